@@ -33,7 +33,12 @@ const (
 	keyCorsAllowOrigins  = "cors.origins"
 	keyCacheEvictionTime = "cache.eviction"
 	keySolCompilerPath   = "sol.compiler"
+	keySolCompilersDir   = "sol.compilers"
+	keyVyperCompilerPath = "vyper.compiler"
+	keyContractTracing   = "contract.tracing"
+	keyStatusBackfill    = "backfill.status"
 	keyVotingSources     = "voting.sources"
+	keyStakerInfo        = "staker.info"
 
 	// defi related configs
 	keyDefiFMintAddressProvider = "defi.address-provider"
@@ -77,6 +82,22 @@ type Config struct {
 	// SolCompilerPath represents the path to sol compiler for smart contract validation.
 	SolCompilerPath string
 
+	// SolCompilersDir represents the path to a directory with Solidity compiler
+	// binaries of different versions available for smart contract validation.
+	SolCompilersDir string
+
+	// VyperCompilerPath represents the path to Vyper compiler for smart contract validation.
+	VyperCompilerPath string
+
+	// ContractTracing enables detection of smart contracts deployed by other contracts
+	// using transaction traces. The full node must provide the tracing API.
+	ContractTracing bool
+
+	// StatusBackfill enables loading of the missing status of transactions stored
+	// before the status was introduced. The status is taken from the transaction
+	// receipts, so the whole transaction history is pulled from the full node.
+	StatusBackfill bool
+
 	// ApiPeers represents a list of other API points of the same type we need to inform
 	// on possible state change.
 	ApiPeers []string
@@ -85,6 +106,10 @@ type Config struct {
 	// for official Fantom ballots.
 	VotingSources []string
 
+	// StakerInfoContract represents the address of the contract holding
+	// the configuration URL of the extended staker information.
+	StakerInfoContract string
+
 	// ApiStateOrigin represents request origin used on state syncing events.
 	ApiStateOrigin string
 
@@ -134,10 +159,17 @@ func Load() (*Config, error) {
 		CorsAllowOrigins:  cfg.GetStringSlice(keyCorsAllowOrigins),
 		CacheEvictionTime: cfg.GetDuration(keyCacheEvictionTime),
 		SolCompilerPath:   cfg.GetString(keySolCompilerPath),
+		SolCompilersDir:   cfg.GetString(keySolCompilersDir),
+		VyperCompilerPath: cfg.GetString(keyVyperCompilerPath),
+		ContractTracing:   cfg.GetBool(keyContractTracing),
+		StatusBackfill:    cfg.GetBool(keyStatusBackfill),
 		ApiPeers:          cfg.GetStringSlice(keyApiPeers),
 		ApiStateOrigin:    cfg.GetString(keyApiStateOrigin),
 		VotingSources:     cfg.GetStringSlice(keyVotingSources),
 
+		// staker information contract
+		StakerInfoContract: cfg.GetString(keyStakerInfo),
+
 		// DeFi below this line
 		DefiFMintAddressProvider: cfg.GetString(keyDefiFMintAddressProvider),
 		DefiUniswapCore:          cfg.GetString(keyDefiUniswapCore),
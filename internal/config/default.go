@@ -39,9 +39,24 @@ const (
 	// defSolCompilerPath represents the default SOL compiler path
 	defSolCompilerPath = "/usr/bin/solc"
 
+	// defSolCompilersDir represents the default directory of SOL compilers registry
+	defSolCompilersDir = "/usr/lib/solc"
+
+	// defVyperCompilerPath represents the default Vyper compiler path
+	defVyperCompilerPath = "/usr/bin/vyper"
+
+	// defContractTracing represents the default state of contract creation tracing
+	defContractTracing = false
+
+	// defStatusBackfill represents the default state of transaction status backfill
+	defStatusBackfill = false
+
 	// defApiStateOrigin represents the default origin used for API state syncing
 	defApiStateOrigin = "https://localhost"
 
+	// defStakerInfoContract represents the address of the staker information contract
+	defStakerInfoContract = "0x92ffad75b8a942d149621a39502cdd8ad1dd57b4"
+
 	// defDefiFMintAddressProvider represents the address of the fMintAddressProvider
 	defDefiFMintAddressProvider = "0x730e27f6c52d07b1a6ab39b639b617dc566c91af"
 
@@ -73,12 +88,19 @@ func applyDefaults(cfg *viper.Viper) {
 	cfg.SetDefault(keyMongoDatabase, defMongoDatabase)
 	cfg.SetDefault(keyCacheEvictionTime, defCacheEvictionTime)
 	cfg.SetDefault(keySolCompilerPath, defSolCompilerPath)
+	cfg.SetDefault(keySolCompilersDir, defSolCompilersDir)
+	cfg.SetDefault(keyVyperCompilerPath, defVyperCompilerPath)
+	cfg.SetDefault(keyContractTracing, defContractTracing)
+	cfg.SetDefault(keyStatusBackfill, defStatusBackfill)
 	cfg.SetDefault(keyApiPeers, defApiPeers)
 	cfg.SetDefault(keyApiStateOrigin, defApiStateOrigin)
 
 	// no voting sources by default
 	cfg.SetDefault(keyVotingSources, defVotingSources)
 
+	// staker information contract
+	cfg.SetDefault(keyStakerInfo, defStakerInfoContract)
+
 	// cors
 	cfg.SetDefault(keyCorsAllowOrigins, defCorsAllowOrigins)
 
@@ -76,16 +76,33 @@ func (acc *Account) TxCount() (hexutil.Uint64, error) {
 }
 
 // TxList resolves list of transaction associated with the account.
+// The list can be limited by direction, counterparty, value range and time window.
 func (acc *Account) TxList(args struct {
-	Cursor *Cursor
-	Count  int32
+	Cursor       *Cursor
+	Count        int32
+	Direction    *string
+	Counterparty *common.Address
+	MinValue     *hexutil.Big
+	MaxValue     *hexutil.Big
+	FromTime     *hexutil.Uint64
+	ToTime       *hexutil.Uint64
 }) (*TransactionList, error) {
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
 	args.Count = listLimitCount(args.Count, accMaxTransactionsPerRequest)
 
+	// collect the filter
+	filter := types.AccountTransactionFilter{
+		Direction:    args.Direction,
+		Counterparty: args.Counterparty,
+		MinValue:     args.MinValue,
+		MaxValue:     args.MaxValue,
+		FromTime:     args.FromTime,
+		ToTime:       args.ToTime,
+	}
+
 	// get the transaction hash list from repository
-	bl, err := acc.repo.AccountTransactions(&acc.Account, (*string)(args.Cursor), args.Count)
+	bl, err := acc.repo.AccountTransactions(&acc.Account, (*string)(args.Cursor), args.Count, &filter)
 	if err != nil {
 		return nil, err
 	}
@@ -93,6 +110,11 @@ func (acc *Account) TxList(args struct {
 	return NewTransactionList(bl, acc.repo), nil
 }
 
+// TxSummary resolves the aggregated transactions of the account.
+func (acc *Account) TxSummary() (*types.AccountTxSummary, error) {
+	return acc.repo.AccountTxSummary(&acc.Account)
+}
+
 // Contract resolves the account smart contract detail,
 // if the account is a smart contract address.
 func (acc *Account) Contract() (*Contract, error) {
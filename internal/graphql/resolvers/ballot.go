@@ -0,0 +1,100 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"fantom-api-graphql/internal/repository"
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	"time"
+)
+
+// Ballot represents resolvable official voting ballot.
+type Ballot struct {
+	repo repository.Repository
+	types.Ballot
+}
+
+// Vote represents resolvable vote cast in an official ballot.
+type Vote struct {
+	repo repository.Repository
+	types.Vote
+}
+
+// NewBallot creates a new instance of resolvable voting ballot.
+func NewBallot(ballot *types.Ballot, repo repository.Repository) *Ballot {
+	return &Ballot{
+		repo:   repo,
+		Ballot: *ballot,
+	}
+}
+
+// NewVote creates a new instance of resolvable vote.
+func NewVote(vote *types.Vote, repo repository.Repository) *Vote {
+	return &Vote{
+		repo: repo,
+		Vote: *vote,
+	}
+}
+
+// Ballots resolves the list of official voting ballots. Only ballots
+// open for voting are resolved if active is requested.
+func (rs *rootResolver) Ballots(args *struct{ Active bool }) ([]*Ballot, error) {
+	// get the ballots
+	bl, err := rs.repo.Ballots(args.Active)
+	if err != nil {
+		return nil, err
+	}
+
+	// make the resolvable list
+	list := make([]*Ballot, len(bl))
+	for i, b := range bl {
+		list[i] = NewBallot(b, rs.repo)
+	}
+
+	return list, nil
+}
+
+// Ballot resolves the details of the official voting ballot.
+func (rs *rootResolver) Ballot(args *struct{ Address common.Address }) (*Ballot, error) {
+	// get the ballot
+	b, err := rs.repo.Ballot(&args.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewBallot(b, rs.repo), nil
+}
+
+// IsActive resolves the ballot being open for voting right now.
+func (b *Ballot) IsActive() bool {
+	now := uint64(time.Now().Unix())
+	return !b.IsFinalized && uint64(b.Start) <= now && now <= uint64(b.End)
+}
+
+// Votes resolves the list of votes cast by the account in official ballots.
+func (acc *Account) Votes() ([]*Vote, error) {
+	// get the votes
+	vl, err := acc.repo.Votes(&acc.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	// make the resolvable list
+	list := make([]*Vote, len(vl))
+	for i := range vl {
+		list[i] = NewVote(&vl[i], acc.repo)
+	}
+
+	return list, nil
+}
+
+// Ballot resolves the ballot the vote has been cast in.
+func (v *Vote) Ballot() (*Ballot, error) {
+	// get the ballot
+	b, err := v.repo.Ballot(&v.Vote.Ballot)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewBallot(b, v.repo), nil
+}
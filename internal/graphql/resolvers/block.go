@@ -48,10 +48,35 @@ func (rs *rootResolver) Block(args *struct {
 	return NewBlock(block, rs.repo), nil
 }
 
+// BlockByTime resolves the blockchain block closest to the given time stamp.
+func (rs *rootResolver) BlockByTime(args *struct {
+	Timestamp hexutil.Uint64
+	Closest   string
+}) (*Block, error) {
+	// get the block
+	block, err := rs.repo.BlockByTime(args.Timestamp, args.Closest)
+	if err != nil {
+		rs.log.Errorf("could not get block by time %d; %s", uint64(args.Timestamp), err.Error())
+		return nil, err
+	}
+
+	// no block found
+	if block == nil {
+		return nil, nil
+	}
+	return NewBlock(block, rs.repo), nil
+}
+
 // Parent resolves parent block information to the given block.
 func (blk *Block) Parent() (*Block, error) {
-	// get the parent block by hash
-	parent, err := blk.repo.BlockByHash(&blk.ParentHash)
+	// the first block has no parent
+	if blk.Number == 0 {
+		return nil, nil
+	}
+
+	// get the parent block by number so it can be served from the stored blocks
+	num := blk.Number - 1
+	parent, err := blk.repo.BlockByNumber(&num)
 	if err != nil {
 		return nil, err
 	}
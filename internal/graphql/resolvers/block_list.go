@@ -5,6 +5,7 @@ import (
 	"fantom-api-graphql/internal/repository"
 	"fantom-api-graphql/internal/types"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"math/big"
 )
 
 // BlockList represents resolvable list of blockchain block edges structure.
@@ -30,9 +31,12 @@ func NewBlockList(blocks *types.BlockList, totalCount *hexutil.Big, repo reposit
 }
 
 // Blocks resolves list of blockchain blocks encapsulated in a listable structure.
+// The list can be limited to blocks created in the given time range.
 func (rs *rootResolver) Blocks(args *struct {
-	Cursor *Cursor
-	Count  int32
+	Cursor   *Cursor
+	Count    int32
+	FromTime *hexutil.Uint64
+	ToTime   *hexutil.Uint64
 }) (*BlockList, error) {
 	// find the cursor
 	var num *uint64
@@ -46,16 +50,21 @@ func (rs *rootResolver) Blocks(args *struct {
 		num = &val
 	}
 
+	// limit query size; the count can be either positive or negative
+	// this controls the loading direction
+	args.Count = listLimitCount(args.Count, listMaxEdgesPerRequest)
+
+	// time range is converted into the range of blocks
+	if args.FromTime != nil || args.ToTime != nil {
+		return rs.blocksByTime(num, args.Count, args.FromTime, args.ToTime)
+	}
+
 	// get the first block so we know the total
 	bh, err := rs.repo.BlockHeight()
 	if err != nil {
 		return nil, err
 	}
 
-	// limit query size; the count can be either positive or negative
-	// this controls the loading direction
-	args.Count = listLimitCount(args.Count, listMaxEdgesPerRequest)
-
 	// get the block list from repository
 	bl, err := rs.repo.Blocks(num, args.Count)
 	if err != nil {
@@ -66,6 +75,18 @@ func (rs *rootResolver) Blocks(args *struct {
 	return NewBlockList(bl, bh, rs.repo), nil
 }
 
+// blocksByTime resolves list of blockchain blocks created in the given time range.
+func (rs *rootResolver) blocksByTime(num *uint64, count int32, from *hexutil.Uint64, to *hexutil.Uint64) (*BlockList, error) {
+	// get the block list from repository
+	bl, err := rs.repo.BlocksByTime(num, count, from, to)
+	if err != nil {
+		rs.log.Errorf("can not get blocks list by time; %s", err.Error())
+		return nil, err
+	}
+
+	return NewBlockList(bl, (*hexutil.Big)(new(big.Int).SetUint64(bl.Total)), rs.repo), nil
+}
+
 // PageInfo resolves the current page information for the blocks list.
 func (bl *BlockList) PageInfo() (*ListPageInfo, error) {
 	// do we have any items?
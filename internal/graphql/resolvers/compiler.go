@@ -0,0 +1,18 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import "fantom-api-graphql/internal/types"
+
+// Compilers resolves list of smart contract compilers available for the contract validation.
+func (rs *rootResolver) Compilers() []types.Compiler {
+	// get the list from repository
+	cl := rs.repo.Compilers()
+
+	// make the resolvable list
+	list := make([]types.Compiler, len(cl))
+	for i, c := range cl {
+		list[i] = *c
+	}
+
+	return list
+}
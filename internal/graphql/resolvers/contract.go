@@ -31,6 +31,10 @@ const (
 // version string syntax. We enforce specific syntax on provided contract versions.
 var scVersionSyntaxRegexp = regexp.MustCompile("^\\w?(\\d+\\.)+\\d+$")
 
+// scCompilerVersionSyntaxRegexp represents a regular expression for testing
+// requested compiler version syntax. We expect plain version numbers, i.e. 0.5.17.
+var scCompilerVersionSyntaxRegexp = regexp.MustCompile("^\\d+\\.\\d+\\.\\d+$")
+
 // Contract represents resolvable blockchain smart contract structure.
 type Contract struct {
 	repo repository.Repository
@@ -69,8 +73,17 @@ type ContractValidationInput struct {
 	// during the contract compilation.
 	OptimizeRuns int32 `json:"optimizeRuns"`
 
-	// SourceCode represents the Solidity source code to be validated.
+	// SourceCode represents the Solidity or Vyper source code to be validated.
 	SourceCode string `json:"sourceCode"`
+
+	// Language represents an optional language of the source code,
+	// either "Solidity", or "Vyper". Solidity is assumed if not provided.
+	Language *string `json:"language,omitempty"`
+
+	// CompilerVersion represents an optional version of the compiler
+	// used to build the deployed byte code, i.e. "0.5.17". The version
+	// is detected from the deployed byte code metadata if not provided.
+	CompilerVersion *string `json:"compilerVersion,omitempty"`
 }
 
 // NewContract builds new resolvable smart contract structure.
@@ -93,6 +106,45 @@ func (con *Contract) DeployedBy() (*Transaction, error) {
 	return trx, nil
 }
 
+// MatchQuality resolves the quality of the match between the validated source code
+// and the deployed byte code. Nil is returned for contracts not validated.
+func (con *Contract) MatchQuality() *string {
+	if 0 == len(con.Match) {
+		return nil
+	}
+	return &con.Match
+}
+
+// ProxyType resolves the type of the proxy if the contract is a proxy.
+func (con *Contract) ProxyType() (*string, error) {
+	px, err := con.repo.ContractProxy(&con.Contract)
+	if err != nil || px == nil {
+		return nil, err
+	}
+	return &px.Type, nil
+}
+
+// Implementation resolves the address of the current implementation
+// if the contract is a proxy.
+func (con *Contract) Implementation() (*common.Address, error) {
+	px, err := con.repo.ContractProxy(&con.Contract)
+	if err != nil || px == nil {
+		return nil, err
+	}
+	return &px.Implementation, nil
+}
+
+// Upgrades resolves the list of implementation upgrades of a proxy contract.
+func (con *Contract) Upgrades() ([]types.ContractUpgrade, error) {
+	return con.repo.ContractUpgrades(&con.Contract)
+}
+
+// MergedAbi resolves the ABI of the contract merged with the ABI
+// of the implementation for proxy contracts.
+func (con *Contract) MergedAbi() (string, error) {
+	return con.repo.ContractAbi(&con.Contract)
+}
+
 // sanitizeStringOption sanitizes and validates optional string value from the
 // smart contract validation check.
 func sanitizeStringOption(o *string, length int) (bool, *string) {
@@ -141,6 +193,16 @@ func isValidationValid(in *ContractValidationInput) error {
 		return fmt.Errorf("invalid version information provided")
 	}
 
+	// validate the source code language
+	if in.Language != nil && *in.Language != types.ContractLanguageSolidity && *in.Language != types.ContractLanguageVyper {
+		return fmt.Errorf("unknown source code language %s", *in.Language)
+	}
+
+	// validate the compiler version syntax
+	if in.CompilerVersion != nil && !scCompilerVersionSyntaxRegexp.MatchString(*in.CompilerVersion) {
+		return fmt.Errorf("invalid compiler version provided")
+	}
+
 	// validate the version syntax
 	if in.OptimizeRuns < 0 {
 		return fmt.Errorf("invalid number of optimization runs provided")
@@ -164,6 +226,12 @@ func updateContractFromInput(con *ContractValidationInput, sc *types.Contract) {
 	sc.IsOptimized = con.Optimized
 	sc.OptimizeRuns = con.OptimizeRuns
 
+	// pass the source code language; Solidity is the default
+	sc.Language = types.ContractLanguageSolidity
+	if con.Language != nil {
+		sc.Language = *con.Language
+	}
+
 	// pass the intended name
 	if con.Name != nil {
 		sc.Name = *con.Name
@@ -214,14 +282,14 @@ func (rs *rootResolver) ValidateContract(args *struct{ Contract ContractValidati
 	updateContractFromInput(&args.Contract, sc)
 
 	// do the validation
-	if err := rs.repo.ValidateContract(sc); err != nil {
+	if err := rs.repo.ValidateContract(sc, args.Contract.CompilerVersion); err != nil {
 		rs.log.Errorf("contract validation failed; %s", err.Error())
 		return nil, err
 	}
 
 	// initiate contract syncing in a separated routine
 	// we don't really need to wait for it, so let it run
-	go rs.syncContract(*sc)
+	go rs.syncContract(*sc, args.Contract.CompilerVersion)
 
 	// return the final updated contract
 	return NewContract(sc, rs.repo), nil
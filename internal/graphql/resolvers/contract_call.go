@@ -0,0 +1,43 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Call resolves a read only call of the contract at the given address
+// on the state of the given block, or the latest block if the block is not specified.
+func (rs *rootResolver) Call(args *struct {
+	To    common.Address
+	Data  hexutil.Bytes
+	From  *common.Address
+	Block *hexutil.Uint64
+}) (hexutil.Bytes, error) {
+	// do the call
+	res, err := rs.repo.Call(&args.To, args.Data, args.From, args.Block)
+	if err != nil {
+		rs.log.Errorf("can not call contract %s; %s", args.To.String(), err.Error())
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// CallContract resolves a read only call of the given method of the contract at the given address
+// using the verified contract ABI; the output values are encoded as a JSON array.
+func (rs *rootResolver) CallContract(args *struct {
+	Address common.Address
+	Method  string
+	Args    []string
+	Block   *hexutil.Uint64
+}) (string, error) {
+	// do the call
+	res, err := rs.repo.CallContract(&args.Address, args.Method, args.Args, args.Block)
+	if err != nil {
+		rs.log.Errorf("can not call %s of contract %s; %s", args.Method, args.Address.String(), err.Error())
+		return "", err
+	}
+
+	return res, nil
+}
@@ -24,14 +24,20 @@ const (
 
 // getContractSyncInput prepares input structure used for contract syncing
 // across peer API points.
-func contractSyncInput(con *types.Contract) ContractValidationInput {
+func contractSyncInput(con *types.Contract, ver *string) ContractValidationInput {
 	// prep the validation input to be synced
 	var cInput = ContractValidationInput{
-		Address:      con.Address,
-		Name:         &con.Name,
-		SourceCode:   con.SourceCode,
-		OptimizeRuns: con.OptimizeRuns,
-		Optimized:    con.IsOptimized,
+		Address:         con.Address,
+		Name:            &con.Name,
+		SourceCode:      con.SourceCode,
+		OptimizeRuns:    con.OptimizeRuns,
+		Optimized:       con.IsOptimized,
+		CompilerVersion: ver,
+	}
+
+	// transfer source code language, if any
+	if 0 < len(con.Language) {
+		cInput.Language = &con.Language
 	}
 
 	// transfer compiler version info, if any
@@ -54,7 +60,7 @@ func contractSyncInput(con *types.Contract) ContractValidationInput {
 
 // constructMutation creates the GraphQL mutation query string
 // for the contract provided.
-func constructMutationPayload(con *types.Contract) (bytes.Buffer, error) {
+func constructMutationPayload(con *types.Contract, ver *string) (bytes.Buffer, error) {
 	// prepare the payload
 	payload := struct {
 		Query     string                 `json:"query"`
@@ -62,7 +68,7 @@ func constructMutationPayload(con *types.Contract) (bytes.Buffer, error) {
 	}{
 		Query: contractSyncMutationQuery,
 		Variables: map[string]interface{}{
-			"sc": contractSyncInput(con),
+			"sc": contractSyncInput(con, ver),
 		},
 	}
 
@@ -77,7 +83,8 @@ func constructMutationPayload(con *types.Contract) (bytes.Buffer, error) {
 }
 
 // SyncContract synchronizes contract across all the peers in the API network.
-func (rs *rootResolver) syncContract(con types.Contract) {
+// The compiler version is passed to peers as it was requested, if any.
+func (rs *rootResolver) syncContract(con types.Contract, ver *string) {
 	// no peers to sync against
 	if len(rs.cfg.ApiPeers) <= 0 {
 		rs.log.Debugf("no peers for contract validation syncing")
@@ -85,7 +92,7 @@ func (rs *rootResolver) syncContract(con types.Contract) {
 	}
 
 	// construct the payload
-	payload, err := constructMutationPayload(&con)
+	payload, err := constructMutationPayload(&con, ver)
 	if err != nil {
 		rs.log.Errorf("can not construct the sync payload; %s", err.Error())
 		return
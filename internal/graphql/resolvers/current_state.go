@@ -3,8 +3,6 @@ package resolvers
 
 import (
 	"fantom-api-graphql/internal/repository"
-	"fantom-api-graphql/internal/types"
-	"fmt"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
@@ -21,14 +19,14 @@ func (rs *rootResolver) State() (CurrentState, error) {
 }
 
 // SealedEpoch resolves the most recent sealed epoch details.
-func (cst CurrentState) SealedEpoch() (types.Epoch, error) {
+func (cst CurrentState) SealedEpoch() (*Epoch, error) {
 	// get the sealed epoch
 	e, err := cst.repo.CurrentSealedEpoch()
 	if err != nil {
-		return types.Epoch{}, err
+		return nil, err
 	}
 
-	return *e, nil
+	return NewEpoch(e, cst.repo), nil
 }
 
 // Validators resolves the number of validators active in the network.
@@ -41,20 +39,15 @@ func (cst CurrentState) Accounts() (hexutil.Uint64, error) {
 	return cst.repo.AccountsActive()
 }
 
-// Blocks resolves the total number of blocks in the chain.
+// Blocks resolves the total number of blocks in the chain known to the API.
 func (cst CurrentState) Blocks() (hexutil.Uint64, error) {
-	// get the block height of the chain
-	h, err := cst.repo.BlockHeight()
+	// count the blocks
+	count, err := cst.repo.BlocksCount()
 	if err != nil {
 		return hexutil.Uint64(0), err
 	}
 
-	// do we have the block?
-	if nil == h || !h.ToInt().IsInt64() {
-		return hexutil.Uint64(0), fmt.Errorf("block height not available")
-	}
-
-	return hexutil.Uint64(h.ToInt().Uint64()), nil
+	return hexutil.Uint64(count), nil
 }
 
 // Transactions resolves the total number of transactions in the chain.
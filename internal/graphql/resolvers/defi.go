@@ -0,0 +1,88 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"fantom-api-graphql/internal/repository"
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	"sync"
+)
+
+// FMintAccount represents resolvable fMint DeFi account positions.
+type FMintAccount struct {
+	repo repository.Repository
+	types.FMintAccount
+
+	// the DeFi configuration is loaded once for all the resolved fields
+	cfgOnce sync.Once
+	cfg     *types.DefiSettings
+	cfgErr  error
+}
+
+// NewFMintAccount creates a new instance of resolvable fMint account.
+func NewFMintAccount(fa *types.FMintAccount, repo repository.Repository) *FMintAccount {
+	return &FMintAccount{
+		repo:         repo,
+		FMintAccount: *fa,
+	}
+}
+
+// DefiConfiguration resolves the current configuration of the fMint DeFi module.
+func (rs *rootResolver) DefiConfiguration() (*types.DefiSettings, error) {
+	return rs.repo.DefiConfiguration()
+}
+
+// FMintAccount resolves the collateral and debt positions of the given account
+// in the fMint DeFi module.
+func (rs *rootResolver) FMintAccount(args *struct{ Owner common.Address }) (*FMintAccount, error) {
+	// get the account positions
+	fa, err := rs.repo.FMintAccount(&args.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFMintAccount(fa, rs.repo), nil
+}
+
+// CanBeLiquidated resolves the flag of the account collateral to debt ratio
+// being below the lowest allowed ratio.
+func (fa *FMintAccount) CanBeLiquidated() (bool, error) {
+	// no debt, no liquidation
+	if fa.CollateralRatio4 == nil {
+		return false, nil
+	}
+
+	// get the current settings
+	ds, err := fa.settings()
+	if err != nil {
+		return false, err
+	}
+
+	return fa.CollateralRatio4.ToInt().Cmp(ds.MinCollateralRatio4.ToInt()) < 0, nil
+}
+
+// RewardsEligible resolves the flag of the account collateral to debt ratio
+// being high enough to be eligible for rewards.
+func (fa *FMintAccount) RewardsEligible() (bool, error) {
+	// no debt, no rewards
+	if fa.CollateralRatio4 == nil {
+		return false, nil
+	}
+
+	// get the current settings
+	ds, err := fa.settings()
+	if err != nil {
+		return false, err
+	}
+
+	return fa.CollateralRatio4.ToInt().Cmp(ds.RewardCollateralRatio4.ToInt()) >= 0, nil
+}
+
+// settings loads the current DeFi configuration once per account resolver
+// so the fields depending on it don't need to load it again.
+func (fa *FMintAccount) settings() (*types.DefiSettings, error) {
+	fa.cfgOnce.Do(func() {
+		fa.cfg, fa.cfgErr = fa.repo.DefiConfiguration()
+	})
+	return fa.cfg, fa.cfgErr
+}
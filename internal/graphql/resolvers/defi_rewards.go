@@ -0,0 +1,30 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"fantom-api-graphql/internal/types"
+)
+
+// FMintRewards represents resolvable state of fMint rewards of an account.
+type FMintRewards struct {
+	types.FMintRewards
+}
+
+// FMintRewardPool resolves the state of the fMint reward distribution pool.
+func (rs *rootResolver) FMintRewardPool() (*types.FMintRewardPool, error) {
+	return rs.repo.FMintRewardPool()
+}
+
+// Rewards resolves the state of fMint rewards of the account.
+func (fa *FMintAccount) Rewards() (*FMintRewards, error) {
+	rw, err := fa.repo.FMintRewards(&fa.Address)
+	if err != nil {
+		return nil, err
+	}
+	return &FMintRewards{FMintRewards: *rw}, nil
+}
+
+// RewardClaims resolves the list of fMint reward claims of the account.
+func (fa *FMintAccount) RewardClaims() ([]types.FMintRewardClaim, error) {
+	return fa.repo.FMintRewardClaims(&fa.Address)
+}
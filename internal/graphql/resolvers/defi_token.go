@@ -0,0 +1,50 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"fantom-api-graphql/internal/repository"
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// DefiToken represents resolvable DeFi token registered in the tokens registry.
+type DefiToken struct {
+	repo repository.Repository
+	types.DefiToken
+}
+
+// NewDefiToken creates a new instance of resolvable DeFi token.
+func NewDefiToken(token *types.DefiToken, repo repository.Repository) *DefiToken {
+	return &DefiToken{
+		repo:      repo,
+		DefiToken: *token,
+	}
+}
+
+// DefiTokens resolves the list of tokens registered in the DeFi tokens registry.
+func (rs *rootResolver) DefiTokens() ([]*DefiToken, error) {
+	// get the list from repository
+	tl, err := rs.repo.DefiTokens()
+	if err != nil {
+		return nil, err
+	}
+
+	// make the resolvable list
+	list := make([]*DefiToken, len(tl))
+	for i, t := range tl {
+		list[i] = NewDefiToken(t, rs.repo)
+	}
+
+	return list, nil
+}
+
+// DefiTokenPrice resolves the current price of the given token from the DeFi price oracle.
+func (rs *rootResolver) DefiTokenPrice(args *struct{ Token common.Address }) (hexutil.Big, error) {
+	return rs.repo.DefiTokenPrice(&args.Token)
+}
+
+// Price resolves the current price of the token from the DeFi price oracle.
+func (dt *DefiToken) Price() (hexutil.Big, error) {
+	return dt.repo.DefiTokenPrice(&dt.Address)
+}
@@ -0,0 +1,27 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"fantom-api-graphql/internal/repository"
+	"fantom-api-graphql/internal/types"
+)
+
+// Epoch represents resolvable epoch information.
+type Epoch struct {
+	repo repository.Repository
+	types.Epoch
+}
+
+// NewEpoch creates a new instance of resolvable epoch.
+func NewEpoch(ep *types.Epoch, repo repository.Repository) *Epoch {
+	return &Epoch{
+		repo:  repo,
+		Epoch: *ep,
+	}
+}
+
+// Validators resolves the list of validators of the epoch with their stake
+// and reward weights.
+func (ep *Epoch) Validators() ([]types.EpochValidator, error) {
+	return ep.repo.EpochValidators(ep.Id)
+}
@@ -0,0 +1,87 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"fantom-api-graphql/internal/repository"
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"math/big"
+	"strconv"
+)
+
+// EpochList represents resolvable list of sealed epoch edges structure.
+type EpochList struct {
+	repo repository.Repository
+	list *types.EpochList
+}
+
+// EpochListEdge represents a single edge of an epoch list structure.
+type EpochListEdge struct {
+	Epoch  *Epoch
+	Cursor Cursor
+}
+
+// NewEpochList builds new resolvable list of epochs.
+func NewEpochList(el *types.EpochList, repo repository.Repository) *EpochList {
+	return &EpochList{
+		repo: repo,
+		list: el,
+	}
+}
+
+// Epochs resolves list of sealed epochs encapsulated in a listable structure.
+func (rs *rootResolver) Epochs(args *struct {
+	Cursor *Cursor
+	Count  int32
+}) (*EpochList, error) {
+	// limit query size; the count can be either positive or negative
+	// this controls the loading direction
+	args.Count = listLimitCount(args.Count, listMaxEdgesPerRequest)
+
+	// get the epoch list from repository
+	el, err := rs.repo.Epochs((*string)(args.Cursor), args.Count)
+	if err != nil {
+		rs.log.Errorf("can not get epochs list; %s", err.Error())
+		return nil, err
+	}
+
+	return NewEpochList(el, rs.repo), nil
+}
+
+// TotalCount resolves the total number of epochs in the list.
+func (el *EpochList) TotalCount() hexutil.Big {
+	val := (*hexutil.Big)(big.NewInt(int64(el.list.Total)))
+	return *val
+}
+
+// PageInfo resolves the current page information for the epoch list.
+func (el *EpochList) PageInfo() (*ListPageInfo, error) {
+	// do we have any items?
+	if el.list == nil || el.list.Collection == nil || len(el.list.Collection) == 0 {
+		return NewListPageInfo(nil, nil, false, false)
+	}
+
+	// get the first and last elements
+	first := Cursor(strconv.FormatUint(el.list.First, 10))
+	last := Cursor(strconv.FormatUint(el.list.Last, 10))
+	return NewListPageInfo(&first, &last, !el.list.IsEnd, !el.list.IsStart)
+}
+
+// Edges resolves list of edges for the linked epoch list.
+func (el *EpochList) Edges() []*EpochListEdge {
+	// do we have any items? return empty list if not
+	if el.list == nil || el.list.Collection == nil || len(el.list.Collection) == 0 {
+		return make([]*EpochListEdge, 0)
+	}
+
+	// make the list
+	edges := make([]*EpochListEdge, len(el.list.Collection))
+	for i, e := range el.list.Collection {
+		edges[i] = &EpochListEdge{
+			Epoch:  NewEpoch(e, el.repo),
+			Cursor: Cursor(strconv.FormatUint(uint64(e.Id), 10)),
+		}
+	}
+
+	return edges
+}
@@ -0,0 +1,37 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// GasPrice resolves the current gas price suggested by the connected node.
+func (rs *rootResolver) GasPrice() (hexutil.Big, error) {
+	// get the price
+	price, err := rs.repo.GasPrice()
+	if err != nil {
+		rs.log.Errorf("can not get gas price; %s", err.Error())
+		return hexutil.Big{}, err
+	}
+
+	return *price, nil
+}
+
+// EstimateGas resolves the amount of gas needed to execute the given call.
+func (rs *rootResolver) EstimateGas(args *struct{ Tx types.CallInput }) (hexutil.Uint64, error) {
+	// estimate the gas
+	gas, err := rs.repo.EstimateGas(&args.Tx)
+	if err != nil {
+		rs.log.Errorf("can not estimate gas; %s", err.Error())
+		return hexutil.Uint64(0), err
+	}
+
+	return *gas, nil
+}
+
+// GasPriceSuggestion resolves slow, standard and fast gas price suggestions
+// derived from gas prices of transactions in recent blocks.
+func (rs *rootResolver) GasPriceSuggestion() (*types.GasPriceSuggestion, error) {
+	return rs.repo.GasPriceSuggestion()
+}
@@ -0,0 +1,119 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"fantom-api-graphql/internal/repository"
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// GovernanceContract represents resolvable governance contract.
+type GovernanceContract struct {
+	repo repository.Repository
+	types.GovernanceContract
+}
+
+// GovernanceVote represents resolvable vote cast in a governance proposal.
+type GovernanceVote struct {
+	repo repository.Repository
+	types.GovernanceVote
+}
+
+// NewGovernanceContract creates a new instance of resolvable governance contract.
+func NewGovernanceContract(gov *types.GovernanceContract, repo repository.Repository) *GovernanceContract {
+	return &GovernanceContract{
+		repo:               repo,
+		GovernanceContract: *gov,
+	}
+}
+
+// NewGovernanceVote creates a new instance of resolvable governance vote.
+func NewGovernanceVote(vote *types.GovernanceVote, repo repository.Repository) *GovernanceVote {
+	return &GovernanceVote{
+		repo:           repo,
+		GovernanceVote: *vote,
+	}
+}
+
+// GovContracts resolves the list of the configured governance contracts.
+func (rs *rootResolver) GovContracts() []*GovernanceContract {
+	// get the contracts
+	gl := rs.repo.GovernanceContracts()
+
+	// make the resolvable list
+	list := make([]*GovernanceContract, len(gl))
+	for i := range gl {
+		list[i] = NewGovernanceContract(&gl[i], rs.repo)
+	}
+
+	return list
+}
+
+// GovContract resolves the configured governance contract by its address.
+func (rs *rootResolver) GovContract(args *struct{ Address common.Address }) (*GovernanceContract, error) {
+	// get the contract
+	gov, err := rs.repo.GovernanceContract(&args.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewGovernanceContract(gov, rs.repo), nil
+}
+
+// GovProposal resolves the details and the state of the governance proposal.
+func (rs *rootResolver) GovProposal(args *struct {
+	Contract common.Address
+	Id       hexutil.Uint64
+}) (*types.GovernanceProposal, error) {
+	// make sure the governance contract is known
+	if _, err := rs.repo.GovernanceContract(&args.Contract); err != nil {
+		return nil, err
+	}
+
+	return rs.repo.GovernanceProposal(&args.Contract, args.Id)
+}
+
+// ProposalsCount resolves the number of proposals of the governance contract.
+func (gov *GovernanceContract) ProposalsCount() (hexutil.Uint64, error) {
+	return gov.repo.GovernanceProposalsCount(&gov.Address)
+}
+
+// Proposals resolves the list of the latest proposals of the governance contract.
+func (gov *GovernanceContract) Proposals(args *struct{ ActiveOnly bool }) ([]*types.GovernanceProposal, error) {
+	return gov.repo.GovernanceProposals(&gov.Address, args.ActiveOnly)
+}
+
+// Proposal resolves the governance proposal by its id.
+func (gov *GovernanceContract) Proposal(args *struct{ Id hexutil.Uint64 }) (*types.GovernanceProposal, error) {
+	return gov.repo.GovernanceProposal(&gov.Address, args.Id)
+}
+
+// Proposal resolves the governance proposal the vote was cast in.
+func (v *GovernanceVote) Proposal() (*types.GovernanceProposal, error) {
+	return v.repo.GovernanceProposal(&v.Governance, v.ProposalId)
+}
+
+// GovVotes resolves the list of governance votes cast by the account,
+// optionally filtered by the governance contract.
+func (acc *Account) GovVotes(args *struct{ Contract *common.Address }) ([]*GovernanceVote, error) {
+	// get the votes
+	vl, err := acc.repo.GovernanceVotes(&acc.Address, args.Contract)
+	if err != nil {
+		return nil, err
+	}
+
+	// make the resolvable list
+	list := make([]*GovernanceVote, len(vl))
+	for i := range vl {
+		list[i] = NewGovernanceVote(&vl[i], acc.repo)
+	}
+
+	return list, nil
+}
+
+// VotingPower resolves the governance voting power of the account
+// derived from its stake and delegations.
+func (acc *Account) VotingPower() (*types.GovernanceVotingPower, error) {
+	return acc.repo.GovernanceVotingPower(&acc.Address)
+}
@@ -0,0 +1,73 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"time"
+)
+
+// networkStatsDefaultPeriods represents the number of periods loaded if the time range is not specified.
+const networkStatsDefaultPeriods = 24
+
+// NetworkStats represents resolvable aggregated network statistics.
+type NetworkStats struct {
+	types.NetworkStats
+}
+
+// NewNetworkStats creates a new instance of resolvable network statistics.
+func NewNetworkStats(ns *types.NetworkStats) *NetworkStats {
+	return &NetworkStats{NetworkStats: *ns}
+}
+
+// Tps resolves the average number of transactions per second in the period.
+func (ns *NetworkStats) Tps() float64 {
+	// nothing aggregated yet?
+	if ns.Duration == 0 {
+		return 0
+	}
+	return float64(ns.TxCount) / float64(ns.Duration)
+}
+
+// NetworkStats resolves the aggregated network statistics of the given resolution in the time range.
+// The latest periods are resolved if the range is not specified.
+func (rs *rootResolver) NetworkStats(args *struct {
+	Resolution string
+	From       *hexutil.Uint64
+	To         *hexutil.Uint64
+}) ([]*NetworkStats, error) {
+	// validate the resolution
+	period := types.NetworkStatsPeriod(args.Resolution)
+	if period == 0 {
+		return nil, fmt.Errorf("unknown network stats resolution %s", args.Resolution)
+	}
+
+	// the range ends now by default
+	to := uint64(time.Now().UTC().Unix())
+	if args.To != nil {
+		to = uint64(*args.To)
+	}
+
+	// and spans the default number of periods
+	var from uint64
+	if args.From != nil {
+		from = uint64(*args.From)
+	} else if to > networkStatsDefaultPeriods*period {
+		from = to - networkStatsDefaultPeriods*period
+	}
+
+	// load the stats
+	list, err := rs.repo.NetworkStats(args.Resolution, from, to)
+	if err != nil {
+		rs.log.Errorf("can not load network stats; %s", err.Error())
+		return nil, err
+	}
+
+	// make the resolvable list
+	res := make([]*NetworkStats, len(list))
+	for i, ns := range list {
+		res[i] = NewNetworkStats(ns)
+	}
+	return res, nil
+}
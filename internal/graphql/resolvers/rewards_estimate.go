@@ -0,0 +1,18 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// EstimateRewards resolves the expected staking rewards of the given amount
+// staked for the given duration based on the latest sealed epoch.
+func (rs *rootResolver) EstimateRewards(args *struct {
+	Amount       hexutil.Big
+	Duration     hexutil.Uint64
+	IsValidator  bool
+	LockDuration hexutil.Uint64
+}) (*types.RewardsEstimate, error) {
+	return rs.repo.EstimateRewards(args.Amount, args.Duration, args.IsValidator, args.LockDuration)
+}
@@ -31,6 +31,9 @@ type ApiResolver interface {
 	// State resolves current state of the blockchain.
 	State() (CurrentState, error)
 
+	// Search resolves the list of entities matching the given text.
+	Search(*struct{ Text string }) ([]*SearchResult, error)
+
 	// Account resolves blockchain account by address.
 	Account(struct{ Address common.Address }) (*Account, error)
 
@@ -46,16 +49,27 @@ type ApiResolver interface {
 	// to notify them about the change.
 	ValidateContract(*struct{ Contract ContractValidationInput }) (*Contract, error)
 
+	// Compilers resolves list of smart contract compilers available for the contract validation.
+	Compilers() []types.Compiler
+
 	// Block resolves blockchain block by number or by hash. If neither is provided, the most recent block is given.
 	Block(*struct {
 		Number *hexutil.Uint64
 		Hash   *types.Hash
 	}) (*Block, error)
 
+	// BlockByTime resolves the blockchain block closest to the given time stamp.
+	BlockByTime(*struct {
+		Timestamp hexutil.Uint64
+		Closest   string
+	}) (*Block, error)
+
 	// Blocks resolves list of blockchain blocks encapsulated in a listable structure.
 	Blocks(*struct {
-		Cursor *Cursor
-		Count  int32
+		Cursor   *Cursor
+		Count    int32
+		FromTime *hexutil.Uint64
+		ToTime   *hexutil.Uint64
 	}) (*BlockList, error)
 
 	// Transaction resolves blockchain transaction by hash.
@@ -65,6 +79,7 @@ type ApiResolver interface {
 	Transactions(*struct {
 		Cursor *Cursor
 		Count  int32
+		Filter *types.TransactionFilter
 	}) (*TransactionList, error)
 
 	// OnBlock resolves subscription to new blocks event broadcast.
@@ -73,11 +88,74 @@ type ApiResolver interface {
 	// OnTransaction resolves subscription to new transactions event broadcast.
 	OnTransaction(ctx context.Context) <-chan *Transaction
 
+	// OnPairSwap resolves subscription to new swaps of the given Uniswap pair.
+	OnPairSwap(ctx context.Context, args *struct{ Pair common.Address }) <-chan *types.UniswapAction
+
+	// OnGovProposalChange resolves subscription to state changes of governance proposals.
+	OnGovProposalChange(ctx context.Context, args *struct{ Contract *common.Address }) <-chan *types.GovernanceProposal
+
+	// OnStakerStatusChange resolves subscription to status changes of stakers.
+	OnStakerStatusChange(ctx context.Context, args *struct{ StakerId *hexutil.Uint64 }) <-chan *StakerStatusChange
+
+	// OnNetworkStats resolves subscription to updates of the network statistics.
+	OnNetworkStats(ctx context.Context, args *struct{ Resolution *string }) <-chan *NetworkStats
+
+	// NetworkStats resolves the aggregated network statistics of the given resolution in the time range.
+	NetworkStats(*struct {
+		Resolution string
+		From       *hexutil.Uint64
+		To         *hexutil.Uint64
+	}) ([]*NetworkStats, error)
+
+	// GasPrice resolves the current gas price suggested by the connected node.
+	GasPrice() (hexutil.Big, error)
+
+	// EstimateGas resolves the amount of gas needed to execute the given call.
+	EstimateGas(*struct{ Tx types.CallInput }) (hexutil.Uint64, error)
+
+	// GasPriceSuggestion resolves slow, standard and fast gas price suggestions.
+	GasPriceSuggestion() (*types.GasPriceSuggestion, error)
+
+	// Call resolves a read only call of the contract at the given address.
+	Call(*struct {
+		To    common.Address
+		Data  hexutil.Bytes
+		From  *common.Address
+		Block *hexutil.Uint64
+	}) (hexutil.Bytes, error)
+
+	// CallContract resolves a read only call of the given method of the contract using the verified ABI.
+	CallContract(*struct {
+		Address common.Address
+		Method  string
+		Args    []string
+		Block   *hexutil.Uint64
+	}) (string, error)
+
 	// CurrentEpoch resolves id of the current epoch.
 	CurrentEpoch() (hexutil.Uint64, error)
 
-	// Epoch resolves information about epoch of the given id.
-	Epoch(*struct{ Id hexutil.Uint64 }) (types.Epoch, error)
+	// Epoch resolves information about epoch of the given id,
+	// optionally at the given block.
+	Epoch(*struct {
+		Id    hexutil.Uint64
+		Block *hexutil.Uint64
+	}) (*Epoch, error)
+
+	// Epochs resolves list of sealed epochs encapsulated in a listable structure.
+	Epochs(*struct {
+		Cursor *Cursor
+		Count  int32
+	}) (*EpochList, error)
+
+	// EstimateRewards resolves the expected staking rewards of the given amount
+	// staked for the given duration.
+	EstimateRewards(*struct {
+		Amount       hexutil.Big
+		Duration     hexutil.Uint64
+		IsValidator  bool
+		LockDuration hexutil.Uint64
+	}) (*types.RewardsEstimate, error)
 
 	// LastStakerId resolves the last staker id in Opera blockchain.
 	LastStakerId() (hexutil.Uint64, error)
@@ -85,9 +163,100 @@ type ApiResolver interface {
 	// StakersNum resolves the number of stakers in Opera blockchain.
 	StakersNum() (hexutil.Uint64, error)
 
+	// Staker resolves a staker information by numeric id, or by address,
+	// optionally at the given block.
+	Staker(*struct {
+		Id      *hexutil.Uint64
+		Address *common.Address
+		Block   *hexutil.Uint64
+	}) (*Staker, error)
+
+	// Stakers resolves the list of all the stakers known to the SFC contract.
+	Stakers() ([]*Staker, error)
+
+	// Delegations resolves the list of delegations of the given account.
+	Delegations(*struct {
+		Address common.Address
+		Block   *hexutil.Uint64
+	}) ([]types.Delegation, error)
+
+	// UpdateStakerInfo reloads the extended staker information of the given staker.
+	UpdateStakerInfo(*struct{ StakerId hexutil.Uint64 }) (*types.StakerInfo, error)
+
 	// SendTransaction sends raw signed and RLP encoded transaction to the block chain.
 	SendTransaction(*struct{ Tx hexutil.Bytes }) (*Transaction, error)
 
+	// DefiConfiguration resolves the current configuration of the fMint DeFi module.
+	DefiConfiguration() (*types.DefiSettings, error)
+
+	// FMintAccount resolves the collateral and debt positions of the given account
+	// in the fMint DeFi module.
+	FMintAccount(*struct{ Owner common.Address }) (*FMintAccount, error)
+
+	// FMintRewardPool resolves the state of the fMint reward distribution pool.
+	FMintRewardPool() (*types.FMintRewardPool, error)
+
+	// DefiTokens resolves the list of tokens registered in the DeFi tokens registry.
+	DefiTokens() ([]*DefiToken, error)
+
+	// DefiTokenPrice resolves the current price of the given token from the DeFi price oracle.
+	DefiTokenPrice(*struct{ Token common.Address }) (hexutil.Big, error)
+
+	// UniswapPairs resolves list of Uniswap pairs encapsulated in a listable structure.
+	UniswapPairs(*struct {
+		Cursor *Cursor
+		Count  int32
+	}) (*UniswapPairList, error)
+
+	// UniswapPair resolves the state of the given Uniswap pair.
+	UniswapPair(*struct{ Address common.Address }) (*UniswapPair, error)
+
+	// UniswapAmountsOut resolves the amounts of tokens received for the given
+	// input amount swapped along the given path of tokens.
+	UniswapAmountsOut(*struct {
+		AmountIn hexutil.Big
+		Tokens   []common.Address
+	}) ([]hexutil.Big, error)
+
+	// UniswapPositions resolves the list of liquidity positions of the given account.
+	UniswapPositions(*struct{ Owner common.Address }) ([]*UniswapPosition, error)
+
+	// UniswapActions resolves the list of the latest swaps and liquidity changes
+	// on the Uniswap exchange.
+	UniswapActions(*struct {
+		Pair       *common.Address
+		Account    *common.Address
+		ActionType *string
+		Count      int32
+	}) ([]types.UniswapAction, error)
+
+	// PairCandles resolves the list of OHLCV candles of the given Uniswap pair
+	// and resolution in the given time range.
+	PairCandles(*struct {
+		Pair       common.Address
+		Resolution string
+		From       hexutil.Uint64
+		To         hexutil.Uint64
+	}) ([]types.UniswapCandle, error)
+
+	// Ballots resolves the list of official voting ballots.
+	Ballots(*struct{ Active bool }) ([]*Ballot, error)
+
+	// Ballot resolves the details of the official voting ballot.
+	Ballot(*struct{ Address common.Address }) (*Ballot, error)
+
+	// GovContracts resolves the list of the configured governance contracts.
+	GovContracts() []*GovernanceContract
+
+	// GovContract resolves the configured governance contract by its address.
+	GovContract(*struct{ Address common.Address }) (*GovernanceContract, error)
+
+	// GovProposal resolves the details and the state of the governance proposal.
+	GovProposal(*struct {
+		Contract common.Address
+		Id       hexutil.Uint64
+	}) (*types.GovernanceProposal, error)
+
 	// Close terminates resolver broadcast management.
 	Close()
 }
@@ -113,6 +282,30 @@ type rootResolver struct {
 	unsubscribeOnTrx chan string
 	trxSubscribers   map[string]*subscriptOnTrx
 	onTrxEvents      chan *types.Transaction
+
+	// Uniswap swap subscriptions management
+	subscribeOnSwap   chan *subscriptOnSwap
+	unsubscribeOnSwap chan string
+	swapSubscribers   map[string]*subscriptOnSwap
+	onSwapEvents      chan *types.UniswapAction
+
+	// governance proposal subscriptions management
+	subscribeOnProposal   chan *subscriptOnProposal
+	unsubscribeOnProposal chan string
+	proposalSubscribers   map[string]*subscriptOnProposal
+	onProposalEvents      chan *types.GovernanceProposalEvent
+
+	// staker status subscriptions management
+	subscribeOnStakerStatus   chan *subscriptOnStakerStatus
+	unsubscribeOnStakerStatus chan string
+	stakerStatusSubscribers   map[string]*subscriptOnStakerStatus
+	onStakerStatusEvents      chan *types.StakerStatusEvent
+
+	// network stats subscriptions management
+	subscribeOnNetworkStats   chan *subscriptOnNetworkStats
+	unsubscribeOnNetworkStats chan string
+	networkStatsSubscribers   map[string]*subscriptOnNetworkStats
+	onNetworkStatsEvents      chan *types.NetworkStats
 }
 
 // New creates a new root resolver instance and initializes it's internal structure.
@@ -137,11 +330,39 @@ func New(cfg *config.Config, log logger.Logger, repo repository.Repository) ApiR
 		unsubscribeOnTrx: make(chan string, subscriptionQueueCapacity),
 		trxSubscribers:   make(map[string]*subscriptOnTrx, subscriptionInitialCapacity),
 		onTrxEvents:      make(chan *types.Transaction, onBlockChannelCapacity),
+
+		// swap events subscription basics
+		subscribeOnSwap:   make(chan *subscriptOnSwap, subscriptionQueueCapacity),
+		unsubscribeOnSwap: make(chan string, subscriptionQueueCapacity),
+		swapSubscribers:   make(map[string]*subscriptOnSwap, subscriptionInitialCapacity),
+		onSwapEvents:      make(chan *types.UniswapAction, onSwapChannelCapacity),
+
+		// governance proposal events subscription basics
+		subscribeOnProposal:   make(chan *subscriptOnProposal, subscriptionQueueCapacity),
+		unsubscribeOnProposal: make(chan string, subscriptionQueueCapacity),
+		proposalSubscribers:   make(map[string]*subscriptOnProposal, subscriptionInitialCapacity),
+		onProposalEvents:      make(chan *types.GovernanceProposalEvent, onProposalChannelCapacity),
+
+		// staker status events subscription basics
+		subscribeOnStakerStatus:   make(chan *subscriptOnStakerStatus, subscriptionQueueCapacity),
+		unsubscribeOnStakerStatus: make(chan string, subscriptionQueueCapacity),
+		stakerStatusSubscribers:   make(map[string]*subscriptOnStakerStatus, subscriptionInitialCapacity),
+		onStakerStatusEvents:      make(chan *types.StakerStatusEvent, onStakerStatusChannelCapacity),
+
+		// network stats events subscription basics
+		subscribeOnNetworkStats:   make(chan *subscriptOnNetworkStats, subscriptionQueueCapacity),
+		unsubscribeOnNetworkStats: make(chan string, subscriptionQueueCapacity),
+		networkStatsSubscribers:   make(map[string]*subscriptOnNetworkStats, subscriptionInitialCapacity),
+		onNetworkStatsEvents:      make(chan *types.NetworkStats, onNetworkStatsChannelCapacity),
 	}
 
 	// register event channels with repository
 	repo.SetBlockChannel(rs.onBlockEvents)
 	repo.SetTrxChannel(rs.onTrxEvents)
+	repo.SetSwapChannel(rs.onSwapEvents)
+	repo.SetProposalChannel(rs.onProposalEvents)
+	repo.SetStakerStatusChannel(rs.onStakerStatusEvents)
+	repo.SetNetworkStatsChannel(rs.onNetworkStatsEvents)
 
 	// handle broadcast and subscriptions in a separate routine
 	rs.wg.Add(1)
@@ -184,17 +405,53 @@ func (rs *rootResolver) run() {
 		case id := <-rs.unsubscribeOnTrx:
 			delete(rs.trxSubscribers, id)
 
+		case id := <-rs.unsubscribeOnSwap:
+			delete(rs.swapSubscribers, id)
+
+		case id := <-rs.unsubscribeOnProposal:
+			delete(rs.proposalSubscribers, id)
+
+		case id := <-rs.unsubscribeOnStakerStatus:
+			delete(rs.stakerStatusSubscribers, id)
+
+		case id := <-rs.unsubscribeOnNetworkStats:
+			delete(rs.networkStatsSubscribers, id)
+
 		case sub := <-rs.subscribeOnBlock:
 			rs.addBlockSubscriber(sub)
 
 		case sub := <-rs.subscribeOnTrx:
 			rs.addTrxSubscriber(sub)
 
+		case sub := <-rs.subscribeOnSwap:
+			rs.addSwapSubscriber(sub)
+
+		case sub := <-rs.subscribeOnProposal:
+			rs.addProposalSubscriber(sub)
+
+		case sub := <-rs.subscribeOnStakerStatus:
+			rs.addStakerStatusSubscriber(sub)
+
+		case sub := <-rs.subscribeOnNetworkStats:
+			rs.addNetworkStatsSubscriber(sub)
+
 		case evt := <-rs.onBlockEvents:
 			rs.dispatchOnBlock(evt)
 
 		case evt := <-rs.onTrxEvents:
 			rs.dispatchOnTransaction(evt)
+
+		case evt := <-rs.onSwapEvents:
+			rs.dispatchOnSwap(evt)
+
+		case evt := <-rs.onProposalEvents:
+			rs.dispatchOnProposal(evt)
+
+		case evt := <-rs.onStakerStatusEvents:
+			rs.dispatchOnStakerStatus(evt)
+
+		case evt := <-rs.onNetworkStatsEvents:
+			rs.dispatchOnNetworkStats(evt)
 		}
 	}
 }
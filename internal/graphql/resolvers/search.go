@@ -0,0 +1,75 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+// SearchResult represents resolvable entity matching a search text.
+type SearchResult struct {
+	result interface{}
+}
+
+// Search resolves the list of entities matching the given text.
+func (rs *rootResolver) Search(args *struct{ Text string }) ([]*SearchResult, error) {
+	// do the search
+	res, err := rs.repo.Search(args.Text)
+	if err != nil {
+		return nil, err
+	}
+
+	// make the list of resolvable results
+	list := make([]*SearchResult, 0)
+	for _, blk := range res.Blocks {
+		list = append(list, &SearchResult{result: NewBlock(blk, rs.repo)})
+	}
+	for _, trx := range res.Transactions {
+		list = append(list, &SearchResult{result: NewTransaction(trx, rs.repo)})
+	}
+	for _, acc := range res.Accounts {
+		list = append(list, &SearchResult{result: NewAccount(acc, rs.repo)})
+	}
+	for _, sc := range res.Contracts {
+		list = append(list, &SearchResult{result: NewContract(sc, rs.repo)})
+	}
+	for _, tk := range res.Tokens {
+		list = append(list, &SearchResult{result: NewDefiToken(tk, rs.repo)})
+	}
+	for _, st := range res.Stakers {
+		list = append(list, &SearchResult{result: NewStaker(st, rs.repo)})
+	}
+
+	return list, nil
+}
+
+// ToBlock resolves the search result as a block.
+func (sr *SearchResult) ToBlock() (*Block, bool) {
+	blk, ok := sr.result.(*Block)
+	return blk, ok
+}
+
+// ToTransaction resolves the search result as a transaction.
+func (sr *SearchResult) ToTransaction() (*Transaction, bool) {
+	trx, ok := sr.result.(*Transaction)
+	return trx, ok
+}
+
+// ToAccount resolves the search result as an account.
+func (sr *SearchResult) ToAccount() (*Account, bool) {
+	acc, ok := sr.result.(*Account)
+	return acc, ok
+}
+
+// ToContract resolves the search result as a smart contract.
+func (sr *SearchResult) ToContract() (*Contract, bool) {
+	sc, ok := sr.result.(*Contract)
+	return sc, ok
+}
+
+// ToDefiToken resolves the search result as a token.
+func (sr *SearchResult) ToDefiToken() (*DefiToken, bool) {
+	tk, ok := sr.result.(*DefiToken)
+	return tk, ok
+}
+
+// ToStaker resolves the search result as a staker.
+func (sr *SearchResult) ToStaker() (*Staker, bool) {
+	st, ok := sr.result.(*Staker)
+	return st, ok
+}
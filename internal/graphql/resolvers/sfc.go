@@ -11,9 +11,25 @@ func (rs *rootResolver) CurrentEpoch() (hexutil.Uint64, error) {
 	return rs.repo.CurrentEpoch()
 }
 
-// Epoch resolves information about epoch of the given id.
-func (rs *rootResolver) Epoch(args *struct{ Id hexutil.Uint64 }) (types.Epoch, error) {
-	return rs.repo.Epoch(args.Id)
+// Epoch resolves information about epoch of the given id,
+// optionally at the given block.
+func (rs *rootResolver) Epoch(args *struct {
+	Id    hexutil.Uint64
+	Block *hexutil.Uint64
+}) (*Epoch, error) {
+	// get the epoch
+	var ep types.Epoch
+	var err error
+	if args.Block != nil {
+		ep, err = rs.repo.EpochAt(args.Id, *args.Block)
+	} else {
+		ep, err = rs.repo.Epoch(args.Id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEpoch(&ep, rs.repo), nil
 }
 
 // Resolves the last staker id in Opera blockchain.
@@ -0,0 +1,145 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"fantom-api-graphql/internal/repository"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"time"
+)
+
+// Staker represents resolvable staker information.
+type Staker struct {
+	repo repository.Repository
+	types.Staker
+}
+
+// NewStaker creates a new instance of resolvable staker.
+func NewStaker(st *types.Staker, repo repository.Repository) *Staker {
+	return &Staker{
+		repo:   repo,
+		Staker: *st,
+	}
+}
+
+// Staker resolves a staker information by numeric id, or by address,
+// optionally at the given block. Nil is resolved if neither is provided.
+func (rs *rootResolver) Staker(args *struct {
+	Id      *hexutil.Uint64
+	Address *common.Address
+	Block   *hexutil.Uint64
+}) (*Staker, error) {
+	var st *types.Staker
+	var err error
+
+	// load by the id, or by the address if provided
+	switch {
+	case args.Id != nil && args.Block != nil:
+		st, err = rs.repo.StakerAt(*args.Id, *args.Block)
+	case args.Id != nil:
+		st, err = rs.repo.Staker(*args.Id)
+	case args.Address != nil && args.Block != nil:
+		st, err = rs.repo.StakerByAddressAt(*args.Address, *args.Block)
+	case args.Address != nil:
+		st, err = rs.repo.StakerByAddress(*args.Address)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return NewStaker(st, rs.repo), nil
+}
+
+// Stakers resolves the list of all the stakers known to the SFC contract.
+func (rs *rootResolver) Stakers() ([]*Staker, error) {
+	// get the stakers
+	sl, err := rs.repo.Stakers()
+	if err != nil {
+		return nil, err
+	}
+
+	// make the resolvable list
+	list := make([]*Staker, len(sl))
+	for i := range sl {
+		list[i] = NewStaker(&sl[i], rs.repo)
+	}
+
+	return list, nil
+}
+
+// Delegations resolves the list of delegations of the given account
+// from the SFC contract, optionally at the given block.
+func (rs *rootResolver) Delegations(args *struct {
+	Address common.Address
+	Block   *hexutil.Uint64
+}) ([]types.Delegation, error) {
+	return rs.repo.Delegations(args.Address, args.Block)
+}
+
+// IsStakeLocked resolves the staker stake being locked right now.
+func (st *Staker) IsStakeLocked() bool {
+	return uint64(time.Now().Unix()) < uint64(st.LockedUntil)
+}
+
+// StakerInfo resolves the extended staker information, if available.
+func (st *Staker) StakerInfo() *types.StakerInfo {
+	// try to get the info
+	sti, err := st.repo.StakerInfo(st.Id)
+	if err != nil {
+		return nil
+	}
+	return sti
+}
+
+// stakerPerformanceDefaultEpochs is the number of the latest epochs
+// used for staker performance if the range is not specified.
+const stakerPerformanceDefaultEpochs = 100
+
+// Performance resolves the performance of the staker in the given range of epochs.
+// The latest epochs are used if the range is not specified.
+func (st *Staker) Performance(args *struct {
+	FromEpoch *hexutil.Uint64
+	ToEpoch   *hexutil.Uint64
+}) (*types.StakerPerformance, error) {
+	// get the end of the range
+	var to hexutil.Uint64
+	if args.ToEpoch != nil {
+		to = *args.ToEpoch
+	} else {
+		ep, err := st.repo.CurrentEpoch()
+		if err != nil {
+			return nil, err
+		}
+		to = ep
+	}
+
+	// get the start of the range
+	var from hexutil.Uint64
+	if args.FromEpoch != nil {
+		from = *args.FromEpoch
+	} else if to >= stakerPerformanceDefaultEpochs {
+		from = to - stakerPerformanceDefaultEpochs + 1
+	}
+
+	return st.repo.StakerPerformance(st.Id, from, to)
+}
+
+// UpdateStakerInfo reloads the extended staker information from the staker info
+// contract and the document it references. Staker operators use it to publish
+// the changed information without waiting for the cache refresh.
+func (rs *rootResolver) UpdateStakerInfo(args *struct{ StakerId hexutil.Uint64 }) (*types.StakerInfo, error) {
+	// make sure the staker exists
+	st, err := rs.repo.Staker(args.StakerId)
+	if err != nil {
+		return nil, err
+	}
+	if st.Id == 0 {
+		return nil, fmt.Errorf("staker #%d not found", uint64(args.StakerId))
+	}
+
+	return rs.repo.RefreshStakerInfo(st.Id)
+}
@@ -0,0 +1,111 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"context"
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	"sync"
+	"time"
+)
+
+// onProposalChannelCapacity is the number of governance proposal changes held in memory for being broadcast to subscriber.
+const onProposalChannelCapacity = 100
+
+// subscriptOnProposal represents reference to a subscriber to onGovProposalChange events broadcast.
+type subscriptOnProposal struct {
+	contract *common.Address
+	stop     <-chan struct{}
+	events   chan<- *types.GovernanceProposal
+}
+
+// OnGovProposalChange resolves subscription to state changes of governance proposals,
+// optionally limited to the given governance contract.
+func (rs *rootResolver) OnGovProposalChange(ctx context.Context, args *struct{ Contract *common.Address }) <-chan *types.GovernanceProposal {
+	// make the stream
+	c := make(chan *types.GovernanceProposal, onProposalChannelCapacity)
+
+	// subscribe to event dispatch
+	rs.subscribeOnProposal <- &subscriptOnProposal{
+		contract: args.Contract,
+		stop:     ctx.Done(),
+		events:   c,
+	}
+
+	return c
+}
+
+// addProposalSubscriber adds a new subscription to onGovProposalChange events.
+func (rs *rootResolver) addProposalSubscriber(sub *subscriptOnProposal) {
+	id, err := uuid()
+	if err == nil {
+		// add the subscriber to the map
+		rs.proposalSubscribers[id] = sub
+	} else {
+		// log critical issue
+		rs.log.Critical("can not generate UUID for new onGovProposalChange subscriber")
+		rs.log.Critical(err)
+	}
+}
+
+// dispatchOnProposal dispatches onGovProposalChange event to subscribers of the governance contract.
+// The state of the proposal is loaded by the subscriber go routines so the dispatch loop is not blocked;
+// the load is shared by all the subscribers of the event.
+func (rs *rootResolver) dispatchOnProposal(evt *types.GovernanceProposalEvent) {
+	// nobody is listening
+	if 0 == len(rs.proposalSubscribers) {
+		return
+	}
+
+	// load the current state of the proposal once
+	var once sync.Once
+	var prop *types.GovernanceProposal
+	load := func() *types.GovernanceProposal {
+		once.Do(func() {
+			var err error
+			prop, err = rs.repo.GovernanceProposal(&evt.Governance, evt.ProposalId)
+			if err != nil {
+				rs.log.Errorf("can not load governance proposal #%d of %s; %s", uint64(evt.ProposalId), evt.Governance.String(), err.Error())
+			}
+		})
+		return prop
+	}
+
+	// broadcast the event in separate go routines so we don't block here
+	for id, sub := range rs.proposalSubscribers {
+		if sub.contract == nil || *sub.contract == evt.Governance {
+			go rs.notifyOnProposal(load, sub, id)
+		}
+	}
+}
+
+// notifyOnProposal broadcasts onGovProposalChange event to given subscriber.
+func (rs *rootResolver) notifyOnProposal(load func() *types.GovernanceProposal, sub *subscriptOnProposal, id string) {
+	// check if the context isn't already closed in which case we just unsub and leave
+	select {
+	case <-sub.stop:
+		rs.unsubscribeOnProposal <- id
+		return
+	default:
+	}
+
+	// get the proposal; nothing to broadcast if not available
+	prop := load()
+	if prop == nil {
+		return
+	}
+
+	// broadcast
+	select {
+	case <-sub.stop:
+		// just unsub on broken context
+		rs.unsubscribeOnProposal <- id
+
+	case sub.events <- prop:
+		// push the proposal to subscriber
+
+	case <-time.After(time.Second):
+		// timeout reached without response? just remove the subscriber
+		rs.unsubscribeOnProposal <- id
+	}
+}
@@ -0,0 +1,85 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"context"
+	"fantom-api-graphql/internal/types"
+	"time"
+)
+
+// onNetworkStatsChannelCapacity is the number of network stats updates held in memory for being broadcast to subscriber.
+const onNetworkStatsChannelCapacity = 10
+
+// subscriptOnNetworkStats represents reference to a subscriber to onNetworkStats events broadcast.
+type subscriptOnNetworkStats struct {
+	resolution *string
+	stop       <-chan struct{}
+	events     chan<- *NetworkStats
+}
+
+// OnNetworkStats resolves subscription to updates of the network statistics
+// of the periods in progress, optionally limited to the given resolution.
+func (rs *rootResolver) OnNetworkStats(ctx context.Context, args *struct{ Resolution *string }) <-chan *NetworkStats {
+	// make the stream
+	c := make(chan *NetworkStats, onNetworkStatsChannelCapacity)
+
+	// subscribe to event dispatch
+	rs.subscribeOnNetworkStats <- &subscriptOnNetworkStats{
+		resolution: args.Resolution,
+		stop:       ctx.Done(),
+		events:     c,
+	}
+
+	return c
+}
+
+// addNetworkStatsSubscriber adds a new subscription to onNetworkStats events.
+func (rs *rootResolver) addNetworkStatsSubscriber(sub *subscriptOnNetworkStats) {
+	id, err := uuid()
+	if err == nil {
+		// add the subscriber to the map
+		rs.networkStatsSubscribers[id] = sub
+	} else {
+		// log critical issue
+		rs.log.Critical("can not generate UUID for new onNetworkStats subscriber")
+		rs.log.Critical(err)
+	}
+}
+
+// dispatchOnNetworkStats dispatches onNetworkStats event to subscribers of the resolution.
+func (rs *rootResolver) dispatchOnNetworkStats(evt *types.NetworkStats) {
+	// make the resolvable event
+	ns := NewNetworkStats(evt)
+
+	// broadcast the event in separate go routines so we don't block here
+	for id, sub := range rs.networkStatsSubscribers {
+		if sub.resolution == nil || *sub.resolution == evt.Resolution {
+			go rs.notifyOnNetworkStats(ns, sub, id)
+		}
+	}
+}
+
+// notifyOnNetworkStats broadcasts onNetworkStats event to given subscriber.
+func (rs *rootResolver) notifyOnNetworkStats(ns *NetworkStats, sub *subscriptOnNetworkStats, id string) {
+	// check if the context isn't already closed in which case we just unsub and leave
+	select {
+	case <-sub.stop:
+		rs.unsubscribeOnNetworkStats <- id
+		return
+	default:
+	}
+
+	// broadcast
+	select {
+	case <-sub.stop:
+		// just unsub on broken context
+		rs.unsubscribeOnNetworkStats <- id
+
+	case sub.events <- ns:
+		// push the update to subscriber
+
+	case <-time.After(time.Second):
+		// timeout reached without response? just remove the subscriber
+		rs.unsubscribeOnNetworkStats <- id
+	}
+}
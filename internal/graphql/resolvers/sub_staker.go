@@ -0,0 +1,102 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"context"
+	"fantom-api-graphql/internal/repository"
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"time"
+)
+
+// onStakerStatusChannelCapacity is the number of staker status changes held in memory for being broadcast to subscriber.
+const onStakerStatusChannelCapacity = 100
+
+// StakerStatusChange represents resolvable staker status change event.
+type StakerStatusChange struct {
+	repo repository.Repository
+	types.StakerStatusEvent
+}
+
+// subscriptOnStakerStatus represents reference to a subscriber to onStakerStatusChange events broadcast.
+type subscriptOnStakerStatus struct {
+	stakerId *hexutil.Uint64
+	stop     <-chan struct{}
+	events   chan<- *StakerStatusChange
+}
+
+// Staker resolves the current state of the staker.
+func (sc *StakerStatusChange) Staker() (*Staker, error) {
+	st, err := sc.repo.Staker(sc.StakerId)
+	if err != nil {
+		return nil, err
+	}
+	return NewStaker(st, sc.repo), nil
+}
+
+// OnStakerStatusChange resolves subscription to status changes of stakers,
+// optionally limited to the given staker.
+func (rs *rootResolver) OnStakerStatusChange(ctx context.Context, args *struct{ StakerId *hexutil.Uint64 }) <-chan *StakerStatusChange {
+	// make the stream
+	c := make(chan *StakerStatusChange, onStakerStatusChannelCapacity)
+
+	// subscribe to event dispatch
+	rs.subscribeOnStakerStatus <- &subscriptOnStakerStatus{
+		stakerId: args.StakerId,
+		stop:     ctx.Done(),
+		events:   c,
+	}
+
+	return c
+}
+
+// addStakerStatusSubscriber adds a new subscription to onStakerStatusChange events.
+func (rs *rootResolver) addStakerStatusSubscriber(sub *subscriptOnStakerStatus) {
+	id, err := uuid()
+	if err == nil {
+		// add the subscriber to the map
+		rs.stakerStatusSubscribers[id] = sub
+	} else {
+		// log critical issue
+		rs.log.Critical("can not generate UUID for new onStakerStatusChange subscriber")
+		rs.log.Critical(err)
+	}
+}
+
+// dispatchOnStakerStatus dispatches onStakerStatusChange event to subscribers of the staker.
+func (rs *rootResolver) dispatchOnStakerStatus(evt *types.StakerStatusEvent) {
+	// make the resolvable event
+	sc := &StakerStatusChange{repo: rs.repo, StakerStatusEvent: *evt}
+
+	// broadcast the event in separate go routines so we don't block here
+	for id, sub := range rs.stakerStatusSubscribers {
+		if sub.stakerId == nil || *sub.stakerId == evt.StakerId {
+			go rs.notifyOnStakerStatus(sc, sub, id)
+		}
+	}
+}
+
+// notifyOnStakerStatus broadcasts onStakerStatusChange event to given subscriber.
+func (rs *rootResolver) notifyOnStakerStatus(sc *StakerStatusChange, sub *subscriptOnStakerStatus, id string) {
+	// check if the context isn't already closed in which case we just unsub and leave
+	select {
+	case <-sub.stop:
+		rs.unsubscribeOnStakerStatus <- id
+		return
+	default:
+	}
+
+	// broadcast
+	select {
+	case <-sub.stop:
+		// just unsub on broken context
+		rs.unsubscribeOnStakerStatus <- id
+
+	case sub.events <- sc:
+		// push the change to subscriber
+
+	case <-time.After(time.Second):
+		// timeout reached without response? just remove the subscriber
+		rs.unsubscribeOnStakerStatus <- id
+	}
+}
@@ -0,0 +1,82 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"context"
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	"time"
+)
+
+// onSwapChannelCapacity is the number of new swap events held in memory for being broadcast to subscriber.
+const onSwapChannelCapacity = 500
+
+// subscriptOnSwap represents reference to a subscriber to onPairSwap events broadcast.
+type subscriptOnSwap struct {
+	pair   common.Address
+	stop   <-chan struct{}
+	events chan<- *types.UniswapAction
+}
+
+// OnPairSwap resolves subscription to new swaps of the given Uniswap pair.
+func (rs *rootResolver) OnPairSwap(ctx context.Context, args *struct{ Pair common.Address }) <-chan *types.UniswapAction {
+	// make the stream
+	c := make(chan *types.UniswapAction, onSwapChannelCapacity)
+
+	// subscribe to event dispatch
+	rs.subscribeOnSwap <- &subscriptOnSwap{
+		pair:   args.Pair,
+		stop:   ctx.Done(),
+		events: c,
+	}
+
+	return c
+}
+
+// addSwapSubscriber adds a new subscription to onPairSwap events.
+func (rs *rootResolver) addSwapSubscriber(sub *subscriptOnSwap) {
+	id, err := uuid()
+	if err == nil {
+		// add the subscriber to the map
+		rs.swapSubscribers[id] = sub
+	} else {
+		// log critical issue
+		rs.log.Critical("can not generate UUID for new onPairSwap subscriber")
+		rs.log.Critical(err)
+	}
+}
+
+// dispatchOnSwap dispatches onPairSwap event to subscribers of the swap pair.
+func (rs *rootResolver) dispatchOnSwap(swap *types.UniswapAction) {
+	// broadcast the event in separate go routines so we don't block here
+	for id, sub := range rs.swapSubscribers {
+		if sub.pair == swap.Pair {
+			go rs.notifyOnSwap(swap, sub, id)
+		}
+	}
+}
+
+// notifyOnSwap broadcasts onPairSwap event to given subscriber.
+func (rs *rootResolver) notifyOnSwap(swap *types.UniswapAction, sub *subscriptOnSwap, id string) {
+	// check if the context isn't already closed in which case we just unsub and leave
+	select {
+	case <-sub.stop:
+		rs.unsubscribeOnSwap <- id
+		return
+	default:
+	}
+
+	// broadcast
+	select {
+	case <-sub.stop:
+		// just unsub on broken context
+		rs.unsubscribeOnSwap <- id
+
+	case sub.events <- swap:
+		// push the swap to subscriber
+
+	case <-time.After(time.Second):
+		// timeout reached without response? just remove the subscriber
+		rs.unsubscribeOnSwap <- id
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fantom-api-graphql/internal/repository"
 	"fantom-api-graphql/internal/types"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"math/big"
 )
 
 // Transaction represents resolvable blockchain transaction structure.
@@ -87,3 +88,15 @@ func (trx *Transaction) Block() (*Block, error) {
 
 	return NewBlock(blk, trx.repo), nil
 }
+
+// Fee resolves the fee paid for the transaction processing in WEI; nil for pending transaction.
+func (trx *Transaction) Fee() *hexutil.Big {
+	// the gas used is known for processed transactions only
+	if trx.GasUsed == nil {
+		return nil
+	}
+
+	// fee = gas used * gas price
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(uint64(*trx.GasUsed)), trx.GasPrice.ToInt())
+	return (*hexutil.Big)(fee)
+}
@@ -10,8 +10,9 @@ import (
 
 // TransactionList represents resolvable list of blockchain transaction edges structure.
 type TransactionList struct {
-	repo repository.Repository
-	list *types.TransactionHashList
+	repo   repository.Repository
+	list   *types.TransactionHashList
+	filter *types.TransactionFilter
 }
 
 // TransactionListEdge represents a single edge of a transaction list structure.
@@ -29,28 +30,43 @@ func NewTransactionList(txs *types.TransactionHashList, repo repository.Reposito
 }
 
 // Transactions resolves list of blockchain transactions encapsulated in a listable structure.
+// The list is limited to transactions matching the filter, if provided.
 func (rs *rootResolver) Transactions(args *struct {
 	Cursor *Cursor
 	Count  int32
+	Filter *types.TransactionFilter
 }) (*TransactionList, error) {
 	// limit query size; the count can be either positive or negative
 	// this controls the loading direction
 	args.Count = listLimitCount(args.Count, listMaxEdgesPerRequest)
 
 	// get the transaction hash list from repository
-	txs, err := rs.repo.Transactions((*string)(args.Cursor), args.Count)
+	txs, err := rs.repo.Transactions((*string)(args.Cursor), args.Count, args.Filter)
 	if err != nil {
 		rs.log.Errorf("can not get transactions list; %s", err.Error())
 		return nil, err
 	}
 
-	return NewTransactionList(txs, rs.repo), nil
+	// the filtered list is counted on demand
+	tl := NewTransactionList(txs, rs.repo)
+	tl.filter = args.Filter
+	return tl, nil
 }
 
 // TotalCount resolves the total number of transactions in the list.
-func (tl *TransactionList) TotalCount() hexutil.Big {
+// The transactions matching the filter are counted only if requested.
+func (tl *TransactionList) TotalCount() (hexutil.Big, error) {
+	// count the filtered list
+	if tl.filter != nil {
+		tc, err := tl.repo.TransactionsFilteredCount(tl.filter)
+		if err != nil {
+			return hexutil.Big{}, err
+		}
+		return hexutil.Big(*new(big.Int).SetUint64(uint64(tc))), nil
+	}
+
 	val := (*hexutil.Big)(big.NewInt(int64(tl.list.Total)))
-	return *val
+	return *val, nil
 }
 
 // PageInfo resolves the current page information for the transaction list.
@@ -0,0 +1,126 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"fantom-api-graphql/internal/repository"
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// UniswapPair represents resolvable Uniswap pair of tokens.
+type UniswapPair struct {
+	repo repository.Repository
+	types.UniswapPair
+}
+
+// NewUniswapPair creates a new instance of resolvable Uniswap pair.
+func NewUniswapPair(pair *types.UniswapPair, repo repository.Repository) *UniswapPair {
+	return &UniswapPair{
+		repo:        repo,
+		UniswapPair: *pair,
+	}
+}
+
+// UniswapPosition represents resolvable liquidity position of an account in a Uniswap pair.
+type UniswapPosition struct {
+	repo repository.Repository
+	types.UniswapPosition
+}
+
+// NewUniswapPosition creates a new instance of resolvable Uniswap liquidity position.
+func NewUniswapPosition(pos *types.UniswapPosition, repo repository.Repository) *UniswapPosition {
+	return &UniswapPosition{
+		repo:            repo,
+		UniswapPosition: *pos,
+	}
+}
+
+// UniswapPair resolves the state of the given Uniswap pair.
+func (rs *rootResolver) UniswapPair(args *struct{ Address common.Address }) (*UniswapPair, error) {
+	// get the pair
+	pair, err := rs.repo.UniswapPair(&args.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewUniswapPair(pair, rs.repo), nil
+}
+
+// UniswapAmountsOut resolves the amounts of tokens received for the given
+// input amount swapped along the given path of tokens.
+func (rs *rootResolver) UniswapAmountsOut(args *struct {
+	AmountIn hexutil.Big
+	Tokens   []common.Address
+}) ([]hexutil.Big, error) {
+	return rs.repo.UniswapAmountsOut(args.AmountIn, args.Tokens)
+}
+
+// UniswapPositions resolves the list of liquidity positions of the given account
+// in the Uniswap pairs.
+func (rs *rootResolver) UniswapPositions(args *struct{ Owner common.Address }) ([]*UniswapPosition, error) {
+	// get the positions
+	pl, err := rs.repo.UniswapPositions(&args.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	// make the resolvable list
+	list := make([]*UniswapPosition, len(pl))
+	for i := range pl {
+		list[i] = NewUniswapPosition(&pl[i], rs.repo)
+	}
+
+	return list, nil
+}
+
+// UniswapActions resolves the list of the latest swaps and liquidity changes
+// on the Uniswap exchange, optionally filtered by the pair, the account
+// and the type of the action.
+func (rs *rootResolver) UniswapActions(args *struct {
+	Pair       *common.Address
+	Account    *common.Address
+	ActionType *string
+	Count      int32
+}) ([]types.UniswapAction, error) {
+	return rs.repo.UniswapActions(args.Pair, args.Account, args.ActionType, args.Count)
+}
+
+// PairCandles resolves the list of OHLCV candles of the given Uniswap pair
+// and resolution in the given time range.
+func (rs *rootResolver) PairCandles(args *struct {
+	Pair       common.Address
+	Resolution string
+	From       hexutil.Uint64
+	To         hexutil.Uint64
+}) ([]types.UniswapCandle, error) {
+	return rs.repo.UniswapCandles(&args.Pair, args.Resolution, uint64(args.From), uint64(args.To))
+}
+
+// Volume24h resolves the trading volume of the pair over the last 24 hours.
+func (pair *UniswapPair) Volume24h() (*types.UniswapVolume, error) {
+	return pair.repo.UniswapVolume24h(&pair.Address)
+}
+
+// Tvl0 resolves the total value locked in the pair expressed in the first token.
+func (pair *UniswapPair) Tvl0() (*hexutil.Big, error) {
+	tvl0, _, err := pair.repo.UniswapPairTvl(&pair.UniswapPair)
+	return tvl0, err
+}
+
+// Tvl1 resolves the total value locked in the pair expressed in the second token.
+func (pair *UniswapPair) Tvl1() (*hexutil.Big, error) {
+	_, tvl1, err := pair.repo.UniswapPairTvl(&pair.UniswapPair)
+	return tvl1, err
+}
+
+// Pair resolves the Uniswap pair of the liquidity position.
+func (pos *UniswapPosition) Pair() (*UniswapPair, error) {
+	// get the pair
+	pair, err := pos.repo.UniswapPair(&pos.UniswapPosition.Pair)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewUniswapPair(pair, pos.repo), nil
+}
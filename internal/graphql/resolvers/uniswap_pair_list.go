@@ -0,0 +1,87 @@
+// Package resolvers implements GraphQL resolvers to incoming API requests.
+package resolvers
+
+import (
+	"fantom-api-graphql/internal/repository"
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"math/big"
+	"strconv"
+)
+
+// UniswapPairList represents resolvable list of Uniswap pair edges structure.
+type UniswapPairList struct {
+	repo repository.Repository
+	list *types.UniswapPairList
+}
+
+// UniswapPairListEdge represents a single edge of a Uniswap pair list structure.
+type UniswapPairListEdge struct {
+	Pair   *UniswapPair
+	Cursor Cursor
+}
+
+// NewUniswapPairList builds new resolvable list of Uniswap pairs.
+func NewUniswapPairList(pl *types.UniswapPairList, repo repository.Repository) *UniswapPairList {
+	return &UniswapPairList{
+		repo: repo,
+		list: pl,
+	}
+}
+
+// UniswapPairs resolves list of Uniswap pairs encapsulated in a listable structure.
+func (rs *rootResolver) UniswapPairs(args *struct {
+	Cursor *Cursor
+	Count  int32
+}) (*UniswapPairList, error) {
+	// limit query size; the count can be either positive or negative
+	// this controls the loading direction
+	args.Count = listLimitCount(args.Count, listMaxEdgesPerRequest)
+
+	// get the pair list from repository
+	pl, err := rs.repo.UniswapPairs((*string)(args.Cursor), args.Count)
+	if err != nil {
+		rs.log.Errorf("can not get uniswap pairs list; %s", err.Error())
+		return nil, err
+	}
+
+	return NewUniswapPairList(pl, rs.repo), nil
+}
+
+// TotalCount resolves the total number of pairs in the list.
+func (pl *UniswapPairList) TotalCount() hexutil.Big {
+	val := (*hexutil.Big)(big.NewInt(int64(pl.list.Total)))
+	return *val
+}
+
+// PageInfo resolves the current page information for the pair list.
+func (pl *UniswapPairList) PageInfo() (*ListPageInfo, error) {
+	// do we have any items?
+	if pl.list == nil || pl.list.Collection == nil || len(pl.list.Collection) == 0 {
+		return NewListPageInfo(nil, nil, false, false)
+	}
+
+	// get the first and last elements
+	first := Cursor(strconv.FormatUint(pl.list.First, 10))
+	last := Cursor(strconv.FormatUint(pl.list.Last, 10))
+	return NewListPageInfo(&first, &last, !pl.list.IsEnd, !pl.list.IsStart)
+}
+
+// Edges resolves list of edges for the linked pair list.
+func (pl *UniswapPairList) Edges() []*UniswapPairListEdge {
+	// do we have any items? return empty list if not
+	if pl.list == nil || pl.list.Collection == nil || len(pl.list.Collection) == 0 {
+		return make([]*UniswapPairListEdge, 0)
+	}
+
+	// make the list
+	edges := make([]*UniswapPairListEdge, len(pl.list.Collection))
+	for i, p := range pl.list.Collection {
+		edges[i] = &UniswapPairListEdge{
+			Pair:   NewUniswapPair(p, pl.repo),
+			Cursor: Cursor(strconv.FormatUint(p.Index, 10)),
+		}
+	}
+
+	return edges
+}
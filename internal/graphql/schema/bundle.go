@@ -2,6 +2,993 @@ package gqlschema
 
 // Auto generated GraphQL schema bundle; created 2020-10-09 12:18
 const schema = `
+# UniswapPairList is a list of Uniswap pair edges provided by sequential access request.
+type UniswapPairList {
+    # Edges contains provided edges of the sequential list.
+    edges: [UniswapPairListEdge!]!
+
+    # TotalCount is the maximum number of pairs available for sequential access.
+    totalCount: BigInt!
+
+    # PageInfo is an information about the current page of pair edges.
+    pageInfo: ListPageInfo!
+}
+
+# UniswapPairListEdge is a single edge in a sequential list of Uniswap pairs.
+type UniswapPairListEdge {
+    cursor: Cursor!
+    pair: UniswapPair!
+}
+
+# Delegation represents a delegation of an account to a staker
+# as recorded by the SFC contract.
+type Delegation {
+    # Address is the address of the delegating account.
+    address: Address!
+
+    # ToStakerId is the id of the staker the delegation belongs to.
+    toStakerId: Long!
+
+    # CreatedEpoch is the id of the epoch the delegation was created in.
+    createdEpoch: Long!
+
+    # CreatedTime is the unix time stamp of the delegation creation.
+    createdTime: Long!
+
+    # DeactivatedEpoch is the id of the epoch the delegation was deactivated in.
+    deactivatedEpoch: Long!
+
+    # DeactivatedTime is the unix time stamp of the delegation deactivation.
+    deactivatedTime: Long!
+
+    # Amount is the amount of delegated tokens in WEI.
+    amount: BigInt!
+
+    # PaidUntilEpoch is the id of the last epoch the rewards were claimed for.
+    paidUntilEpoch: Long!
+}
+
+# GasPriceSuggestion represents gas prices suggested for new transactions
+# derived from gas prices of transactions in recent blocks.
+type GasPriceSuggestion {
+    # Slow is the gas price in WEI for transactions not in a hurry.
+    slow: BigInt!
+
+    # Standard is the gas price in WEI for regular transactions.
+    standard: BigInt!
+
+    # Fast is the gas price in WEI for transactions to be processed quickly.
+    fast: BigInt!
+
+    # NodePrice is the gas price in WEI suggested by the connected node.
+    # Suggested prices never go below this price.
+    nodePrice: BigInt!
+
+    # Blocks is the number of recent blocks sampled.
+    blocks: Int!
+
+    # Samples is the number of transactions sampled.
+    samples: Int!
+}
+
+# CallInput represents a transaction call message used to estimate gas,
+# or to execute a read only call without creating a transaction.
+input CallInput {
+    # From is the address of the sender.
+    from: Address
+
+    # To is the address of the recipient; empty for contract creation.
+    to: Address
+
+    # Gas is the amount of gas provided for the call.
+    gas: Long
+
+    # GasPrice is the gas price in WEI.
+    gasPrice: BigInt
+
+    # Value is the amount of WEI transferred with the call.
+    value: BigInt
+
+    # Data is the call data, or the contract byte code.
+    data: Bytes
+}
+
+# NetworkStatsResolution represents the aggregation period of the network statistics.
+enum NetworkStatsResolution {
+    # HOUR represents hourly aggregated statistics.
+    HOUR
+
+    # DAY represents daily aggregated statistics, days start at midnight UTC.
+    DAY
+}
+
+# NetworkStats represents the network activity aggregated over a period of time.
+type NetworkStats {
+    # Resolution is the aggregation period of the statistics.
+    resolution: NetworkStatsResolution!
+
+    # Timestamp is the unix time stamp of the period start.
+    timestamp: Long!
+
+    # Duration is the number of seconds covered by the statistics.
+    # It's shorter than the full period for the period in progress.
+    duration: Long!
+
+    # TxCount is the number of transactions processed in the period.
+    txCount: Long!
+
+    # Tps is the average number of transactions per second in the period.
+    tps: Float!
+
+    # GasUsed is the total amount of gas used by the blocks of the period.
+    gasUsed: Long!
+
+    # Senders is the number of unique accounts sending transactions in the period.
+    senders: Long!
+
+    # NewAccounts is the number of accounts with the first transaction in the period.
+    newAccounts: Long!
+
+    # NewContracts is the number of smart contracts deployed in the period.
+    newContracts: Long!
+
+    # Volume is the total amount of WEI transferred in the period.
+    volume: BigInt!
+}
+
+# TransactionDirection represents the direction of a transaction from the account point of view.
+enum TransactionDirection {
+    # IN represents transactions received by the account.
+    IN
+
+    # OUT represents transactions sent by the account.
+    OUT
+}
+
+# AccountTxSummary represents aggregated transactions of an account.
+type AccountTxSummary {
+    # Total value sent by the account in WEI.
+    totalSent: BigInt!
+
+    # Total value received by the account in WEI.
+    totalReceived: BigInt!
+
+    # Number of transactions sent by the account.
+    sentCount: Long!
+
+    # Number of transactions received by the account, including the contract creation.
+    receivedCount: Long!
+
+    # Time stamp of the first transaction of the account.
+    firstActivity: Long
+
+    # Time stamp of the latest transaction of the account.
+    lastActivity: Long
+
+    # Are the values of all the transactions included in the totals.
+    # Transactions stored before the values were recorded are counted,
+    # but their values are missing until the data backfill is complete.
+    isComplete: Boolean!
+}
+
+# TransactionFilter represents a set of conditions applied to a list of transactions.
+# Conditions not provided are not applied.
+input TransactionFilter {
+    # Address of the sender of the transaction.
+    sender: Address
+
+    # Address of the recipient of the transaction.
+    recipient: Address
+
+    # Limit the list to contract creating transactions.
+    contractCreation: Boolean = false
+
+    # Minimal value transferred in WEI, inclusive.
+    minValue: BigInt
+
+    # Maximal value transferred in WEI, inclusive.
+    maxValue: BigInt
+
+    # Minimal time stamp of the transaction, inclusive.
+    fromTime: Long
+
+    # Maximal time stamp of the transaction, inclusive.
+    toTime: Long
+
+    # Minimal block number of the transaction, inclusive.
+    fromBlock: Long
+
+    # Maximal block number of the transaction, inclusive.
+    toBlock: Long
+
+    # Status of the transaction; 1 for success, 0 for failure.
+    status: Long
+}
+
+# SearchResult represents an entity matching a search text.
+union SearchResult = Block | Transaction | Account | Contract | DefiToken | Staker
+
+# StakerSample represents the state of a staker observed during an epoch.
+type StakerSample {
+    # Id number of the staker.
+    stakerId: Long!
+
+    # Id of the epoch the sample belongs to.
+    epoch: Long!
+
+    # Time stamp of the latest observation of the staker in the epoch.
+    time: Long!
+
+    # Binary encoded status of the staker.
+    status: Long!
+
+    # Is the staker active.
+    isActive: Boolean!
+
+    # Is the staker offline.
+    isOffline: Boolean!
+
+    # Is the staker considered to be cheater.
+    isCheater: Boolean!
+
+    # Was the staker observed offline during the epoch.
+    wasOffline: Boolean!
+
+    # Number of times the staker went offline during the epoch.
+    offlineIncidents: Int!
+
+    # How many blocks the staker missed.
+    missedBlocks: Long!
+
+    # Number of seconds the staker is offline.
+    downtime: Long!
+
+    # How many blocks the staker missed during the epoch.
+    epochMissedBlocks: Long!
+
+    # Number of seconds the staker was offline during the epoch.
+    epochDowntime: Long!
+
+    # Number of seconds of the epoch covered by the observations.
+    observedTime: Long!
+}
+
+# StakerPerformance represents the performance of a staker in a range of epochs.
+type StakerPerformance {
+    # Id number of the staker.
+    stakerId: Long!
+
+    # First epoch of the range.
+    fromEpoch: Long!
+
+    # Last epoch of the range.
+    toEpoch: Long!
+
+    # Number of epochs in the range with a recorded sample.
+    sampledEpochs: Int!
+
+    # Percentage of the observed time the staker was online,
+    # derived from the missed blocks and downtime counters of the staker.
+    uptime: Float!
+
+    # Number of blocks missed by the staker in the range.
+    missedBlocks: Long!
+
+    # Number of seconds the staker was offline in the range.
+    downtime: Long!
+
+    # Number of times the staker went offline in the range.
+    offlineIncidents: Int!
+
+    # Was the staker flagged as a cheater in the range.
+    isCheater: Boolean!
+
+    # The first epoch the staker was flagged as a cheater in.
+    cheaterEpoch: Long
+
+    # List of the states sampled in the range.
+    samples: [StakerSample!]!
+}
+
+# StakerStatusChange represents a change of a staker status.
+type StakerStatusChange {
+    # Id number of the staker.
+    stakerId: Long!
+
+    # Id of the epoch the change was observed in.
+    epoch: Long!
+
+    # Time stamp of the change observation.
+    time: Long!
+
+    # Binary encoded status of the staker before the change.
+    prevStatus: Long!
+
+    # Binary encoded status of the staker after the change.
+    status: Long!
+
+    # Is the staker active.
+    isActive: Boolean!
+
+    # Is the staker offline.
+    isOffline: Boolean!
+
+    # Is the staker considered to be cheater.
+    isCheater: Boolean!
+
+    # The current state of the staker.
+    staker: Staker!
+}
+
+# RewardsEstimate represents the expected staking rewards calculated
+# from the state of the latest sealed epoch.
+type RewardsEstimate {
+    # Amount of tokens staked, or delegated.
+    amount: BigInt!
+
+    # Duration of the staking period in seconds.
+    duration: Long!
+
+    # IsValidator signals the amount is the own stake of a validator.
+    # Delegators pay the validator commission from their rewards.
+    isValidator: Boolean!
+
+    # LockDuration is the lockup duration of the stake in seconds.
+    lockDuration: Long!
+
+    # BaseReward is the expected base reward for the period.
+    baseReward: BigInt!
+
+    # TxReward is the expected share on transaction fees for the period.
+    txReward: BigInt!
+
+    # Total is the total expected reward for the period.
+    total: BigInt!
+
+    # Apr is the annual percentage rate of the expected reward.
+    apr: Float!
+}
+
+# EpochList is a list of sealed epoch edges provided by sequential access request.
+type EpochList {
+    # Edges contains provided edges of the sequential list.
+    edges: [EpochListEdge!]!
+
+    # TotalCount is the maximum number of epochs available for sequential access.
+    totalCount: BigInt!
+
+    # PageInfo is an information about the current page of epoch edges.
+    pageInfo: ListPageInfo!
+}
+
+# EpochListEdge is a single edge in a sequential list of epochs.
+type EpochListEdge {
+    cursor: Cursor!
+    epoch: Epoch!
+}
+
+# EpochValidator represents the state of a validator in a sealed epoch.
+type EpochValidator {
+    # Epoch is the id of the epoch.
+    epoch: Long!
+
+    # StakerId is the id of the validator staker.
+    stakerId: Long!
+
+    # StakeAmount is the amount of own stake of the validator in WEI.
+    stakeAmount: BigInt!
+
+    # DelegatedMe is the amount delegated to the validator in WEI.
+    delegatedMe: BigInt!
+
+    # BaseRewardWeight is the base reward weight of the validator in the epoch.
+    baseRewardWeight: BigInt!
+
+    # TxRewardWeight is the transaction reward weight of the validator in the epoch.
+    txRewardWeight: BigInt!
+
+    # Reward is the raw reward of the validator in the epoch in WEI, including the delegators share.
+    reward: BigInt!
+
+    # MissedBlocks is the number of blocks missed by the validator in the epoch.
+    # It's known only for epochs observed live when they were sealed.
+    missedBlocks: Long
+
+    # Downtime is the downtime of the validator in the epoch in seconds.
+    # It's known only for epochs observed live when they were sealed.
+    downtime: Long
+
+    # MissedBlocksTotal is the total number of blocks missed by the validator when the epoch was sealed.
+    missedBlocksTotal: Long
+
+    # DowntimeTotal is the total downtime of the validator when the epoch was sealed.
+    downtimeTotal: Long
+}
+
+# GovernanceProposalStatus represents the status of a governance proposal.
+enum GovernanceProposalStatus {
+    # ACTIVE represents a proposal open for voting.
+    ACTIVE
+
+    # RESOLVED represents a proposal resolved by the voting.
+    RESOLVED
+
+    # FAILED represents a proposal rejected by the voting.
+    FAILED
+
+    # CANCELED represents a proposal canceled before resolving.
+    CANCELED
+
+    # EXPIRED represents a resolved proposal not executed in time.
+    EXPIRED
+}
+
+# GovernanceContract represents a configured on-chain governance contract.
+type GovernanceContract {
+    # Name is the configured name of the governance contract.
+    name: String!
+
+    # Address is the address of the governance contract.
+    address: Address!
+
+    # ProposalsCount is the total number of proposals of the governance.
+    proposalsCount: Long!
+
+    # Proposals is the list of the latest proposals of the governance.
+    # Active only filter inspects the latest 200 proposals.
+    proposals(activeOnly: Boolean = false): [GovernanceProposal!]!
+
+    # Proposal is the proposal of the given id.
+    proposal(id: Long!): GovernanceProposal!
+}
+
+# GovernanceProposal represents a proposal of an on-chain governance.
+type GovernanceProposal {
+    # Governance is the address of the governance contract.
+    governance: Address!
+
+    # Id is the identifier of the proposal.
+    id: Long!
+
+    # Name is the name of the proposal.
+    name: String!
+
+    # Description is the description of the proposal.
+    description: String!
+
+    # Contract is the address of the proposal contract.
+    contract: Address!
+
+    # ProposalType is the type of the proposal template.
+    proposalType: Long!
+
+    # IsExecutable signals the proposal is executed on resolving.
+    isExecutable: Boolean!
+
+    # MinVotes is the minimal weight of votes required to resolve the proposal.
+    minVotes: BigInt!
+
+    # MinAgreement is the minimal agreement required for an option to win.
+    minAgreement: BigInt!
+
+    # OpinionScales is the list of opinion scales voters choose from.
+    opinionScales: [BigInt!]!
+
+    # Options is the list of options with their weighted results.
+    options: [GovernanceOption!]!
+
+    # VotingStarts is the unix time stamp of the voting start.
+    votingStarts: Long!
+
+    # VotingMayEnd is the unix time stamp the voting may be resolved since.
+    votingMayEnd: Long!
+
+    # VotingMustEnd is the unix time stamp the voting must be resolved by.
+    votingMustEnd: Long!
+
+    # Status is the status of the proposal.
+    status: GovernanceProposalStatus!
+
+    # Votes is the total weight of votes cast.
+    votes: BigInt!
+
+    # WinnerId is the id of the winning option, if resolved.
+    winnerId: Long
+}
+
+# GovernanceOption represents an option of a governance proposal with its weighted result.
+type GovernanceOption {
+    # Id is the identifier of the option.
+    id: Long!
+
+    # Name is the name of the option.
+    name: String!
+
+    # Votes is the total weight of votes for the option.
+    votes: BigInt!
+
+    # AgreementRatio is the ratio of the agreement of the option.
+    agreementRatio: BigInt!
+
+    # Agreement is the weighted agreement of the option.
+    agreement: BigInt!
+}
+
+# GovernanceVote represents a vote cast by an account in a governance proposal.
+type GovernanceVote {
+    # Governance is the address of the governance contract.
+    governance: Address!
+
+    # ProposalId is the identifier of the proposal.
+    proposalId: Long!
+
+    # Proposal is the proposal the vote has been cast in.
+    proposal: GovernanceProposal!
+
+    # Voter is the address of the voting account.
+    voter: Address!
+
+    # DelegatedTo is the address of the validator the vote is delegated to.
+    delegatedTo: Address!
+
+    # Choices is the list of opinions chosen for each of the proposal options.
+    choices: [Long!]!
+
+    # Weight is the weight of the vote.
+    weight: BigInt!
+
+    # TransactionHash is the hash of the vote transaction.
+    transactionHash: Hash!
+
+    # TimeStamp is the unix time stamp of the vote.
+    timeStamp: Long!
+}
+
+# GovernanceVotingPower represents the governance voting power of an account.
+type GovernanceVotingPower {
+    # Address is the address of the account.
+    address: Address!
+
+    # Stake is the amount of the own validator stake.
+    stake: BigInt!
+
+    # DelegatedMe is the amount delegated to the account as a validator.
+    delegatedMe: BigInt!
+
+    # Delegated is the amount the account delegated to validators.
+    delegated: BigInt!
+
+    # Total is the total voting power of the account.
+    total: BigInt!
+}
+
+# Ballot represents an official voting ballot contract deployed
+# by one of the official voting sources.
+type Ballot {
+    # Address is the address of the ballot contract.
+    address: Address!
+
+    # Name is the name of the ballot.
+    name: String!
+
+    # DetailsUrl is the URL of the ballot details.
+    detailsUrl: String!
+
+    # Start is the unix time stamp of the voting start.
+    start: Long!
+
+    # End is the unix time stamp of the voting end.
+    end: Long!
+
+    # IsActive signals the ballot is open for voting right now.
+    isActive: Boolean!
+
+    # IsFinalized signals the ballot has been finalized and the winner is known.
+    isFinalized: Boolean!
+
+    # TotalVotes is the number of votes cast.
+    totalVotes: Long!
+
+    # Proposals is the list of proposals of the ballot with their vote tally.
+    proposals: [BallotProposal!]!
+
+    # Winner is the index of the winning proposal, if finalized.
+    winner: Int
+}
+
+# BallotProposal represents a proposal of a voting ballot with its vote tally.
+type BallotProposal {
+    # Name is the name of the proposal.
+    name: String!
+
+    # Weight is the total weight of the votes for the proposal.
+    weight: BigInt!
+
+    # Votes is the number of votes for the proposal.
+    votes: Long!
+}
+
+# Vote represents a vote cast by an account in an official voting ballot.
+type Vote {
+    # Ballot is the ballot the vote has been cast in.
+    ballot: Ballot!
+
+    # Voter is the address of the voting account.
+    voter: Address!
+
+    # Proposal is the index of the proposal voted for.
+    proposal: Int!
+
+    # TransactionHash is the hash of the vote transaction.
+    transactionHash: Hash!
+
+    # TimeStamp is the unix time stamp of the vote.
+    timeStamp: Long!
+}
+
+# UniswapActionType represents the type of action on a Uniswap pair.
+enum UniswapActionType {
+    # SWAP represents a swap of tokens.
+    SWAP
+
+    # MINT represents liquidity added to the pair.
+    MINT
+
+    # BURN represents liquidity removed from the pair.
+    BURN
+}
+
+# UniswapCandleResolution represents the time resolution of Uniswap pair candles.
+enum UniswapCandleResolution {
+    # MINUTE represents one minute candles.
+    MINUTE
+
+    # FIVE_MINUTES represents five minutes candles.
+    FIVE_MINUTES
+
+    # HOUR represents one hour candles.
+    HOUR
+
+    # DAY represents one day candles.
+    DAY
+}
+
+# UniswapPair represents a pair of tokens traded on the Uniswap exchange.
+type UniswapPair {
+    # Address is the address of the pair contract.
+    address: Address!
+
+    # Token0 is the address of the first token of the pair.
+    token0: Address!
+
+    # Token1 is the address of the second token of the pair.
+    token1: Address!
+
+    # Reserve0 is the amount of the first token in the pair pool.
+    reserve0: BigInt!
+
+    # Reserve1 is the amount of the second token in the pair pool.
+    reserve1: BigInt!
+
+    # ReservesTimeStamp is the unix time stamp of the last reserves update.
+    reservesTimeStamp: Long!
+
+    # TotalSupply is the total amount of liquidity tokens of the pair.
+    totalSupply: BigInt!
+
+    # Volume24h is the trading volume of the pair over the last 24 hours.
+    volume24h: UniswapVolume!
+
+    # Tvl0 is the total value locked in the pair expressed in the first token,
+    # calculated from the reserves and the DeFi price oracle prices of both tokens.
+    # Null if a token of the pair is not priced by the oracle.
+    tvl0: BigInt
+
+    # Tvl1 is the total value locked in the pair expressed in the second token,
+    # calculated from the reserves and the DeFi price oracle prices of both tokens.
+    # Null if a token of the pair is not priced by the oracle.
+    tvl1: BigInt
+}
+
+# UniswapVolume represents the trading volume of a Uniswap pair over a period of time.
+type UniswapVolume {
+    # Volume0 is the amount of the first token swapped.
+    volume0: BigInt!
+
+    # Volume1 is the amount of the second token swapped.
+    volume1: BigInt!
+
+    # Swaps is the number of swaps.
+    swaps: Int!
+}
+
+# UniswapCandle represents the price and volume of a Uniswap pair aggregated
+# over a time bucket. The price is the amount of the second token paid for one
+# unit of the first token, both in their smallest units.
+type UniswapCandle {
+    # Resolution is the time resolution of the candle.
+    resolution: UniswapCandleResolution!
+
+    # Time is the unix time stamp of the time bucket start.
+    time: Long!
+
+    # Open is the price after the first swap in the bucket.
+    open: Float!
+
+    # High is the highest price in the bucket.
+    high: Float!
+
+    # Low is the lowest price in the bucket.
+    low: Float!
+
+    # Close is the price after the last swap in the bucket.
+    close: Float!
+
+    # Volume0 is the amount of the first token swapped in the bucket.
+    volume0: BigInt!
+
+    # Volume1 is the amount of the second token swapped in the bucket.
+    volume1: BigInt!
+
+    # Swaps is the number of swaps in the bucket.
+    swaps: Int!
+}
+
+# UniswapPosition represents liquidity provided by an account to a Uniswap pair.
+type UniswapPosition {
+    # Pair is the Uniswap pair of the position.
+    pair: UniswapPair!
+
+    # Owner is the address of the liquidity provider.
+    owner: Address!
+
+    # Liquidity is the amount of liquidity tokens owned.
+    liquidity: BigInt!
+
+    # Amount0 is the share of the first token reserve owned.
+    amount0: BigInt!
+
+    # Amount1 is the share of the second token reserve owned.
+    amount1: BigInt!
+}
+
+# UniswapAction represents a swap, or a liquidity change, on a Uniswap pair.
+type UniswapAction {
+    # Type is the type of the action.
+    type: UniswapActionType!
+
+    # Pair is the address of the pair contract.
+    pair: Address!
+
+    # Account is the address of the account sending the transaction.
+    account: Address!
+
+    # Sender is the address of the contract calling the pair.
+    sender: Address!
+
+    # Recipient is the address receiving the output tokens, if any.
+    recipient: Address
+
+    # Amount0In is the amount of the first token sent to the pair.
+    amount0In: BigInt!
+
+    # Amount1In is the amount of the second token sent to the pair.
+    amount1In: BigInt!
+
+    # Amount0Out is the amount of the first token sent out of the pair.
+    amount0Out: BigInt!
+
+    # Amount1Out is the amount of the second token sent out of the pair.
+    amount1Out: BigInt!
+
+    # Reserve0 is the reserve of the first token after the action.
+    reserve0: BigInt!
+
+    # Reserve1 is the reserve of the second token after the action.
+    reserve1: BigInt!
+
+    # TransactionHash is the hash of the action transaction.
+    transactionHash: Hash!
+
+    # TimeStamp is the unix time stamp of the action.
+    timeStamp: Long!
+}
+
+# FMintRewardPool represents the state of the fMint reward distribution pool.
+type FMintRewardPool {
+    # Address is the address of the reward distribution contract.
+    address: Address!
+
+    # RewardToken is the address of the token used for rewards.
+    rewardToken: Address!
+
+    # RewardRate represents the amount of reward tokens distributed per second.
+    rewardRate: BigInt!
+
+    # TotalPrincipal represents the total principal eligible for rewards.
+    totalPrincipal: BigInt!
+
+    # EpochLength represents the length of the reward epoch in seconds.
+    epochLength: Long!
+
+    # EpochEnds represents the unix time stamp of the current reward epoch end.
+    epochEnds: Long!
+
+    # LastRewardPush represents the unix time stamp of the last reward push.
+    lastRewardPush: Long!
+
+    # RewardApplicableUntil represents the unix time stamp until which the rewards are accumulated.
+    rewardApplicableUntil: Long!
+}
+
+# FMintRewards represents the state of fMint rewards of an account.
+type FMintRewards {
+    # Principal represents the account principal eligible for rewards.
+    principal: BigInt!
+
+    # Earned represents the amount of reward earned by the account so far.
+    earned: BigInt!
+
+    # Unlocked represents the amount of reward accumulated so far and paid on the next claim.
+    unlocked: BigInt!
+
+    # Pending represents the amount of reward accumulated until the end of the current reward period.
+    pending: BigInt!
+
+    # Stashed represents the amount of reward stashed for the account.
+    stashed: BigInt!
+
+    # CanClaim signals the account can claim the earned reward now.
+    canClaim: Boolean!
+
+    # IsEligible signals the account collateral ratio is eligible for rewards.
+    isEligible: Boolean!
+}
+
+# FMintRewardClaim represents a reward claimed from the fMint reward distribution.
+type FMintRewardClaim {
+    # Amount is the amount of reward paid.
+    amount: BigInt!
+
+    # TransactionHash is the hash of the claim transaction.
+    transactionHash: Hash!
+
+    # TimeStamp is the unix time stamp of the claim.
+    timeStamp: Long!
+}
+
+# DefiToken represents a token registered in the DeFi tokens registry.
+type DefiToken {
+    # Address is the address of the token contract.
+    address: Address!
+
+    # Name is the name of the token.
+    name: String!
+
+    # Symbol is the symbol of the token.
+    symbol: String!
+
+    # Decimals is the number of decimals of the token.
+    decimals: Int!
+
+    # LogoUrl is the URL of the token logo image.
+    logoUrl: String!
+
+    # PriceDecimals is the number of decimals of the token price.
+    priceDecimals: Int!
+
+    # IsActive signals the token is active in the DeFi module.
+    isActive: Boolean!
+
+    # CanDeposit signals the token can be used as a collateral.
+    canDeposit: Boolean!
+
+    # CanMint signals the token can be minted.
+    canMint: Boolean!
+
+    # CanTrade signals the token has a price source and can be traded.
+    canTrade: Boolean!
+
+    # Price represents the current price of the token from the price oracle.
+    price: BigInt!
+}
+
+# DefiSettings represents the current configuration of the fMint DeFi module.
+type DefiSettings {
+    # MintFee4 represents the fee charged on minting in 4 decimals.
+    mintFee4: BigInt!
+
+    # MinCollateralRatio4 represents the lowest collateral to debt ratio allowed
+    # in 4 decimals. Positions below the ratio can be liquidated.
+    minCollateralRatio4: BigInt!
+
+    # RewardCollateralRatio4 represents the collateral to debt ratio needed
+    # to be eligible for rewards in 4 decimals.
+    rewardCollateralRatio4: BigInt!
+
+    # Decimals represents the number of decimals used by the ratios and fees.
+    decimals: Int!
+
+    # FMintAddressProvider is the address of the fMint address provider.
+    fMintAddressProvider: Address!
+
+    # FMintContract is the address of the fMint minter contract.
+    fMintContract: Address!
+
+    # FMintCollateralPool is the address of the fMint collateral pool.
+    fMintCollateralPool: Address!
+
+    # FMintDebtPool is the address of the fMint debt pool.
+    fMintDebtPool: Address!
+
+    # FMintRewardDistribution is the address of the fMint reward distribution.
+    fMintRewardDistribution: Address!
+
+    # PriceOracleAggregate is the address of the price oracle proxy.
+    priceOracleAggregate: Address!
+
+    # TokenRegistry is the address of the DeFi tokens registry.
+    tokenRegistry: Address!
+}
+
+# FMintTokenBalance represents a balance of a token in fMint pool.
+type FMintTokenBalance {
+    # Token is the address of the token.
+    token: Address!
+
+    # Balance is the amount of tokens.
+    balance: BigInt!
+
+    # Value is the value of the tokens in the reference denomination.
+    value: BigInt!
+}
+
+# FMintAccount represents collateral and debt positions of an account
+# in the fMint DeFi module.
+type FMintAccount {
+    # Address is the address of the account.
+    address: Address!
+
+    # Collateral is the list of tokens locked as collateral.
+    collateral: [FMintTokenBalance!]!
+
+    # Debt is the list of tokens minted as debt.
+    debt: [FMintTokenBalance!]!
+
+    # CollateralValue is the total value of the collateral.
+    collateralValue: BigInt!
+
+    # DebtValue is the total value of the debt.
+    debtValue: BigInt!
+
+    # CollateralRatio4 is the collateral to debt ratio in 4 decimals. Null if there is no debt.
+    collateralRatio4: BigInt
+
+    # CanBeLiquidated signals the collateral ratio is below the lowest allowed ratio.
+    canBeLiquidated: Boolean!
+
+    # RewardsEligible signals the collateral ratio is high enough to be eligible for rewards.
+    rewardsEligible: Boolean!
+
+    # Rewards represents the state of fMint rewards of the account.
+    rewards: FMintRewards!
+
+    # RewardClaims represents the list of the latest fMint reward claims of the account.
+    rewardClaims: [FMintRewardClaim!]!
+}
+
+# Compiler represents a smart contract compiler available for contract validation.
+type Compiler {
+    # Language is the smart contract language of the compiler, i.e. Solidity.
+    language: String!
+
+    # Version is the short version identifier of the compiler, i.e. 0.5.17.
+    version: String!
+
+    # FullVersion is the full version identifier reported by the compiler.
+    fullVersion: String!
+}
+
 # TransactionList is a list of transaction edges provided by sequential access request.
 type TransactionList {
     # Edges contains provided edges of the sequential list.
@@ -102,6 +1089,14 @@ type Transaction {
     # Value is the value sent along with this transaction in WEI.
     value: BigInt!
 
+    # GasPrice represents gas price provided by the sender in WEI.
+    gasPrice: BigInt!
+
+    # Fee is the fee paid for the transaction processing in WEI,
+    # i.e. the gas used multiplied by the gas price.
+    # If the transaction is pending, this field will be null.
+    fee: BigInt
+
     # GasUsed is the amount of gas that was used on processing this transaction.
     # If the transaction is pending, this field will be null.
     gasUsed: Long
@@ -131,6 +1126,15 @@ type Transaction {
     status: Long
 }
 
+# BlockClosest represents the direction of the closest block search by time.
+enum BlockClosest {
+    # BEFORE selects the latest block created at, or before the time stamp.
+    BEFORE
+
+    # AFTER selects the first block created at, or after the time stamp.
+    AFTER
+}
+
 # Block is an Opera block chain block.
 type Block {
     # Number is the number of this block, starting at 0 for the genesis block.
@@ -193,6 +1197,9 @@ type Epoch {
 
     "Total supply amount."
     totalSupply: BigInt!
+
+    "Validators is the list of validators of the epoch with their stake and reward weights."
+    validators: [EpochValidator!]!
 }
 
 # Contract defines block-chain smart contract information container
@@ -206,6 +1213,12 @@ type Contract {
     "transactionHash represents the smart contract deployment transaction hash."
     transactionHash: Hash!
 
+    """
+    Factory represents the address of the contract which deployed this contract.
+    Null if the contract was deployed directly by a transaction.
+    """
+    factory: Address
+
     "Smart contract name. Empty if not available."
     name: String!
 
@@ -224,22 +1237,90 @@ type Contract {
     "Smart contract compiler identifier. Empty if not available."
     compiler: String!
 
+    "Smart contract source code language, i.e. Solidity, or Vyper. Empty if not available."
+    language: String!
+
     "Smart contract source code. Empty if not available."
     sourceCode: String!
 
     "Smart contract ABI definition. Empty if not available."
     abi: String!
 
+    """
+    MergedAbi represents the contract ABI definition merged with the ABI
+    of the implementation, if the contract is a proxy. Use it to decode calls
+    made through the proxy. Empty if not available.
+    """
+    mergedAbi: String!
+
+    """
+    ProxyType represents the type of the proxy, i.e. EIP-1967, EIP-1822,
+    OpenZeppelin, or EIP-1167. Null if the contract is not a recognized proxy.
+    """
+    proxyType: String
+
+    "Implementation represents the address of the current implementation of a proxy contract."
+    implementation: Address
+
+    "Upgrades represents the list of implementation upgrades of a proxy contract."
+    upgrades: [ContractUpgrade!]!
+
     """
     Validated is the unix timestamp at which the source code was validated
     against the deployed byte code. Null if not validated yet.
     """
     validated: Long
 
+    """
+    MatchQuality represents the quality of the match between the validated
+    source code and the deployed byte code. Null if not validated yet.
+    """
+    matchQuality: ContractMatch
+
+    "ConstructorArgs represents the raw ABI encoded constructor arguments of the contract."
+    constructorArgs: Bytes!
+
+    "ConstructorArguments represents the decoded constructor arguments of the contract."
+    constructorArguments: [ContractArgument!]!
+
     "Timestamp is the unix timestamp at which this smart contract was deployed."
     timestamp: Long!
 }
 
+# ContractMatch represents the quality of the match between validated
+# smart contract source code and the deployed byte code.
+enum ContractMatch {
+    "FULL match means both the byte code and the compiler metadata are identical."
+    FULL
+
+    "PARTIAL match means the byte code is identical, but the compiler metadata differ."
+    PARTIAL
+}
+
+# ContractArgument represents a decoded smart contract constructor argument.
+type ContractArgument {
+    "Name is the name of the argument. Empty if not available."
+    name: String!
+
+    "Type is the ABI type of the argument."
+    type: String!
+
+    "Value is the textual representation of the argument value."
+    value: String!
+}
+
+# ContractUpgrade represents an implementation upgrade of a proxy contract.
+type ContractUpgrade {
+    "Implementation is the address of the new implementation."
+    implementation: Address!
+
+    "BlockNumber is the number of the block of the upgrade."
+    blockNumber: Long!
+
+    "TransactionHash is the hash of the upgrade transaction."
+    transactionHash: Hash!
+}
+
 # ContractValidationInput represents a set of data sent from client
 # to validate deployed contract with the provided source code.
 input ContractValidationInput {
@@ -272,6 +1353,19 @@ input ContractValidationInput {
 
     "Smart contract source code."
     sourceCode: String!
+
+    """
+    Optional language of the smart contract source code,
+    either Solidity, or Vyper. Solidity is used if not provided.
+    """
+    language: String
+
+    """
+    Optional version of the compiler used to build the deployed
+    byte code, i.e. 0.5.17. If not provided, the version of Solidity compiler
+    is detected from the metadata of the deployed byte code.
+    """
+    compilerVersion: String
 }
 
 # ContractList is a list of smart contract edges provided by sequential access request.
@@ -436,6 +1530,12 @@ type Staker {
 
     "StakerInfo represents extended staker information from smart contract."
     stakerInfo: StakerInfo
+
+    """
+    Performance of the staker aggregated from the states sampled in each epoch
+    of the given range. The latest 100 epochs are used if the range is not provided.
+    """
+    performance(fromEpoch: Long, toEpoch: Long): StakerPerformance!
 }
 
 
@@ -452,12 +1552,34 @@ type Account {
 
     """
     txList represents list of transactions of the account
-    in form of TransactionList.
+    in form of TransactionList. The list can be limited to the given direction,
+    counterparty, value range in WEI and time window, all inclusive.
     """
-    txList (cursor:Cursor, count:Int!): TransactionList!
+    txList (
+        cursor:Cursor,
+        count:Int!,
+        direction: TransactionDirection,
+        counterparty: Address,
+        minValue: BigInt,
+        maxValue: BigInt,
+        fromTime: Long,
+        toTime: Long
+    ): TransactionList!
+
+    "txSummary represents aggregated transactions of the account."
+    txSummary: AccountTxSummary!
 
     "Details about smart contract, if the account is a smart contract."
     contract: Contract
+
+    "Votes is the list of votes cast by the account in official voting ballots."
+    votes: [Vote!]!
+
+    "GovVotes is the list of votes cast by the account in governance proposals."
+    govVotes(contract: Address): [GovernanceVote!]!
+
+    "VotingPower is the governance voting power of the account."
+    votingPower: GovernanceVotingPower!
 }
 
 # Root schema definition
@@ -472,6 +1594,13 @@ type Query {
     "State represents the current state of the blockchain and network."
     state: CurrentState!
 
+    """
+    Search for blocks by number or hash, transactions by hash, accounts
+    and contracts by address, contracts and stakers by name and tokens by symbol.
+    Names are matched by full text search and by prefix.
+    """
+    search(text: String!): [SearchResult!]!
+
     "Total number of accounts active on the Opera blockchain."
     accountsActive:Long!
 
@@ -495,6 +1624,12 @@ type Query {
     """
     block(number:Long, hash: Hash):Block
 
+    """
+    Get the block closest to the given unix time stamp.
+    By default the latest block created at, or before the time stamp is given.
+    """
+    blockByTime(timestamp: Long!, closest: BlockClosest = BEFORE):Block
+
     "Get transaction information for given transaction hash."
     transaction(hash:Hash!):Transaction
 
@@ -504,8 +1639,10 @@ type Query {
     if negative, return edges before the cursor.
     For undefined cursor, positive <count> starts the list from top,
     negative <count> starts the list from bottom.
+    Optional <fromTime> and <toTime> limit the list to blocks created
+    in the given unix time range.
     """
-    blocks(cursor:Cursor, count:Int!):BlockList!
+    blocks(cursor:Cursor, count:Int!, fromTime: Long, toTime: Long):BlockList!
 
     """
     Get list of Transactions with at most <count> edges.
@@ -513,23 +1650,169 @@ type Query {
     if negative, return edges before the cursor.
     For undefined cursor, positive <count> starts the list from top,
     negative <count> starts the list from bottom.
+    The list is limited to transactions matching the filter, if provided.
     """
-    transactions(cursor:Cursor, count:Int!):TransactionList!
+    transactions(cursor:Cursor, count:Int!, filter: TransactionFilter):TransactionList!
 
     "Get the id of the current epoch of the Opera blockchain."
     currentEpoch:Long!
 
     """
-    Get information about specified epoch. Returns current epoch information
-    if id is not provided.
+    Get information about specified epoch. The state of the SFC contract
+    at the given block is used if the block is provided, the latest state is used otherwise.
+    """
+    epoch(id: Long!, block: Long): Epoch!
+
+    """
+    Get list of sealed Epochs with at most <count> edges.
+    If <count> is positive, return edges after the cursor,
+    if negative, return edges before the cursor.
+    For undefined cursor, positive <count> starts the list from top,
+    negative <count> starts the list from bottom.
+    """
+    epochs(cursor: Cursor, count: Int!): EpochList!
+
+    """
+    Estimate staking rewards of the given amount staked for the given duration
+    in seconds, based on the state of the latest sealed epoch.
+    Locked stake receives the lockup bonus for the locked part of the period,
+    the lock duration must be within the limits of the SFC contract.
+    """
+    estimateRewards(amount: BigInt!, duration: Long!, isValidator: Boolean = false, lockDuration: Long = 0): RewardsEstimate!
+
+    """
+    Get network statistics aggregated by the given resolution with the period start
+    between <from> and <to> unix time stamps. The range ends now by default
+    and starts 24 periods before its end if not specified.
+    Past periods are aggregated once the historical data are backfilled.
     """
-    epoch(id: Long!): Epoch!
+    networkStats(resolution: NetworkStatsResolution = HOUR, from: Long, to: Long): [NetworkStats!]!
+
+    "Get the current gas price in WEI suggested by the connected node."
+    gasPrice: BigInt!
+
+    """
+    Get slow, standard and fast gas price suggestions derived
+    from gas prices of transactions in recent blocks.
+    """
+    gasPriceSuggestion: GasPriceSuggestion!
+
+    "Estimate the amount of gas needed to execute the given transaction call."
+    estimateGas(tx: CallInput!): Long!
+
+    """
+    Execute a read only call of the contract at the given address with the given call data
+    and get the raw result. The state of the latest block is used if the block is not specified.
+    """
+    call(to: Address!, data: Bytes!, from: Address, block: Long): Bytes!
+
+    """
+    Execute a read only call of the given method of a validated contract. The contract ABI
+    is used to encode the arguments and decode the output values. Arguments are given in their
+    text form, i.e. numbers in decimal or hex, addresses, bytes in hex and arrays as JSON arrays.
+    The output values are returned as a JSON array.
+    Proxy contracts are called with the ABI of their validated implementation.
+    """
+    callContract(address: Address!, method: String!, args: [String!]! = [], block: Long): String!
 
     "The last staker id in Opera blockchain."
     lastStakerId: Long!
 
     "The number of stakers in Opera blockchain."
     stakersNum: Long!
+
+    "List of smart contract compilers available for the contract validation."
+    compilers: [Compiler!]!
+
+    "DefiConfiguration exposes the current DeFi contract setup."
+    defiConfiguration: DefiSettings!
+
+    """
+    FMintAccount provides DeFi fMint collateral and debt positions
+    of the given account.
+    """
+    fMintAccount(owner: Address!): FMintAccount!
+
+    "FMintRewardPool provides the state of the fMint reward distribution pool."
+    fMintRewardPool: FMintRewardPool!
+
+    "DefiTokens represents the list of tokens registered in the DeFi tokens registry."
+    defiTokens: [DefiToken!]!
+
+    """
+    DefiTokenPrice provides the current price of the given token
+    from the DeFi price oracle, in the token price decimals.
+    """
+    defiTokenPrice(token: Address!): BigInt!
+
+    """
+    Get list of Uniswap pairs with at most <count> edges.
+    If <count> is positive, return edges after the cursor,
+    if negative, return edges before the cursor.
+    For undefined cursor, positive <count> starts the list from top (the newest pair),
+    negative <count> starts the list from bottom.
+    """
+    uniswapPairs(cursor: Cursor, count: Int!): UniswapPairList!
+
+    "UniswapPair provides the state of the given Uniswap pair."
+    uniswapPair(address: Address!): UniswapPair!
+
+    """
+    UniswapAmountsOut calculates the amounts of tokens received for the given
+    input amount swapped along the given path of tokens.
+    """
+    uniswapAmountsOut(amountIn: BigInt!, tokens: [Address!]!): [BigInt!]!
+
+    "UniswapPositions provides the list of liquidity positions of the given account."
+    uniswapPositions(owner: Address!): [UniswapPosition!]!
+
+    """
+    UniswapActions provides the list of the latest swaps and liquidity changes
+    on the Uniswap exchange, optionally filtered by the pair, the account
+    and the type of the action.
+    """
+    uniswapActions(pair: Address, account: Address, actionType: UniswapActionType, count: Int = 25): [UniswapAction!]!
+
+    """
+    PairCandles provides the list of OHLCV candles of the given Uniswap pair
+    and resolution in the given time range of unix time stamps.
+    """
+    pairCandles(pair: Address!, resolution: UniswapCandleResolution!, from: Long!, to: Long!): [UniswapCandle!]!
+
+    """
+    Ballots provides the list of official voting ballots. Only ballots
+    open for voting are provided if active is requested.
+    """
+    ballots(active: Boolean = false): [Ballot!]!
+
+    "Ballot provides the details of the official voting ballot."
+    ballot(address: Address!): Ballot!
+
+    "GovContracts provides the list of the configured governance contracts."
+    govContracts: [GovernanceContract!]!
+
+    "GovContract provides the configured governance contract by its address."
+    govContract(address: Address!): GovernanceContract!
+
+    "GovProposal provides the details and the state of the governance proposal."
+    govProposal(contract: Address!, id: Long!): GovernanceProposal!
+
+    """
+    Staker information. The staker is loaded either by numeric ID,
+    or by address. null if none is provided. The state of the SFC contract
+    at the given block is used if the block is provided, the latest state is used otherwise.
+    Missed blocks, downtime, PoI and scores are reported for the latest state only.
+    """
+    staker(id: Long, address: Address, block: Long): Staker
+
+    "List of staker information from SFC smart contract."
+    stakers: [Staker!]!
+
+    """
+    Delegations of the given account from the SFC contract. The state at the given block
+    is used if the block is provided, the latest state is used otherwise.
+    """
+    delegations(address: Address!, block: Long): [Delegation!]!
 }
 
 # Mutation endpoints for modifying the data
@@ -548,6 +1831,13 @@ type Mutation {
     it raises a GraphQL error.
     """
     validateContract(contract: ContractValidationInput!): Contract!
+
+    """
+    UpdateStakerInfo reloads the extended staker information of the given staker
+    from the staker info contract. Staker operators use it to publish their updated
+    information without waiting for the periodic refresh.
+    """
+    updateStakerInfo(stakerId: Long!): StakerInfo
 }
 
 # Subscriptions to live events broadcasting
@@ -557,6 +1847,27 @@ type Subscription {
 
     "Subscribe to receive information about new transactions in the blockchain."
     onTransaction: Transaction!
+
+    "Subscribe to receive information about new swaps of the given Uniswap pair."
+    onPairSwap(pair: Address!): UniswapAction!
+
+    """
+    Subscribe to receive state changes of governance proposals,
+    optionally limited to the given governance contract.
+    """
+    onGovProposalChange(contract: Address): GovernanceProposal!
+
+    """
+    Subscribe to receive status changes of stakers, i.e. going offline,
+    or being flagged as a cheater, optionally limited to the given staker.
+    """
+    onStakerStatusChange(stakerId: Long): StakerStatusChange!
+
+    """
+    Subscribe to receive updates of the network statistics of the periods
+    in progress, optionally limited to the given resolution.
+    """
+    onNetworkStats(resolution: NetworkStatsResolution): NetworkStats!
 }
 
 `
@@ -9,6 +9,7 @@ results. BigCache for in-memory object storage to speed up loading of frequently
 package repository
 
 import (
+	"fantom-api-graphql/internal/repository/db"
 	"fantom-api-graphql/internal/types"
 	"fmt"
 	"github.com/ethereum/go-ethereum/common"
@@ -68,16 +69,40 @@ func (p *proxy) AccountNonce(acc *types.Account) (*hexutil.Uint64, error) {
 }
 
 // AccountTransactions returns slice of AccountTransaction structure for a given account at Opera blockchain.
-func (p *proxy) AccountTransactions(acc *types.Account, cursor *string, count int32) (*types.TransactionHashList, error) {
+// The list is limited to transactions matching the filter, if provided.
+func (p *proxy) AccountTransactions(acc *types.Account, cursor *string, count int32, filter *types.AccountTransactionFilter) (*types.TransactionHashList, error) {
 	// do we have an account?
 	if acc == nil {
 		return nil, fmt.Errorf("can not get transaction list for empty account")
 	}
 
+	// use the filtered list if needed
+	if !filter.IsEmpty() {
+		return p.db.AccountTransactionsFiltered(acc, cursor, count, filter)
+	}
+
 	// go to the database for the list of hashes of transaction searched
 	return p.db.AccountTransactions(acc, cursor, count)
 }
 
+// AccountTxSummary returns the aggregated transactions of the given account.
+func (p *proxy) AccountTxSummary(acc *types.Account) (*types.AccountTxSummary, error) {
+	// do we have an account?
+	if acc == nil {
+		return nil, fmt.Errorf("can not get transaction summary for empty account")
+	}
+
+	// aggregate the transactions
+	sum, err := p.db.AccountTxSummary(acc)
+	if err != nil {
+		return nil, err
+	}
+
+	// the values of old transactions are known after the backfill only
+	sum.IsComplete = p.db.IsBackfillDone(db.BackfillAccounts)
+	return sum, nil
+}
+
 // AccountsActive returns total number of accounts known to repository.
 func (p *proxy) AccountsActive() (hexutil.Uint64, error) {
 	return p.db.AccountCount()
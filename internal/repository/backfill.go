@@ -0,0 +1,151 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/repository/db"
+	"strconv"
+)
+
+const (
+	// trxBackfillBatch represents the number of transactions inspected by a single transactions backfill step.
+	trxBackfillBatch = 250
+
+	// blockBackfillBatch represents the number of blocks loaded by a single blocks backfill step.
+	blockBackfillBatch = 100
+
+	// accountBackfillBatch represents the number of accounts inspected by a single accounts backfill step.
+	accountBackfillBatch = 50
+)
+
+// BackfillTransactions fills the decimal value and the status of a batch of transactions
+// stored before these fields were introduced. The status is loaded from the transaction
+// receipts only if the status backfill is enabled in the configuration.
+// It returns true if the backfill is complete.
+func (p *proxy) BackfillTransactions() (bool, error) {
+	// where are we?
+	pos, done, err := p.db.BackfillPosition(db.BackfillTransactions)
+	if err != nil || done {
+		return done, err
+	}
+
+	// fill the values of the next batch
+	after := backfillUint64(pos)
+	last, noStatus, err := p.db.BackfillTransactionValues(after, trxBackfillBatch)
+	if err != nil {
+		return false, err
+	}
+
+	// load the missing status from the transaction receipts, if enabled
+	if p.statusBackfill && 0 < len(noStatus) {
+		status, err := p.rpc.TransactionStatus(noStatus)
+		if err != nil {
+			return false, err
+		}
+
+		if err := p.db.SetTransactionStatus(noStatus, status); err != nil {
+			return false, err
+		}
+	}
+
+	// no progress means we reached the end
+	done = last == after
+	return done, p.db.SetBackfillPosition(db.BackfillTransactions, strconv.FormatUint(last, 10), done)
+}
+
+// BackfillAccounts fills the counterparty and the decimal value of transactions embedded
+// in a batch of accounts stored before these fields were introduced, together with
+// the first seen time of the accounts.
+// It returns true if the backfill is complete.
+func (p *proxy) BackfillAccounts() (bool, error) {
+	// where are we?
+	pos, done, err := p.db.BackfillPosition(db.BackfillAccounts)
+	if err != nil || done {
+		return done, err
+	}
+
+	// process the next batch
+	last, err := p.db.BackfillAccountTransactions(pos, accountBackfillBatch)
+	if err != nil {
+		// keep the progress we made
+		if last != pos {
+			_ = p.db.SetBackfillPosition(db.BackfillAccounts, last, false)
+		}
+		return false, err
+	}
+
+	// no progress means we reached the end
+	done = last == pos
+	return done, p.db.SetBackfillPosition(db.BackfillAccounts, last, done)
+}
+
+// BackfillBlocks stores a batch of blocks below the lowest stored block going down
+// to the very first block. It returns true if the backfill is complete.
+func (p *proxy) BackfillBlocks() (bool, error) {
+	// where are we?
+	pos, done, err := p.db.BackfillPosition(db.BackfillBlocks)
+	if err != nil || done {
+		return done, err
+	}
+
+	// start below the lowest stored block
+	if pos == "" {
+		low, err := p.db.LowestBlock()
+		if err != nil || low == nil {
+			// we need the block scanner to store the first block
+			return false, err
+		}
+		pos = strconv.FormatUint(uint64(low.Number), 10)
+	}
+
+	// nothing to do below the first block
+	hi := backfillUint64(pos)
+	if hi == 0 {
+		return true, p.db.SetBackfillPosition(db.BackfillBlocks, pos, true)
+	}
+
+	// prep the batch of numbers
+	lo := uint64(0)
+	if hi > blockBackfillBatch {
+		lo = hi - blockBackfillBatch
+	}
+	nums := make([]uint64, 0, hi-lo)
+	for n := lo; n < hi; n++ {
+		nums = append(nums, n)
+	}
+
+	// load the blocks
+	blocks, err := p.rpc.Blocks(nums)
+	if err != nil {
+		return false, err
+	}
+
+	// store them
+	for i, blk := range blocks {
+		if blk == nil {
+			p.log.Debugf("block #%d not found", nums[i])
+			continue
+		}
+		if err := p.db.AddBlock(blk); err != nil {
+			return false, err
+		}
+	}
+
+	done = lo == 0
+	return done, p.db.SetBackfillPosition(db.BackfillBlocks, strconv.FormatUint(lo, 10), done)
+}
+
+// backfillUint64 decodes the numeric position of a backfill job; empty position is zero.
+func backfillUint64(pos string) uint64 {
+	val, err := strconv.ParseUint(pos, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return val
+}
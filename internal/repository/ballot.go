@@ -0,0 +1,220 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/repository/db"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	eth "github.com/ethereum/go-ethereum/core/types"
+	"strconv"
+	"time"
+)
+
+// ballotBackfillBatch represents the number of contracts inspected by a single ballots backfill step.
+const ballotBackfillBatch = 250
+
+// isBallotSource checks if the given address is one of the configured
+// sources of official voting ballots.
+func (p *proxy) isBallotSource(addr *common.Address) bool {
+	for _, src := range p.ballotSources {
+		if common.HexToAddress(src) == *addr {
+			return true
+		}
+	}
+	return false
+}
+
+// addBallot adds the contract as an official ballot if it has been deployed
+// by one of the configured voting sources.
+func (p *proxy) addBallot(sc *types.Contract, deployer *common.Address) error {
+	// is the deployer an official source?
+	if !p.isBallotSource(deployer) {
+		return nil
+	}
+
+	// load the ballot details
+	ballot, err := p.rpc.Ballot(&sc.Address)
+	if err != nil {
+		// the contract is not a ballot, don't block the scanner
+		p.log.Errorf("contract %s is not a valid ballot; %s", sc.Address.String(), err.Error())
+		return nil
+	}
+
+	// inform about the ballot
+	p.log.Noticef("official ballot %s [%s] found", sc.Address.String(), ballot.Name)
+	return p.db.AddBallot(ballot, sc.TimeStamp)
+}
+
+// Ballots resolves the list of official voting ballots. Only ballots
+// open for voting are resolved if active is requested.
+func (p *proxy) Ballots(active bool) ([]*types.Ballot, error) {
+	return p.db.Ballots(active, uint64(time.Now().Unix()))
+}
+
+// Ballot resolves the details of the official voting ballot.
+func (p *proxy) Ballot(addr *common.Address) (*types.Ballot, error) {
+	// is this an official ballot?
+	ballot, err := p.db.Ballot(addr)
+	if err != nil {
+		return nil, err
+	}
+	if ballot == nil {
+		return nil, fmt.Errorf("%s is not an official ballot", addr.String())
+	}
+
+	return ballot, nil
+}
+
+// Votes resolves the list of votes cast by the given account in official ballots.
+func (p *proxy) Votes(voter *common.Address) ([]types.Vote, error) {
+	return p.db.Votes(voter)
+}
+
+// addBallotVotes extracts votes cast in official ballots from the given transaction
+// event logs and stores them in the persistent storage. The details of the ballots
+// receiving votes, or being finalized, are refreshed so the stored vote tally is current.
+func (p *proxy) addBallotVotes(block *types.Block, trx *types.Transaction, logs []*eth.Log) error {
+	// any ballot events at all?
+	for _, addr := range p.rpc.BallotEventSources(logs) {
+		// is the event coming from an official ballot?
+		ok, err := p.db.IsBallot(&addr)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		// refresh the ballot details
+		if err := p.refreshBallot(&addr); err != nil {
+			return err
+		}
+
+		// get the list of votes
+		list, err := p.rpc.BallotVotes(trx, logs, &addr)
+		if err != nil {
+			p.log.Errorf("can not get ballot votes of %s; %s", trx.Hash.String(), err.Error())
+			continue
+		}
+
+		// store all of them
+		for i := range list {
+			list[i].TimeStamp = block.TimeStamp
+			if err := p.db.AddVote(&list[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// refreshBallot loads the current details of the official ballot and stores them.
+func (p *proxy) refreshBallot(addr *common.Address) error {
+	ballot, err := p.rpc.Ballot(addr)
+	if err != nil {
+		// the details are refreshed on the next ballot event
+		p.log.Errorf("can not refresh ballot %s; %s", addr.String(), err.Error())
+		return nil
+	}
+	return p.db.AddBallot(ballot, 0)
+}
+
+// BackfillBallots detects official ballots among a batch of contracts stored
+// before the ballots were indexed, together with the votes cast in them.
+// It returns true if the backfill is complete.
+func (p *proxy) BackfillBallots() (bool, error) {
+	// where are we?
+	pos, done, err := p.db.BackfillPosition(db.BackfillBallots)
+	if err != nil || done {
+		return done, err
+	}
+
+	// no sources, no ballots
+	if 0 == len(p.ballotSources) {
+		return true, p.db.SetBackfillPosition(db.BackfillBallots, pos, true)
+	}
+
+	// load the next batch of contracts
+	after := backfillUint64(pos)
+	list, err := p.db.ContractsAfter(after, ballotBackfillBatch)
+	if err != nil {
+		return false, err
+	}
+	if 0 == len(list) {
+		return true, p.db.SetBackfillPosition(db.BackfillBallots, pos, true)
+	}
+
+	// get the senders of the contracts deployed directly
+	hashes := make([]types.Hash, 0, len(list))
+	for _, sc := range list {
+		if sc.Factory == nil {
+			hashes = append(hashes, sc.TransactionHash)
+		}
+	}
+	senders, err := p.db.TransactionSenders(hashes)
+	if err != nil {
+		return false, err
+	}
+
+	// check the contracts
+	for _, sc := range list {
+		if err := p.backfillBallot(sc, senders); err != nil {
+			return false, err
+		}
+	}
+
+	last := list[len(list)-1].OrdinalIndex
+	return false, p.db.SetBackfillPosition(db.BackfillBallots, strconv.FormatUint(last, 10), false)
+}
+
+// backfillBallot adds the stored contract as an official ballot with the votes
+// already cast in it, if it has been deployed by one of the configured voting sources.
+func (p *proxy) backfillBallot(sc *types.Contract, senders map[types.Hash]common.Address) error {
+	// the contract is deployed either by the sender, or by the factory
+	deployer := sc.Factory
+	if deployer == nil {
+		from, ok := senders[sc.TransactionHash]
+		if !ok {
+			return nil
+		}
+		deployer = &from
+	}
+
+	// is this an official source?
+	if !p.isBallotSource(deployer) {
+		return nil
+	}
+
+	// add the ballot
+	if err := p.addBallot(sc, deployer); err != nil {
+		return err
+	}
+
+	// the ballot may not be valid
+	ok, err := p.db.IsBallot(&sc.Address)
+	if err != nil || !ok {
+		return err
+	}
+
+	// load the votes cast so far
+	votes, err := p.rpc.BallotVoteHistory(&sc.Address)
+	if err != nil {
+		return err
+	}
+	for i := range votes {
+		if err := p.db.AddVote(&votes[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -40,7 +40,7 @@ func (p *proxy) BlockByNumber(num *hexutil.Uint64) (*types.Block, error) {
 		return p.blockByTag(&tag)
 	}
 
-	return p.getBlock(num.String(), p.blockByTag)
+	return p.getBlock(num.String(), p.storedBlockByTag)
 }
 
 // BlockByHash returns a block at Opera blockchain represented by a hash. Top block is returned if the hash
@@ -95,6 +95,24 @@ func (p *proxy) getBlock(tag string, pull func(*string) (*types.Block, error)) (
 	return blk, nil
 }
 
+// storedBlockByTag returns a block represented by an encoded block number from the persistent storage.
+// If the block is not stored yet, it's pulled from the Opera blockchain.
+func (p *proxy) storedBlockByTag(tag *string) (*types.Block, error) {
+	// decode the block number
+	num, err := hexutil.DecodeUint64(*tag)
+	if err != nil {
+		return p.blockByTag(tag)
+	}
+
+	// try the persistent storage
+	blk, err := p.db.Block(hexutil.Uint64(num))
+	if err != nil || blk == nil {
+		return p.blockByTag(tag)
+	}
+
+	return blk, nil
+}
+
 // blockByTag returns a block at Opera blockchain represented by given tag.
 // The tag could be an encoded block number, or a predefined string tag for "earliest", "latest" or "pending" block.
 func (p *proxy) blockByTag(tag *string) (*types.Block, error) {
@@ -117,98 +135,81 @@ func (p *proxy) blockByTag(tag *string) (*types.Block, error) {
 	return block, nil
 }
 
-// initBlockList finds and returns the first block of the list and initializes the list internals accordingly.
-func (p *proxy) initBlockList(num *uint64, count int32) (*types.Block, *types.BlockList, error) {
-	// start from the latest block by default
-	var tag = rpc.BlockTypeLatest
+// blocksListRange calculates the inclusive range of block numbers covered by the list
+// inside the range of available blocks and initializes the list internals accordingly.
+// Nil list is returned if the range is empty.
+func blocksListRange(num *uint64, count int32, bottom uint64, top uint64) (uint64, uint64, *types.BlockList) {
+	// prep an empty list
+	list := types.BlockList{Collection: make([]*types.Block, 0), Total: top - bottom + 1}
+
+	// scanning from new to old blocks
+	if count > 0 {
+		// nothing below the very first block
+		if num != nil && *num <= bottom {
+			return 0, 0, nil
+		}
 
-	// we may want to start from bottom, or specific block instead
-	if num == nil && count < 0 {
-		tag = rpc.BlockTypeEarliest
-	} else if num != nil {
-		tag = hexutil.EncodeUint64(*num)
-	}
+		// the highest block of the list
+		hi := top
+		if num != nil && *num-1 < top {
+			hi = *num - 1
+		}
 
-	// inform what we are about to do
-	p.log.Debugf("initializing a new blocks list using tag [%s]", tag)
+		// the lowest block of the list
+		lo := bottom
+		if hi-bottom >= uint64(count) {
+			lo = hi - uint64(count) + 1
+		}
 
-	// get the latest block to start from
-	fb, err := p.blockByTag(&tag)
-	if err != nil {
-		p.log.Critical("the starting block not found in the blockchain")
-		return nil, nil, err
+		list.IsStart = hi == top
+		list.IsEnd = lo == bottom
+		return lo, hi, &list
 	}
 
-	// make sure the first block is valid
-	if fb == nil {
-		p.log.Critical("the starting block is not valid")
-		return nil, nil, fmt.Errorf("received invalid first block of the list")
+	// scanning from old to new blocks; the lowest block of the list
+	lo := bottom
+	if num != nil && *num >= bottom {
+		lo = *num + 1
 	}
 
-	// inform what we are about to do
-	p.log.Debugf("block list starts with block [%s]", fb.Number.String())
+	// nothing above the top block
+	if lo > top {
+		return 0, 0, nil
+	}
 
-	// prep an empty list marking already clear boundaries for missing block cursor/number
-	list := types.BlockList{
-		Collection: make([]*types.Block, 0),
-		IsStart:    num == nil && count > 0,
-		IsEnd:      num == nil && count < 0,
+	// the highest block of the list
+	hi := lo + uint64(-count) - 1
+	if hi > top {
+		hi = top
 	}
 
-	return fb, &list, nil
+	list.IsStart = hi == top
+	list.IsEnd = lo == bottom
+	return lo, hi, &list
 }
 
-// pullBlocks pulls specified list of blocks from repository and calculates boundary situation.
-func (p *proxy) pullBlocks(num *uint64, count int32, toPull int32, current *types.Block, list *types.BlockList) {
-	// prep the scan vars
-	var next *types.Block
-	var tag hexutil.Uint64
-	var err error
-
-	// loop to pull all the blocks requested
-	for i := int32(0); i < toPull; i++ {
-		// do we have any next block waiting to be used?
-		if next != nil {
-			// update the list with the current pending block only if it's valid for the list
-			if num == nil || uint64(current.Number) != *num {
-				list.Collection = append(list.Collection, current)
-			}
-
-			// move search to next block
-			current = next
-		}
-
-		// we always have a <current> block; either from successful initBlockList, or from previous scan iteration
-		// we assume blocks are always consecutive; if not, the search will stop on the gap gracefully
-		if count > 0 {
-			tag = hexutil.Uint64(uint64(current.Number) - 1)
-		} else {
-			tag = hexutil.Uint64(uint64(current.Number) + 1)
-		}
+// storedBlocks loads stored blocks of the given range and maps them by their numbers.
+func (p *proxy) storedBlocks(lo uint64, hi uint64) map[uint64]*types.Block {
+	// prep the map
+	res := make(map[uint64]*types.Block)
 
-		// try to get next block; break the loop on search issue; in that case <next> will be nil
-		next, err = p.BlockByNumber(&tag)
-		if err != nil {
-			break
-		}
+	// load the stored blocks; we can still pull them all from the blockchain if this fails
+	blocks, err := p.db.BlocksInRange(lo, hi)
+	if err != nil {
+		p.log.Errorf("can not load stored blocks #%d to #%d; %s", lo, hi, err.Error())
+		return res
 	}
 
-	// update the list with the last pending block only if it's valid for the list
-	if num == nil || uint64(current.Number) != *num {
-		list.Collection = append(list.Collection, current)
+	// map the blocks
+	for _, blk := range blocks {
+		res[uint64(blk.Number)] = blk
 	}
-
-	// return the result
-	list.IsStart, list.IsEnd = checkBlocksListBoundary(count, next, list)
-}
-
-// checkListBoundary verifies if the list of blocks is on one of the edges.
-func checkBlocksListBoundary(count int32, next *types.Block, list *types.BlockList) (bool, bool) {
-	return list.IsStart || (count < 0 && next == nil), list.IsEnd || (count > 0 && next == nil)
+	return res
 }
 
 // Blocks pulls list of blocks starting on the specified block number and going up, or down based on count number.
 // If the initial block number is not provided, we start on top, or bottom based on count value.
+// Blocks are served from the persistent storage, the blocks not yet stored are pulled from the blockchain.
 //
 // No-number boundaries are handled as follows:
 // 	- For positive count we start from the most recent block and scan to older blocks.
@@ -219,29 +220,52 @@ func (p *proxy) Blocks(num *uint64, count int32) (*types.BlockList, error) {
 		return nil, fmt.Errorf("nothing to do, zero blocks requested")
 	}
 
-	// init the list
-	current, list, err := p.initBlockList(num, count)
+	// get the current height of the chain
+	bh, err := p.rpc.BlockHeight()
 	if err != nil {
+		p.log.Errorf("can not get the block height; %s", err.Error())
 		return nil, err
 	}
 
-	// how many to pull at most
-	toPull := count
-	if count < 0 {
-		toPull = -count
-	}
+	return p.blocksInRange(num, count, 0, bh.ToInt().Uint64())
+}
 
-	// if in the middle we try to pull one extra block to find out if we reached aan end
-	if num != nil {
-		toPull++
+// blocksInRange pulls list of blocks of the given range of block numbers starting on the specified
+// block number and going up, or down based on count number. Blocks are served from the persistent
+// storage, the blocks not yet stored are pulled from the blockchain.
+func (p *proxy) blocksInRange(num *uint64, count int32, bottom uint64, top uint64) (*types.BlockList, error) {
+	// find the range of blocks to be listed
+	lo, hi, list := blocksListRange(num, count, bottom, top)
+	if list == nil {
+		return &types.BlockList{
+			Collection: make([]*types.Block, 0),
+			Total:      top - bottom + 1,
+			IsStart:    count < 0,
+			IsEnd:      count > 0,
+		}, nil
 	}
 
-	// get specified list of block from repository
-	p.pullBlocks(num, count, toPull, current, list)
+	// load what we have stored
+	stored := p.storedBlocks(lo, hi)
+
+	// inform what we are about to do
+	p.log.Debugf("block list #%d to #%d has %d blocks stored", lo, hi, len(stored))
+
+	// collect the blocks, newer on top; the blocks not stored are pulled from the blockchain
+	for n := hi + 1; n > lo; n-- {
+		blk, ok := stored[n-1]
+		if !ok {
+			var err error
+			tag := hexutil.Uint64(n - 1)
+			blk, err = p.BlockByNumber(&tag)
+			if err != nil {
+				// a gap in the list would be skipped by the cursor paging
+				p.log.Errorf("block #%d not available; %s", n-1, err.Error())
+				return nil, err
+			}
+		}
 
-	// if we scanned from bottom up, we need to reverse the list so newer blocks are on top
-	if count < 0 {
-		list.Reverse()
+		list.Collection = append(list.Collection, blk)
 	}
 
 	return list, nil
@@ -0,0 +1,185 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// BlockClosestBefore represents a search for the latest block created at, or before a time stamp.
+	BlockClosestBefore = "BEFORE"
+
+	// BlockClosestAfter represents a search for the first block created at, or after a time stamp.
+	BlockClosestAfter = "AFTER"
+)
+
+// AddBlock stores the block header in the persistent storage.
+func (p *proxy) AddBlock(blk *types.Block) error {
+	return p.db.AddBlock(blk)
+}
+
+// BlockByTime returns the block closest to the given time stamp in the direction specified.
+// The persistent storage is used if it covers the time stamp, the blockchain is searched otherwise.
+func (p *proxy) BlockByTime(ts hexutil.Uint64, closest string) (*types.Block, error) {
+	// validate the direction
+	if closest != BlockClosestBefore && closest != BlockClosestAfter {
+		return nil, fmt.Errorf("unknown block search direction %s", closest)
+	}
+
+	// try the persistent storage first
+	blk, err := p.db.BlockByTime(ts, closest == BlockClosestAfter)
+	if err != nil {
+		return nil, err
+	}
+
+	// make sure the neighbour block is known as well so we don't skip a gap in the stored blocks
+	if blk != nil && p.isBlockNeighbourKnown(blk, closest) {
+		return blk, nil
+	}
+
+	// inform what we do
+	p.log.Debugf("block by time %d not covered by storage, searching blockchain", uint64(ts))
+	return p.searchBlockByTime(ts, closest)
+}
+
+// isBlockNeighbourKnown checks if the block next to the given block in the search direction
+// is stored; this confirms the stored block is really the closest one.
+func (p *proxy) isBlockNeighbourKnown(blk *types.Block, closest string) bool {
+	// the first block has no predecessor
+	if closest == BlockClosestAfter && uint64(blk.Number) == 0 {
+		return true
+	}
+
+	// get the neighbour number
+	num := uint64(blk.Number) + 1
+	if closest == BlockClosestAfter {
+		num = uint64(blk.Number) - 1
+	}
+
+	// do we have it?
+	nb, err := p.db.Block(hexutil.Uint64(num))
+	return err == nil && nb != nil
+}
+
+// searchBlockByTime does a binary search for the block closest to the given time stamp on the blockchain.
+func (p *proxy) searchBlockByTime(ts hexutil.Uint64, closest string) (*types.Block, error) {
+	// get the top block as the upper boundary
+	top, err := p.BlockByNumber(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// are we out of range?
+	if top.TimeStamp < ts {
+		if closest == BlockClosestBefore {
+			return top, nil
+		}
+		return nil, ErrBlockNotFound
+	}
+
+	// find the first block with time stamp above (or at for AFTER) the time
+	lo, hi := uint64(0), uint64(top.Number)
+	for lo < hi {
+		mid := hexutil.Uint64(lo + (hi-lo)/2)
+		blk, err := p.BlockByNumber(&mid)
+		if err != nil {
+			return nil, err
+		}
+
+		// move the boundary
+		if blk.TimeStamp > ts || (closest == BlockClosestAfter && blk.TimeStamp == ts) {
+			hi = uint64(mid)
+		} else {
+			lo = uint64(mid) + 1
+		}
+	}
+
+	// for AFTER the found block is what we seek
+	if closest == BlockClosestAfter {
+		num := hexutil.Uint64(lo)
+		return p.BlockByNumber(&num)
+	}
+
+	// for BEFORE the top block itself may qualify
+	if top.TimeStamp <= ts {
+		return top, nil
+	}
+
+	// otherwise we need the block preceding the found one
+	if lo == 0 {
+		return nil, ErrBlockNotFound
+	}
+	num := hexutil.Uint64(lo - 1)
+	return p.BlockByNumber(&num)
+}
+
+// BlocksByTime pulls list of blocks created in the given time range starting on the specified
+// block number and going up, or down based on count number. The time range is converted
+// into the range of block numbers so the blocks not stored yet are pulled from the blockchain.
+func (p *proxy) BlocksByTime(num *uint64, count int32, from *hexutil.Uint64, to *hexutil.Uint64) (*types.BlockList, error) {
+	// nothing to load?
+	if count == 0 {
+		return nil, fmt.Errorf("nothing to do, zero blocks requested")
+	}
+
+	// find the range of block numbers
+	bottom, top, err := p.blockRangeByTime(from, to)
+	if err == ErrBlockNotFound || (err == nil && bottom > top) {
+		return &types.BlockList{Collection: make([]*types.Block, 0), IsStart: true, IsEnd: true}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return p.blocksInRange(num, count, bottom, top)
+}
+
+// blockRangeByTime finds the inclusive range of numbers of blocks created in the given time range.
+func (p *proxy) blockRangeByTime(from *hexutil.Uint64, to *hexutil.Uint64) (uint64, uint64, error) {
+	// the first block created at, or after the start
+	var bottom uint64
+	if from != nil {
+		blk, err := p.BlockByTime(*from, BlockClosestAfter)
+		if err != nil {
+			return 0, 0, err
+		}
+		bottom = uint64(blk.Number)
+	}
+
+	// the last block created at, or before the end; the top block if not limited
+	if to != nil {
+		blk, err := p.BlockByTime(*to, BlockClosestBefore)
+		if err != nil {
+			return 0, 0, err
+		}
+		return bottom, uint64(blk.Number), nil
+	}
+
+	bh, err := p.rpc.BlockHeight()
+	if err != nil {
+		p.log.Errorf("can not get the block height; %s", err.Error())
+		return 0, 0, err
+	}
+	return bottom, bh.ToInt().Uint64(), nil
+}
+
+// BlocksCount returns the number of blocks known to the API, i.e. the height
+// of the chain plus the genesis block. The stored blocks are not counted,
+// their count lags behind the top of the chain.
+func (p *proxy) BlocksCount() (uint64, error) {
+	bh, err := p.rpc.BlockHeight()
+	if err != nil {
+		p.log.Errorf("can not get the block height; %s", err.Error())
+		return 0, err
+	}
+	return bh.ToInt().Uint64() + 1, nil
+}
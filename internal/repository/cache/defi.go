@@ -0,0 +1,119 @@
+// Package cache implements bridge to fast in-memory object cache.
+package cache
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"time"
+)
+
+const (
+	// defiTokensCacheKey represents the in-memory cache key for the list of DeFi tokens.
+	defiTokensCacheKey = "defi-tokens"
+
+	// defiPriceCacheKeyPrefix represents the prefix of the in-memory cache key for DeFi token price.
+	defiPriceCacheKeyPrefix = "defi-price-"
+
+	// defiCacheTTL represents the time after which the DeFi data in the cache
+	// are considered outdated. BigCache eviction window is too long for them.
+	defiCacheTTL = 30 * time.Second
+)
+
+// PullDefiTokens extracts the list of DeFi tokens from the in-memory cache if available.
+func (b *MemBridge) PullDefiTokens() []*types.DefiToken {
+	// try to get the fresh data from the cache
+	data := b.pullFresh(defiTokensCacheKey, defiCacheTTL)
+	if data == nil {
+		return nil
+	}
+
+	// do we have the data?
+	list, err := types.UnmarshalDefiTokens(data)
+	if err != nil {
+		b.log.Criticalf("can not decode DeFi tokens from in-memory cache; %s", err.Error())
+		return nil
+	}
+
+	return list
+}
+
+// PushDefiTokens stores the list of DeFi tokens in the in-memory cache.
+func (b *MemBridge) PushDefiTokens(list []*types.DefiToken) error {
+	// we need a valid list
+	if list == nil {
+		return fmt.Errorf("undefined DeFi tokens list can not be pushed to the in-memory cache")
+	}
+
+	// encode the list
+	data, err := json.Marshal(list)
+	if err != nil {
+		b.log.Criticalf("can not marshal DeFi tokens to JSON; %s", err.Error())
+		return err
+	}
+
+	return b.pushStamped(defiTokensCacheKey, data)
+}
+
+// PullDefiTokenPrice extracts the price of the DeFi token from the in-memory cache if available.
+func (b *MemBridge) PullDefiTokenPrice(token *common.Address) *hexutil.Big {
+	// try to get the fresh data from the cache
+	data := b.pullFresh(defiPriceCacheKeyPrefix+token.String(), defiCacheTTL)
+	if data == nil {
+		return nil
+	}
+
+	// decode the price
+	var val hexutil.Big
+	if err := val.UnmarshalText(data); err != nil {
+		b.log.Criticalf("can not decode DeFi token price from in-memory cache; %s", err.Error())
+		return nil
+	}
+
+	return &val
+}
+
+// PushDefiTokenPrice stores the price of the DeFi token in the in-memory cache.
+func (b *MemBridge) PushDefiTokenPrice(token *common.Address, val hexutil.Big) error {
+	// encode the price
+	data, err := val.MarshalText()
+	if err != nil {
+		b.log.Criticalf("can not encode DeFi token price; %s", err.Error())
+		return err
+	}
+
+	return b.pushStamped(defiPriceCacheKeyPrefix+token.String(), data)
+}
+
+// pullFresh extracts the time stamped data from the in-memory cache
+// if available and not older than the given time to live.
+func (b *MemBridge) pullFresh(key string, ttl time.Duration) []byte {
+	// try to get the data from the cache
+	data, err := b.cache.Get(key)
+	if err != nil || len(data) < 8 {
+		// cache returns ErrEntryNotFound if the key does not exist
+		return nil
+	}
+
+	// is the data still fresh?
+	ts := time.Unix(0, int64(binary.BigEndian.Uint64(data[:8])))
+	if time.Since(ts) > ttl {
+		return nil
+	}
+
+	return data[8:]
+}
+
+// pushStamped stores the data in the in-memory cache
+// with the current time stamp attached.
+func (b *MemBridge) pushStamped(key string, data []byte) error {
+	// prefix the data with the current time
+	stamped := make([]byte, 8+len(data))
+	binary.BigEndian.PutUint64(stamped[:8], uint64(time.Now().UnixNano()))
+	copy(stamped[8:], data)
+
+	return b.cache.Set(key, stamped)
+}
@@ -0,0 +1,66 @@
+// Package cache implements bridge to fast in-memory object cache.
+package cache
+
+import (
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"time"
+)
+
+const (
+	// stakerInfoCacheKeyPrefix represents the prefix of the in-memory cache key for extended staker info.
+	stakerInfoCacheKeyPrefix = "staker-info-"
+
+	// stakerInfoCacheTTL represents the time after which the extended staker info
+	// in the cache is considered outdated and is loaded again.
+	stakerInfoCacheTTL = 1 * time.Hour
+)
+
+// stakerInfoKey generates the in-memory cache key of the extended staker info.
+func stakerInfoKey(id hexutil.Uint64) string {
+	return stakerInfoCacheKeyPrefix + id.String()
+}
+
+// PullStakerInfo extracts the extended staker information from the in-memory cache if available.
+func (b *MemBridge) PullStakerInfo(id hexutil.Uint64) *types.StakerInfo {
+	// try to get the fresh data from the cache
+	data := b.pullFresh(stakerInfoKey(id), stakerInfoCacheTTL)
+	if data == nil {
+		return nil
+	}
+
+	// decode the info
+	sti, err := types.UnmarshalStakerInfo(data)
+	if err != nil {
+		b.log.Criticalf("can not decode staker info from in-memory cache; %s", err.Error())
+		return nil
+	}
+
+	return sti
+}
+
+// PushStakerInfo stores the extended staker information in the in-memory cache.
+func (b *MemBridge) PushStakerInfo(id hexutil.Uint64, sti *types.StakerInfo) error {
+	// we need valid info
+	if sti == nil {
+		return fmt.Errorf("undefined staker info can not be pushed to the in-memory cache")
+	}
+
+	// encode the info
+	data, err := sti.Marshal()
+	if err != nil {
+		b.log.Criticalf("can not marshal staker info to JSON; %s", err.Error())
+		return err
+	}
+
+	return b.pushStamped(stakerInfoKey(id), data)
+}
+
+// EvictStakerInfo removes the extended staker information from the in-memory cache.
+func (b *MemBridge) EvictStakerInfo(id hexutil.Uint64) {
+	// delete the entry; missing entry is not a problem
+	if err := b.cache.Delete(stakerInfoKey(id)); err != nil {
+		b.log.Debugf("staker info #%d not in cache; %s", uint64(id), err.Error())
+	}
+}
@@ -0,0 +1,226 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/config"
+	"fantom-api-graphql/internal/logger"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common/compiler"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// compilerFullVersionRegexp represents a regular expression used to extract
+// the full version identifier from the compiler version output.
+var compilerFullVersionRegexp = regexp.MustCompile(`Version:\s*(\S+)`)
+
+// compilerRegistry represents a registry of smart contract compilers
+// available for the contract validation, keyed by the compiler version.
+type compilerRegistry struct {
+	log logger.Logger
+
+	// sol is the map of available Solidity compilers by version
+	sol map[string]*compiler.Solidity
+
+	// defSol is the default Solidity compiler used if the version
+	// can not be decided
+	defSol *compiler.Solidity
+
+	// vy is the Vyper compiler, if available
+	vy *compiler.Vyper
+}
+
+// newCompilerRegistry creates a new registry of compilers and populates it
+// with the compilers found on configured paths.
+func newCompilerRegistry(cfg *config.Config, log logger.Logger) *compilerRegistry {
+	// make the registry
+	cr := compilerRegistry{
+		log: log,
+		sol: make(map[string]*compiler.Solidity),
+	}
+
+	// add the default compiler, if available
+	if sol, err := compiler.SolidityVersion(cfg.SolCompilerPath); err == nil {
+		cr.defSol = sol
+		cr.addSolidity(sol)
+	} else {
+		log.Warningf("default Solidity compiler not available; %s", err.Error())
+	}
+
+	// scan the compilers directory
+	cr.scanSolidity(cfg.SolCompilersDir)
+
+	// inform about the compilers found
+	if 0 == len(cr.sol) {
+		log.Warning("no Solidity compiler available, Solidity contract validation will fail")
+	} else {
+		log.Noticef("%d Solidity compilers available for contract validation", len(cr.sol))
+	}
+
+	// add the Vyper compiler, if available
+	if vy, err := compiler.VyperVersion(cfg.VyperCompilerPath); err == nil {
+		log.Noticef("Vyper compiler %s available for contract validation", vy.Version)
+		cr.vy = vy
+	} else {
+		log.Warningf("Vyper compiler not available, Vyper contract validation will fail; %s", err.Error())
+	}
+
+	return &cr
+}
+
+// scanSolidity scans the given directory for Solidity compiler binaries
+// and adds all the compilers found into the registry.
+func (cr *compilerRegistry) scanSolidity(dir string) {
+	// nothing to scan?
+	if 0 == len(dir) {
+		return
+	}
+
+	// get the list of files in the directory
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		cr.log.Warningf("can not scan Solidity compilers directory %s; %s", dir, err.Error())
+		return
+	}
+
+	// try to use each regular file as a compiler
+	for _, fi := range files {
+		if !fi.Mode().IsRegular() {
+			continue
+		}
+
+		// ask the file for the compiler version; non compiler files will fail here
+		sol, err := compiler.SolidityVersion(filepath.Join(dir, fi.Name()))
+		if err != nil {
+			cr.log.Debugf("file %s is not a Solidity compiler; %s", fi.Name(), err.Error())
+			continue
+		}
+
+		cr.addSolidity(sol)
+	}
+}
+
+// addSolidity adds the given Solidity compiler into the registry,
+// if the version is not already known.
+func (cr *compilerRegistry) addSolidity(sol *compiler.Solidity) {
+	// do we already have this version?
+	if _, ok := cr.sol[sol.Version]; ok {
+		cr.log.Debugf("Solidity compiler %s already registered, %s skipped", sol.Version, sol.Path)
+		return
+	}
+
+	// log and add
+	cr.log.Debugf("Solidity compiler %s registered from %s", sol.Version, sol.Path)
+	cr.sol[sol.Version] = sol
+}
+
+// solidity returns the Solidity compiler of the given version. If the version
+// is not provided, the default compiler is returned.
+func (cr *compilerRegistry) solidity(ver string) (*compiler.Solidity, error) {
+	// no version requested? use the default compiler
+	if 0 == len(ver) {
+		if cr.defSol == nil {
+			return nil, fmt.Errorf("default Solidity compiler not available")
+		}
+		return cr.defSol, nil
+	}
+
+	// find the compiler
+	sol, ok := cr.sol[ver]
+	if !ok {
+		return nil, fmt.Errorf("Solidity compiler %s not available", ver)
+	}
+
+	return sol, nil
+}
+
+// vyper returns the Vyper compiler of the given version. If the version
+// is not provided, any available Vyper compiler is returned.
+func (cr *compilerRegistry) vyper(ver string) (*compiler.Vyper, error) {
+	// do we have the compiler at all?
+	if cr.vy == nil {
+		return nil, fmt.Errorf("Vyper compiler not available")
+	}
+
+	// is the version right?
+	if 0 < len(ver) && ver != cr.vy.Version {
+		return nil, fmt.Errorf("Vyper compiler %s not available", ver)
+	}
+
+	return cr.vy, nil
+}
+
+// list provides the list of all the registered compilers sorted by version
+// from the newest to the oldest.
+func (cr *compilerRegistry) list() []*types.Compiler {
+	// collect the Solidity compilers
+	list := make([]*types.Compiler, 0, len(cr.sol))
+	for _, sol := range cr.sol {
+		list = append(list, &types.Compiler{
+			Language:    types.ContractLanguageSolidity,
+			Version:     sol.Version,
+			FullVersion: compilerFullVersion(sol.FullVersion),
+			Path:        sol.Path,
+		})
+	}
+
+	// sort by version, newer first
+	sort.Slice(list, func(i, j int) bool {
+		return compareVersions(list[i].Version, list[j].Version) > 0
+	})
+
+	// add the Vyper compiler
+	if cr.vy != nil {
+		list = append(list, &types.Compiler{
+			Language:    types.ContractLanguageVyper,
+			Version:     cr.vy.Version,
+			FullVersion: strings.TrimSpace(cr.vy.FullVersion),
+			Path:        cr.vy.Path,
+		})
+	}
+
+	return list
+}
+
+// compilerFullVersion extracts the full version identifier
+// from the compiler version output.
+func compilerFullVersion(out string) string {
+	// try to find the version line
+	match := compilerFullVersionRegexp.FindStringSubmatch(out)
+	if len(match) != 2 {
+		return strings.TrimSpace(out)
+	}
+
+	return match[1]
+}
+
+// compareVersions compares two dot separated numeric versions.
+// The result is positive if a is newer than b, negative if a is older
+// than b and zero if they are equal.
+func compareVersions(a string, b string) int {
+	va := strings.Split(a, ".")
+	vb := strings.Split(b, ".")
+
+	// compare elements one by one
+	for i := 0; i < len(va) && i < len(vb); i++ {
+		na, _ := strconv.Atoi(va[i])
+		nb, _ := strconv.Atoi(vb[i])
+		if na != nb {
+			return na - nb
+		}
+	}
+
+	return len(va) - len(vb)
+}
@@ -9,13 +9,13 @@ results. BigCache for in-memory object storage to speed up loading of frequently
 package repository
 
 import (
-	"bytes"
-	"encoding/json"
+	"encoding/hex"
 	"fantom-api-graphql/internal/types"
 	"fmt"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/compiler"
-	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"path/filepath"
 	"strings"
 )
 
@@ -30,121 +30,195 @@ func (p *proxy) Contracts(validatedOnly bool, cursor *string, count int32) (*typ
 	return p.db.Contracts(validatedOnly, cursor, count)
 }
 
-// cutCodeMetadata removes the IPFS/Swarm metadata information from the code
-// for partial comparison. The current version of the Solidity compiler usually
-// adds metadata to the end of the deployed byte code.
-// @see https://solidity.readthedocs.io/en/latest/metadata.html
-func cutCodeMetadata(bc []byte) []byte {
-	// last 2 bytes are expected to contain metadata length
-	bcLen := uint64(len(bc))
-	cut := uint64(bc[bcLen-2])<<8 | uint64(bc[bcLen-1])
-
-	// are we safely within the byte code size?
-	if cut == 0 || cut >= bcLen-2 {
-		return bc
-	}
+// compiledContract represents a contract produced by a compiler
+// in a language independent form suitable for the byte code validation.
+type compiledContract struct {
+	// Name is the name of the contract as reported by the compiler.
+	Name string
 
-	return bc[:bcLen-cut-2]
-}
+	// Code is the creation byte code of the contract.
+	Code []byte
 
-// compareContractCode compares provided compiled code with the transaction input.
-func compareContractCode(tx *types.Transaction, code string) (bool, error) {
-	// decode the detail into byte array
-	bc, err := hexutil.Decode(code)
-	if err != nil {
-		return false, err
-	}
+	// RuntimeCode is the byte code deployed by the contract creation.
+	RuntimeCode []byte
 
-	// remove meta data hash from the byte code so we can compare raw
-	// contract byte content. Such comparison is not perfect since
-	// there could be changes in the source code not reflected
-	// in the byte code. (variables renamed, unused code introduced, etc.)
-	// Safer would be to use full CBOR parser here.
-	bc = cutCodeMetadata(bc)
-
-	// Is the transaction input shorter than the compiled contract?
-	// If so there is no chance for pass.
-	if len(tx.InputData) < len(bc) {
-		return false, nil
-	}
+	// Immutables is the list of runtime code ranges filled with
+	// immutable values during the contract construction.
+	Immutables []codeRange
+
+	// Abi is the JSON encoded ABI definition of the contract.
+	Abi string
 
-	// compare only up to <bc> length, the rest is metadata
-	// and constructor parameters
-	res := bytes.Compare(bc, tx.InputData[:len(bc)])
+	// Language is the language of the contract source code.
+	Language string
+
+	// Version is the version of the compiler used.
+	Version string
+
+	// Source is the source code of the contract.
+	Source string
+}
+
+// codeRange represents a range of byte code.
+type codeRange struct {
+	Start  int `json:"start"`
+	Length int `json:"length"`
+}
 
-	// return the comparison result
-	return res == 0, nil
+// hexToCode decodes hex encoded byte code with, or without, the 0x prefix.
+// Byte code with unlinked library placeholders can not be decoded.
+func hexToCode(code string) ([]byte, error) {
+	return hex.DecodeString(strings.TrimPrefix(code, "0x"))
 }
 
-// updateContractDetails updates local contract details from the provided compiler
-// output.
-func updateContractDetails(sc *types.Contract, detail *compiler.Contract) {
+// updateContractDetails updates local contract details from the compiled contract.
+func updateContractDetails(sc *types.Contract, cc *compiledContract) {
 	// copy compiler information
 	var str strings.Builder
-	str.WriteString(detail.Info.Language)
+	str.WriteString(cc.Language)
 	str.WriteString(" ")
-	str.WriteString(detail.Info.LanguageVersion)
+	str.WriteString(cc.Version)
 	sc.Compiler = str.String()
 
-	// copy ABI
-	abi, err := json.Marshal(detail.Info.AbiDefinition)
-	if err == nil {
-		sc.Abi = string(abi)
+	// copy ABI and the source code
+	sc.Abi = cc.Abi
+	sc.SourceCode = cc.Source
+}
+
+// Compilers returns the list of smart contract compilers available
+// for the contract validation.
+func (p *proxy) Compilers() []*types.Compiler {
+	return p.compilers.list()
+}
+
+// solidityCompiler decides which Solidity compiler should be used
+// to validate the given contract. The explicitly requested version is used
+// if provided, otherwise we try to detect the version from the metadata
+// of the deployed byte code and fall back to the default compiler.
+func (p *proxy) solidityCompiler(sc *types.Contract, ver *string, code []byte) (*compiler.Solidity, error) {
+	// explicit version requested
+	if ver != nil && 0 < len(*ver) {
+		return p.compilers.solidity(*ver)
+	}
+
+	// try to detect the compiler version from the deployed code metadata
+	_, md := splitCodeMetadata(code)
+	if md == nil {
+		p.log.Debugf("metadata of %s not found", sc.Address.String())
+		return p.compilers.solidity("")
+	}
+
+	detected, ok := md.solcVersion()
+	if !ok {
+		p.log.Debugf("compiler version of %s not detected", sc.Address.String())
+		return p.compilers.solidity("")
 	}
 
-	// copy the source code
-	sc.SourceCode = detail.Info.Source
+	// do we have the detected version?
+	sol, err := p.compilers.solidity(detected)
+	if err != nil {
+		p.log.Warningf("detected compiler of %s not available; %s", sc.Address.String(), err.Error())
+		return p.compilers.solidity("")
+	}
+
+	p.log.Debugf("contract %s compiler version detected as %s", sc.Address.String(), detected)
+	return sol, nil
+}
+
+// compileContract compiles the source code of the given contract
+// using the compiler of the contract language.
+func (p *proxy) compileContract(sc *types.Contract, ver *string, code []byte) ([]*compiledContract, error) {
+	switch sc.Language {
+	case types.ContractLanguageVyper:
+		return p.compileVyper(sc, ver)
+	case types.ContractLanguageSolidity, "":
+		sc.Language = types.ContractLanguageSolidity
+		return p.compileSolidity(sc, ver, code)
+	}
+
+	return nil, fmt.Errorf("unknown smart contract language %s", sc.Language)
+}
+
+// contractName extracts the name of the contract from the compiler output key.
+func contractName(name string) string {
+	// Solidity compiler uses the source file name prefix
+	name = strings.TrimPrefix(name, "<stdin>:")
+
+	// Vyper compiler uses the name of the source file
+	return strings.TrimSuffix(filepath.Base(name), vyperSourceFileSuffix)
 }
 
 // ValidateContract tries to validate contract byte code using
-// provided source code. If successful, the contract information
-// is updated the the repository.
-func (p *proxy) ValidateContract(sc *types.Contract) error {
-	// get the byte code of the actual contract
+// provided source code. The compiled runtime code is compared with the code
+// deployed at the contract address and the constructor arguments are extracted
+// from the deployment transaction. Contracts deployed by factories are accepted
+// too, but their constructor arguments are not available. If successful,
+// the contract information is updated the the repository and other contracts
+// with the same code are validated as well.
+func (p *proxy) ValidateContract(sc *types.Contract, ver *string) error {
+	// get the deployment transaction of the contract
 	tx, err := p.Transaction(&sc.TransactionHash)
 	if err != nil {
 		p.log.Errorf("can not get contract deployment transaction; %s", err.Error())
 		return err
 	}
 
+	// get the deployed runtime code
+	code, err := p.rpc.ContractCode(&sc.Address)
+	if err != nil {
+		p.log.Errorf("can not get contract deployed code; %s", err.Error())
+		return err
+	}
+
 	// try to compile the source code provided
-	contracts, err := compiler.CompileSolidityString(p.solCompiler, sc.SourceCode)
+	contracts, err := p.compileContract(sc, ver, code)
 	if err != nil {
-		p.log.Errorf("solidity code compilation failed")
 		return err
 	}
 
 	// loop over contracts ad try to validate one of them
-	for name, detail := range contracts {
+	for _, cc := range contracts {
 		// check if the compiled byte code match with the deployed contract
-		match, err := compareContractCode(tx, detail.Code)
-		if err != nil {
-			p.log.Errorf("contract byte code comparison failed")
-			return err
+		match := matchContractCode(cc, code)
+		if 0 == len(match) {
+			continue
 		}
 
-		// we have the winner
-		if match {
-			// set the contract name if not done already
-			if 0 == len(sc.Name) {
-				sc.Name = strings.TrimPrefix(name, "<stdin>:")
-			}
+		// set the contract name if not done already
+		if 0 == len(sc.Name) {
+			sc.Name = contractName(cc.Name)
+		}
 
-			// update the contract data
-			updateContractDetails(sc, detail)
+		// update the contract data
+		sc.Match = match
+		updateContractDetails(sc, cc)
 
-			// write update to the database
-			if err := p.db.UpdateContract(sc); err != nil {
-				p.log.Errorf("contract validation failed due to db error; %s", err.Error())
-				return err
-			}
+		// contracts deployed by factories don't have the creation code
+		// in the transaction input so we can not extract the arguments
+		if sc.Factory == nil {
+			p.constructorArguments(sc, cc, tx)
+		}
 
-			// inform about success
-			p.log.Debugf("contract %s [%s] validated", sc.Address.String(), name)
+		// make sure we know the code hash for similar contracts lookup
+		if sc.CodeHash == nil {
+			hash := types.Hash(crypto.Keccak256Hash(code))
+			sc.CodeHash = &hash
+		}
 
-			// inform the upper instance we have a winner
-			return nil
+		// write update to the database
+		if err := p.db.UpdateContract(sc); err != nil {
+			p.log.Errorf("contract validation failed due to db error; %s", err.Error())
+			return err
 		}
+
+		// inform about success
+		p.log.Debugf("contract %s [%s] validated with %s match", sc.Address.String(), cc.Name, match)
+
+		// validate other contracts with the same code
+		p.validateSimilarContracts(sc)
+
+		// inform the upper instance we have a winner
+		return nil
 	}
 
 	// validation fails
@@ -0,0 +1,49 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Call executes a read only call of the contract at the given address
+// on the state of the given block, or the latest block if the block is not specified.
+func (p *proxy) Call(to *common.Address, data []byte, from *common.Address, block *hexutil.Uint64) ([]byte, error) {
+	return p.rpc.Call(to, data, from, block)
+}
+
+// CallContract executes a read only call of the given method of the contract at the given address
+// using the verified contract ABI to encode the arguments and decode the output values into JSON.
+// Proxy contracts are called with the ABI of the implementation merged in,
+// so the proxy itself does not need to be verified.
+func (p *proxy) CallContract(addr *common.Address, method string, args []string, block *hexutil.Uint64) (string, error) {
+	// get the contract
+	sc, err := p.db.Contract(addr)
+	if err != nil {
+		return "", err
+	}
+
+	// we need the contract to be known
+	if sc == nil {
+		return "", fmt.Errorf("contract %s not found", addr.String())
+	}
+
+	// get the ABI of the contract; the implementation ABI is included for proxies
+	abi, err := p.ContractAbi(sc)
+	if err != nil {
+		return "", err
+	}
+	if abi == "" {
+		return "", fmt.Errorf("ABI of contract %s not available", addr.String())
+	}
+
+	return p.rpc.CallAbi(addr, abi, method, args, block)
+}
@@ -0,0 +1,166 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/repository/db"
+	"fantom-api-graphql/internal/repository/rpc"
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"sync/atomic"
+)
+
+// contractCreationMinGas represents the minimal amount of gas a transaction
+// deploying a contract must use, the intrinsic transaction gas
+// and the contract creation gas.
+const contractCreationMinGas = 21000 + 32000
+
+// addContract adds a smart contract deployed by the given transaction
+// into the repository. Factory is nil for contracts deployed
+// directly by the transaction. The creation index makes the contract ordinal
+// unique among contracts deployed by the same transaction.
+func (p *proxy) addContract(block *types.Block, trx *types.Transaction, addr *common.Address, factory *common.Address, ix uint64) error {
+	// prep the contract
+	sc := types.Contract{
+		OrdinalIndex:    p.db.ContractIndex(block, trx, ix),
+		Address:         *addr,
+		TransactionHash: trx.Hash,
+		Factory:         factory,
+		CodeHash:        p.contractCodeHash(addr),
+		TimeStamp:       block.TimeStamp,
+	}
+
+	// add the contract to the database
+	if err := p.db.AddContract(&sc); err != nil {
+		return err
+	}
+
+	// the contract is deployed either by the sender, or by the factory
+	deployer := &trx.From
+	if factory != nil {
+		deployer = factory
+	}
+
+	// is this an official ballot?
+	if err := p.addBallot(&sc, deployer); err != nil {
+		return err
+	}
+
+	// try to validate the contract using an already validated contract
+	p.validateBySimilarContract(&sc)
+	return nil
+}
+
+// addFactoryContracts detects smart contracts deployed by other contracts
+// inside the given transaction and adds them into the repository.
+func (p *proxy) addFactoryContracts(block *types.Block, trx *types.Transaction) error {
+	// is the tracing enabled and could the transaction deploy anything?
+	if atomic.LoadInt32(&p.contractTracing) == 0 || !isFactoryCandidate(trx) {
+		return nil
+	}
+
+	// get the list of contracts deployed
+	list, err := p.rpc.ContractCreations(&trx.Hash)
+	if err != nil {
+		// tracing is not available on the node; stop trying
+		if rpc.IsMethodNotFound(err) {
+			p.log.Warning("transaction tracing not available on the node, contract tracing disabled")
+			atomic.StoreInt32(&p.contractTracing, 0)
+			return nil
+		}
+
+		// don't block the scanner
+		p.log.Errorf("can not trace contract creations of %s; %s", trx.Hash.String(), err.Error())
+		return nil
+	}
+
+	// add all the contracts found; the direct deployment uses creation index zero
+	for i := range list {
+		// no more room in the ordinal index
+		if i+1 > db.ContractMaxCreationIndex {
+			p.log.Errorf("too many contracts deployed by %s, %d skipped", trx.Hash.String(), len(list)-i)
+			break
+		}
+
+		if err := p.addContract(block, trx, &list[i].Address, &list[i].Factory, uint64(i+1)); err != nil {
+			return err
+		}
+
+		p.log.Debugf("contract %s deployed by factory %s", list[i].Address.String(), list[i].Factory.String())
+	}
+
+	return nil
+}
+
+// isFactoryCandidate checks if the transaction could deploy a contract
+// from inside of another contract. We skip direct contract deployments,
+// failed transactions and transactions not using enough gas to deploy anything.
+func isFactoryCandidate(trx *types.Transaction) bool {
+	// direct deployment, or failed transaction?
+	if trx.To == nil || trx.Status == nil || uint64(*trx.Status) == 0 {
+		return false
+	}
+
+	return trx.GasUsed != nil && uint64(*trx.GasUsed) >= contractCreationMinGas
+}
+
+// contractCodeHash calculates the hash of the runtime code deployed
+// on the given address. Nil is returned if the code is not available.
+func (p *proxy) contractCodeHash(addr *common.Address) *types.Hash {
+	// get the deployed code
+	code, err := p.rpc.ContractCode(addr)
+	if err != nil || 0 == len(code) {
+		return nil
+	}
+
+	hash := types.Hash(crypto.Keccak256Hash(code))
+	return &hash
+}
+
+// validateBySimilarContract validates the contract using an already validated
+// contract with exactly the same runtime code, if there is any.
+func (p *proxy) validateBySimilarContract(sc *types.Contract) {
+	// do we know the code?
+	if sc.CodeHash == nil {
+		return
+	}
+
+	// find a validated contract with the same code
+	src, err := p.db.ValidatedContractByCode(sc.CodeHash)
+	if err != nil || src == nil {
+		return
+	}
+
+	// copy the validation over to the contract
+	p.validateSimilarContracts(src)
+}
+
+// validateSimilarContracts validates all the contracts with the same
+// runtime code as the given validated contract.
+func (p *proxy) validateSimilarContracts(sc *types.Contract) {
+	count, err := p.db.ValidateSimilarContracts(sc)
+	if err != nil {
+		p.log.Errorf("can not validate contracts similar to %s; %s", sc.Address.String(), err.Error())
+		return
+	}
+
+	// inform about the result
+	if 0 < count {
+		p.log.Infof("%d contracts validated by similarity with %s", count, sc.Address.String())
+	}
+}
+
+// boolToFlag converts the boolean value into an atomically accessed flag.
+func boolToFlag(val bool) int32 {
+	if val {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,200 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"bytes"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"math/big"
+	"reflect"
+	"strings"
+)
+
+const (
+	// libraryAddressPush represents the PUSH20 opcode library contracts
+	// start their runtime code with to store the library deployment address.
+	libraryAddressPush = 0x73
+
+	// libraryAddressLength represents the length of the library address
+	// pushed at the beginning of the library runtime code.
+	libraryAddressLength = common.AddressLength
+)
+
+// matchContractCode compares the compiled runtime code with the code deployed
+// on the chain and returns the match quality level. Immutable values filled
+// during the contract construction are masked before the comparison.
+// Empty string is returned if the code does not match.
+func matchContractCode(cc *compiledContract, deployed []byte) string {
+	// mask the deployed code copy so we don't modify the source
+	code := maskImmutables(cc, deployed)
+
+	// split both codes to the code body and the metadata
+	cBody, cMeta := splitCodeMetadata(cc.RuntimeCode)
+	dBody, dMeta := splitCodeMetadata(code)
+
+	// the code body must always match
+	if !bytes.Equal(cBody, dBody) {
+		return ""
+	}
+
+	// same metadata means the same source code and settings
+	if cMeta.equals(dMeta) {
+		return types.ContractMatchFull
+	}
+
+	return types.ContractMatchPartial
+}
+
+// maskImmutables creates a copy of the deployed code with immutable
+// references and library address zeroed the same way as they are
+// in the compiled runtime code. Vyper stores the immutable values
+// after the runtime code, so the data section is cut off.
+func maskImmutables(cc *compiledContract, deployed []byte) []byte {
+	// Vyper data section follows the runtime code
+	if cc.Language == types.ContractLanguageVyper && len(deployed) > len(cc.RuntimeCode) {
+		deployed = deployed[:len(cc.RuntimeCode)]
+	}
+
+	code := make([]byte, len(deployed))
+	copy(code, deployed)
+
+	// zero the immutable values
+	for _, rng := range cc.Immutables {
+		if 0 <= rng.Start && rng.Start+rng.Length <= len(code) {
+			for i := rng.Start; i < rng.Start+rng.Length; i++ {
+				code[i] = 0
+			}
+		}
+	}
+
+	// libraries store their own address at the beginning of the runtime code
+	if isLibraryCode(cc.RuntimeCode) && len(code) > libraryAddressLength && code[0] == libraryAddressPush {
+		for i := 1; i <= libraryAddressLength; i++ {
+			code[i] = 0
+		}
+	}
+
+	return code
+}
+
+// isLibraryCode checks if the compiled runtime code starts with the library
+// address placeholder.
+func isLibraryCode(code []byte) bool {
+	if len(code) <= libraryAddressLength || code[0] != libraryAddressPush {
+		return false
+	}
+
+	return bytes.Equal(code[1:libraryAddressLength+1], make([]byte, libraryAddressLength))
+}
+
+// constructorArguments extracts constructor arguments of the contract
+// from the deployment transaction input and decodes them using the contract ABI.
+func (p *proxy) constructorArguments(sc *types.Contract, cc *compiledContract, tx *types.Transaction) {
+	// reset the previous values, if any
+	sc.ConstructorArgs = nil
+	sc.ConstructorArguments = make([]types.ContractArgument, 0)
+
+	// find the end of the creation byte code in the transaction input
+	end, ok := creationCodeEnd(cc.Code, tx.InputData)
+	if !ok {
+		p.log.Errorf("creation code of contract %s not found in the transaction input", sc.Address.String())
+		return
+	}
+
+	// do we have any arguments in the transaction input?
+	if len(tx.InputData) <= end {
+		return
+	}
+
+	// the arguments are appended to the creation byte code
+	sc.ConstructorArgs = make([]byte, len(tx.InputData)-end)
+	copy(sc.ConstructorArgs, tx.InputData[end:])
+
+	// parse the ABI so we can decode the arguments
+	def, err := abi.JSON(strings.NewReader(cc.Abi))
+	if err != nil {
+		p.log.Errorf("can not parse ABI of contract %s; %s", sc.Address.String(), err.Error())
+		return
+	}
+
+	// decode the arguments
+	values, err := def.Constructor.Inputs.UnpackValues(sc.ConstructorArgs)
+	if err != nil {
+		p.log.Errorf("can not decode constructor arguments of contract %s; %s", sc.Address.String(), err.Error())
+		return
+	}
+
+	// collect the decoded arguments
+	for i, in := range def.Constructor.Inputs {
+		sc.ConstructorArguments = append(sc.ConstructorArguments, types.ContractArgument{
+			Name:  in.Name,
+			Type:  in.Type.String(),
+			Value: argumentValue(values[i]),
+		})
+	}
+}
+
+// creationCodeEnd finds the position where the compiled creation code ends in the deployment
+// transaction input. The metadata appended by the compiler may differ from the deployed one,
+// so only the code body is compared and the deployed metadata is decoded to find its length.
+func creationCodeEnd(code []byte, input []byte) (int, bool) {
+	// the code body must be at the beginning of the input
+	body, meta := splitCodeMetadata(code)
+	if len(input) < len(body) || !bytes.Equal(body, input[:len(body)]) {
+		return 0, false
+	}
+
+	// no metadata means the creation code is used as is
+	if meta == nil {
+		return len(code), len(input) >= len(code) && bytes.Equal(code, input[:len(code)])
+	}
+
+	// decode the metadata map of the deployed code following the body
+	dec := cborDecoder{data: input[len(body):]}
+	if val, err := dec.value(); err != nil {
+		return 0, false
+	} else if _, ok := val.(map[string]interface{}); !ok {
+		return 0, false
+	}
+
+	// the map is followed by its two bytes length
+	end := len(body) + dec.pos + 2
+	if len(input) < end || int(input[end-2])<<8|int(input[end-1]) != dec.pos {
+		return 0, false
+	}
+	return end, true
+}
+
+// argumentValue formats decoded ABI value into its textual representation.
+func argumentValue(val interface{}) string {
+	switch v := val.(type) {
+	case common.Address:
+		return v.String()
+	case *big.Int:
+		return v.String()
+	case []byte:
+		return hexutil.Encode(v)
+	case string:
+		return v
+	}
+
+	// fixed size byte arrays are encoded as hex
+	rv := reflect.ValueOf(val)
+	if rv.Kind() == reflect.Array && rv.Type().Elem().Kind() == reflect.Uint8 {
+		b := make([]byte, rv.Len())
+		reflect.Copy(reflect.ValueOf(b), rv)
+		return hexutil.Encode(b)
+	}
+
+	return fmt.Sprintf("%v", val)
+}
@@ -0,0 +1,220 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/logger"
+	"fantom-api-graphql/internal/types"
+	"github.com/op/go-logging"
+	"strings"
+	"testing"
+)
+
+// testInitCode is a creation code prefix deploying the runtime code following it.
+const testInitCode = "608060405234801561001057600080fd5b50610150806100206000396000f3fe"
+
+// testImmutableCode is a code body with a 32 bytes immutable slot zeroed by the compiler at offset 5.
+var testImmutableCode = "6080604052" + strings.Repeat("00", 32) + "5b600080fd"
+
+// testArgs is ABI encoded pair of uint256(42) and address(0x...ff).
+var testArgs = strings.Repeat("0", 62) + "2a" + strings.Repeat("0", 24) + strings.Repeat("f", 40)
+
+// testConstructorAbi is the ABI of a contract with the constructor accepting the testArgs.
+const testConstructorAbi = `[{"type":"constructor","inputs":[{"name":"value","type":"uint256"},{"name":"owner","type":"address"}]}]`
+
+func TestMatchContractCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		compiled string
+		deployed string
+		imm      []codeRange
+		lang     string
+		want     string
+	}{
+		{name: "solc ipfs same metadata", compiled: testCodeBody + testTailIpfs, deployed: testCodeBody + testTailIpfs, want: types.ContractMatchFull},
+		{name: "solc bzzr0 same metadata", compiled: testCodeBody + testTailBzzr0, deployed: testCodeBody + testTailBzzr0, want: types.ContractMatchFull},
+		{name: "solc bzzr1 same metadata", compiled: testCodeBody + testTailBzzr1, deployed: testCodeBody + testTailBzzr1, want: types.ContractMatchFull},
+		{name: "vyper same metadata", compiled: testCodeBody + testTailVyper, deployed: testCodeBody + testTailVyper, want: types.ContractMatchFull},
+		{name: "no metadata", compiled: testCodeBody, deployed: testCodeBody, want: types.ContractMatchFull},
+		{name: "different metadata hash", compiled: testCodeBody + testTailIpfs, deployed: testCodeBody + strings.Replace(testTailIpfs, "33", "44", 32), want: types.ContractMatchPartial},
+		{name: "different metadata format", compiled: testCodeBody + testTailBzzr1, deployed: testCodeBody + testTailIpfs, want: types.ContractMatchPartial},
+		{name: "metadata missing on chain", compiled: testCodeBody + testTailIpfs, deployed: testCodeBody, want: types.ContractMatchPartial},
+		{name: "different body", compiled: testCodeBody + testTailIpfs, deployed: "60806040" + testTailIpfs, want: ""},
+		{name: "empty deployed code", compiled: testCodeBody + testTailIpfs, deployed: "", want: ""},
+		{
+			name:     "immutables masked",
+			compiled: testImmutableCode + testTailIpfs,
+			deployed: "6080604052" + strings.Repeat("ab", 32) + "5b600080fd" + testTailIpfs,
+			imm:      []codeRange{{Start: 5, Length: 32}},
+			want:     types.ContractMatchFull,
+		},
+		{
+			name:     "immutables not masked",
+			compiled: testImmutableCode + testTailIpfs,
+			deployed: "6080604052" + strings.Repeat("ab", 32) + "5b600080fd" + testTailIpfs,
+			want:     "",
+		},
+		{
+			name:     "immutables out of code",
+			compiled: testCodeBody + testTailIpfs,
+			deployed: testCodeBody + testTailIpfs,
+			imm:      []codeRange{{Start: -1, Length: 32}, {Start: 5, Length: 1 << 20}, {Start: 1 << 20, Length: 1}, {Start: 5, Length: -5}},
+			want:     types.ContractMatchFull,
+		},
+		{
+			name:     "vyper immutables data section",
+			compiled: testCodeBody + testTailVyper,
+			deployed: testCodeBody + testTailVyper + strings.Repeat("ab", 64),
+			lang:     types.ContractLanguageVyper,
+			want:     types.ContractMatchFull,
+		},
+		{
+			name:     "vyper different metadata with data section",
+			compiled: testCodeBody + testTailVyper,
+			deployed: testCodeBody + strings.Replace(testTailVyper, "0307", "0309", 1) + strings.Repeat("ab", 64),
+			lang:     types.ContractLanguageVyper,
+			want:     types.ContractMatchPartial,
+		},
+		{
+			name:     "vyper short code",
+			compiled: testCodeBody + testTailVyper,
+			deployed: testCodeBody,
+			lang:     types.ContractLanguageVyper,
+			want:     types.ContractMatchPartial,
+		},
+		{
+			name:     "solidity trailing data",
+			compiled: testCodeBody + testTailIpfs,
+			deployed: testCodeBody + testTailIpfs + strings.Repeat("ab", 64),
+			want:     "",
+		},
+		{
+			name:     "library address masked",
+			compiled: "73" + strings.Repeat("00", 20) + "3014" + testTailIpfs,
+			deployed: "73" + strings.Repeat("cd", 20) + "3014" + testTailIpfs,
+			want:     types.ContractMatchFull,
+		},
+		{
+			name:     "library address too short",
+			compiled: "73" + strings.Repeat("00", 20) + "3014" + testTailIpfs,
+			deployed: "73cdcd",
+			want:     "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cc := compiledContract{RuntimeCode: mustHex(t, tt.compiled), Immutables: tt.imm, Language: tt.lang}
+			deployed := mustHex(t, tt.deployed)
+			orig := string(deployed)
+
+			if got := matchContractCode(&cc, deployed); got != tt.want {
+				t.Errorf("expected match %q, got %q", tt.want, got)
+			}
+			if string(deployed) != orig {
+				t.Errorf("deployed code modified")
+			}
+		})
+	}
+}
+
+func TestCreationCodeEnd(t *testing.T) {
+	code := testInitCode + testCodeBody + testTailIpfs
+	tests := []struct {
+		name   string
+		code   string
+		input  string
+		want   int
+		wantOk bool
+	}{
+		{name: "same metadata", code: code, input: code, want: len(code) / 2, wantOk: true},
+		{name: "same metadata with args", code: code, input: code + testArgs, want: len(code) / 2, wantOk: true},
+		{name: "different metadata hash", code: code, input: testInitCode + testCodeBody + strings.Replace(testTailIpfs, "33", "44", 32) + testArgs, want: len(code) / 2, wantOk: true},
+		{name: "shorter metadata", code: code, input: testInitCode + testCodeBody + testTailBzzr1 + testArgs, want: len(testInitCode+testCodeBody+testTailBzzr1) / 2, wantOk: true},
+		{name: "longer metadata", code: testInitCode + testCodeBody + testTailBzzr0, input: code + testArgs, want: len(code) / 2, wantOk: true},
+		{name: "vyper metadata", code: testInitCode + testCodeBody + testTailVyper, input: testInitCode + testCodeBody + testTailVyper + testArgs, want: len(testInitCode+testCodeBody+testTailVyper) / 2, wantOk: true},
+		{name: "no metadata", code: testInitCode + testCodeBody, input: testInitCode + testCodeBody + testArgs, want: len(testInitCode+testCodeBody) / 2, wantOk: true},
+		{name: "no metadata short input", code: testInitCode + testCodeBody, input: testInitCode},
+		{name: "no metadata different code", code: testInitCode + testCodeBody, input: testInitCode + "60806040" + testArgs},
+		{name: "different body", code: code, input: testInitCode + "60806040" + testTailIpfs},
+		{name: "empty input", code: code, input: ""},
+		{name: "metadata missing", code: code, input: testInitCode + testCodeBody},
+		{name: "truncated metadata", code: code, input: testInitCode + testCodeBody + testTailIpfs[:40]},
+		{name: "metadata length missing", code: code, input: testInitCode + testCodeBody + testTailIpfs[:len(testTailIpfs)-4]},
+		{name: "invalid metadata length", code: code, input: testInitCode + testCodeBody + testTailIpfs[:len(testTailIpfs)-4] + "0034" + testArgs},
+		{name: "invalid metadata", code: code, input: testInitCode + testCodeBody + "bbffffffffffffffff" + "0009"},
+		{name: "array metadata", code: code, input: testInitCode + testCodeBody + "83010203" + "0004"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			end, ok := creationCodeEnd(mustHex(t, tt.code), mustHex(t, tt.input))
+			if ok != tt.wantOk {
+				t.Fatalf("expected found %t, got %t", tt.wantOk, ok)
+			}
+			if ok && end != tt.want {
+				t.Errorf("expected end %d, got %d", tt.want, end)
+			}
+		})
+	}
+}
+
+func TestConstructorArguments(t *testing.T) {
+	p := proxy{log: &logger.ApiLogger{Logger: *logging.MustGetLogger("test")}}
+	code := testInitCode + testCodeBody + testTailIpfs
+
+	tests := []struct {
+		name    string
+		input   string
+		wantRaw int
+		want    []types.ContractArgument
+	}{
+		{
+			name:    "same metadata",
+			input:   code + testArgs,
+			wantRaw: len(testArgs) / 2,
+			want: []types.ContractArgument{
+				{Name: "value", Type: "uint256", Value: "42"},
+				{Name: "owner", Type: "address", Value: "0xFFfFfFffFFfffFFfFFfFFFFFffFFFffffFfFFFfF"},
+			},
+		},
+		{
+			name:    "different metadata",
+			input:   testInitCode + testCodeBody + testTailBzzr1 + testArgs,
+			wantRaw: len(testArgs) / 2,
+			want: []types.ContractArgument{
+				{Name: "value", Type: "uint256", Value: "42"},
+				{Name: "owner", Type: "address", Value: "0xFFfFfFffFFfffFFfFFfFFFFFffFFFffffFfFFFfF"},
+			},
+		},
+		{name: "no arguments", input: code},
+		{name: "truncated arguments", input: code + testArgs[:70], wantRaw: 35},
+		{name: "creation code not found", input: testInitCode + testArgs},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc := types.Contract{}
+			cc := compiledContract{Code: mustHex(t, code), Abi: testConstructorAbi}
+			p.constructorArguments(&sc, &cc, &types.Transaction{InputData: mustHex(t, tt.input)})
+
+			if len(sc.ConstructorArgs) != tt.wantRaw {
+				t.Fatalf("expected %d bytes of arguments, got %d", tt.wantRaw, len(sc.ConstructorArgs))
+			}
+			if len(sc.ConstructorArguments) != len(tt.want) {
+				t.Fatalf("expected %d arguments, got %d", len(tt.want), len(sc.ConstructorArguments))
+			}
+			for i, arg := range tt.want {
+				if sc.ConstructorArguments[i] != arg {
+					t.Errorf("expected argument %v, got %v", arg, sc.ConstructorArguments[i])
+				}
+			}
+		})
+	}
+}
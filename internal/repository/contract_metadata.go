@@ -0,0 +1,252 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// cbor major types used by the compiler metadata encoding
+const (
+	cborMajorUint   = 0
+	cborMajorNegInt = 1
+	cborMajorBytes  = 2
+	cborMajorText   = 3
+	cborMajorArray  = 4
+	cborMajorMap    = 5
+	cborMajorSimple = 7
+)
+
+// cborMaxItems is the max number of items of a CBOR array, or map,
+// we accept in the compiler metadata.
+const cborMaxItems = 64
+
+// codeMetadata represents decoded CBOR metadata appended by the compiler
+// to the end of the deployed byte code.
+// @see https://solidity.readthedocs.io/en/latest/metadata.html
+type codeMetadata struct {
+	// fields contains the decoded metadata map
+	fields map[string]interface{}
+
+	// raw is the raw metadata including the two bytes length suffix
+	raw []byte
+}
+
+// splitCodeMetadata splits the byte code into the code itself and the CBOR
+// encoded metadata appended to it by the compiler. If the metadata can not
+// be found, or decoded, the code is returned as is with nil metadata.
+func splitCodeMetadata(bc []byte) ([]byte, *codeMetadata) {
+	// we need at least the metadata length
+	bcLen := len(bc)
+	if bcLen < 2 {
+		return bc, nil
+	}
+
+	// last 2 bytes are expected to contain metadata length
+	mdLen := int(bc[bcLen-2])<<8 | int(bc[bcLen-1])
+	if mdLen == 0 || mdLen > bcLen-2 {
+		return bc, nil
+	}
+
+	// decode the metadata map; it must span the whole metadata length
+	dec := cborDecoder{data: bc[bcLen-2-mdLen : bcLen-2]}
+	val, err := dec.value()
+	if err != nil || dec.pos != len(dec.data) {
+		return bc, nil
+	}
+
+	// the metadata must be a map
+	fields, ok := val.(map[string]interface{})
+	if !ok {
+		return bc, nil
+	}
+
+	return bc[:bcLen-2-mdLen], &codeMetadata{fields: fields, raw: bc[bcLen-2-mdLen:]}
+}
+
+// solcVersion returns the version of Solidity compiler stored in the metadata.
+// Solidity 0.5.9 and later stores the compiler version inside the metadata.
+func (md *codeMetadata) solcVersion() (string, bool) {
+	// do we have the version at all?
+	ver, ok := md.fields["solc"].([]byte)
+	if !ok || len(ver) != 3 {
+		return "", false
+	}
+
+	return fmt.Sprintf("%d.%d.%d", ver[0], ver[1], ver[2]), true
+}
+
+// equals checks if the metadata is the same as the other metadata.
+func (md *codeMetadata) equals(other *codeMetadata) bool {
+	if md == nil || other == nil {
+		return md == other
+	}
+
+	return bytes.Equal(md.raw, other.raw)
+}
+
+// cborDecoder implements minimalistic CBOR decoder sufficient for decoding
+// compiler metadata. Indefinite length items and tags are not supported.
+// @see https://tools.ietf.org/html/rfc7049
+type cborDecoder struct {
+	data []byte
+	pos  int
+}
+
+// head decodes the initial byte of a data item and its argument.
+func (d *cborDecoder) head() (byte, uint64, error) {
+	// do we have the initial byte?
+	if d.pos >= len(d.data) {
+		return 0, 0, fmt.Errorf("unexpected end of CBOR data")
+	}
+
+	// split the initial byte into the major type and additional info
+	ib := d.data[d.pos]
+	d.pos++
+	major, ai := ib>>5, ib&0x1f
+
+	// short argument is stored directly in the additional info
+	if ai < 24 {
+		return major, uint64(ai), nil
+	}
+
+	// how many bytes the argument uses
+	var size int
+	switch ai {
+	case 24:
+		size = 1
+	case 25:
+		size = 2
+	case 26:
+		size = 4
+	case 27:
+		size = 8
+	default:
+		return 0, 0, fmt.Errorf("unsupported CBOR additional info %d", ai)
+	}
+
+	// do we have enough data?
+	if d.pos+size > len(d.data) {
+		return 0, 0, fmt.Errorf("unexpected end of CBOR data")
+	}
+
+	// decode the argument
+	var arg uint64
+	for _, b := range d.data[d.pos : d.pos+size] {
+		arg = arg<<8 | uint64(b)
+	}
+
+	d.pos += size
+	return major, arg, nil
+}
+
+// bytes reads a byte sequence of given length.
+func (d *cborDecoder) bytes(length uint64) ([]byte, error) {
+	// do we have enough data?
+	if uint64(len(d.data)-d.pos) < length {
+		return nil, fmt.Errorf("unexpected end of CBOR data")
+	}
+
+	b := d.data[d.pos : d.pos+int(length)]
+	d.pos += int(length)
+	return b, nil
+}
+
+// value decodes the next CBOR data item.
+func (d *cborDecoder) value() (interface{}, error) {
+	major, arg, err := d.head()
+	if err != nil {
+		return nil, err
+	}
+
+	switch major {
+	case cborMajorUint:
+		return arg, nil
+	case cborMajorNegInt:
+		return -1 - int64(arg), nil
+	case cborMajorBytes:
+		return d.bytes(arg)
+	case cborMajorText:
+		b, err := d.bytes(arg)
+		return string(b), err
+	case cborMajorArray:
+		return d.array(arg)
+	case cborMajorMap:
+		return d.mapping(arg)
+	case cborMajorSimple:
+		return d.simple(arg)
+	}
+
+	return nil, fmt.Errorf("unsupported CBOR major type %d", major)
+}
+
+// array decodes CBOR array of the given number of items.
+func (d *cborDecoder) array(count uint64) ([]interface{}, error) {
+	if count > cborMaxItems {
+		return nil, fmt.Errorf("CBOR array too long")
+	}
+
+	list := make([]interface{}, count)
+	for i := range list {
+		val, err := d.value()
+		if err != nil {
+			return nil, err
+		}
+		list[i] = val
+	}
+
+	return list, nil
+}
+
+// mapping decodes CBOR map of the given number of pairs.
+// Only text keys are accepted.
+func (d *cborDecoder) mapping(count uint64) (map[string]interface{}, error) {
+	if count > cborMaxItems {
+		return nil, fmt.Errorf("CBOR map too long")
+	}
+
+	m := make(map[string]interface{}, count)
+	for i := uint64(0); i < count; i++ {
+		// decode the key
+		key, err := d.value()
+		if err != nil {
+			return nil, err
+		}
+
+		// we expect text keys only
+		k, ok := key.(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected CBOR map key type %T", key)
+		}
+
+		// decode the value
+		val, err := d.value()
+		if err != nil {
+			return nil, err
+		}
+		m[k] = val
+	}
+
+	return m, nil
+}
+
+// simple decodes CBOR simple value.
+func (d *cborDecoder) simple(arg uint64) (interface{}, error) {
+	switch arg {
+	case 20:
+		return false, nil
+	case 21:
+		return true, nil
+	case 22:
+		return nil, nil
+	}
+
+	return nil, fmt.Errorf("unsupported CBOR simple value %d", arg)
+}
@@ -0,0 +1,129 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// metadata tails appended to the runtime code by the compilers, including the length suffix
+var (
+	// testCodeBody is a code body the metadata tails are appended to.
+	testCodeBody = "6080604052348015600f57600080fd5b50600436106028576000"
+
+	// solc 0.4.7 - 0.5.8; bzzr0 swarm hash only
+	testTailBzzr0 = "a165627a7a72305820" + strings.Repeat("11", 32) + "0029"
+
+	// solc 0.5.9 - 0.5.17; bzzr1 swarm hash and the compiler version 0.5.12
+	testTailBzzr1 = "a265627a7a72315820" + strings.Repeat("22", 32) + "64736f6c6343" + "00050c" + "0032"
+
+	// solc 0.6.0 and later; ipfs hash and the compiler version 0.7.6
+	testTailIpfs = "a264697066735822" + "1220" + strings.Repeat("33", 32) + "64736f6c6343" + "000706" + "0033"
+
+	// vyper 0.3.x; the compiler version 0.3.7 as an array
+	testTailVyper = "a165767970657283000307" + "000b"
+)
+
+// mustHex decodes the hex test data.
+func mustHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatalf("invalid hex test data %s; %s", s, err.Error())
+	}
+	return b
+}
+
+func TestSplitCodeMetadata(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    string
+		wantMd  bool
+		wantLen int
+		wantVer string
+	}{
+		{name: "solc bzzr0", code: testCodeBody + testTailBzzr0, wantMd: true, wantLen: len(testCodeBody) / 2},
+		{name: "solc bzzr1", code: testCodeBody + testTailBzzr1, wantMd: true, wantLen: len(testCodeBody) / 2, wantVer: "0.5.12"},
+		{name: "solc ipfs", code: testCodeBody + testTailIpfs, wantMd: true, wantLen: len(testCodeBody) / 2, wantVer: "0.7.6"},
+		{name: "vyper", code: testCodeBody + testTailVyper, wantMd: true, wantLen: len(testCodeBody) / 2},
+		{name: "metadata only", code: testTailIpfs, wantMd: true, wantVer: "0.7.6"},
+		{name: "no metadata", code: testCodeBody},
+		{name: "empty code", code: ""},
+		{name: "single byte", code: "00"},
+		{name: "zero length", code: testCodeBody + "0000"},
+		{name: "length over code size", code: testCodeBody + "ffff"},
+		{name: "truncated metadata", code: testTailIpfs[20:]},
+		{name: "length too short", code: testCodeBody + testTailIpfs[:len(testTailIpfs)-4] + "0020"},
+		{name: "length too long", code: testCodeBody + testTailIpfs[:len(testTailIpfs)-4] + "0034"},
+		{name: "array metadata", code: testCodeBody + "83010203" + "0004"},
+		{name: "non text map key", code: testCodeBody + "a10102" + "0003"},
+		{name: "indefinite length map", code: testCodeBody + "bf6161ff" + "0004"},
+		{name: "huge byte string", code: testCodeBody + "a161615bffffffffffffffff" + "000c"},
+		{name: "huge map", code: testCodeBody + "bbffffffffffffffff" + "0009"},
+		{name: "huge array", code: testCodeBody + "a161619bffffffffffffffff" + "000c"},
+		{name: "negative int overflow", code: testCodeBody + "a161613bffffffffffffffff" + "000c", wantMd: true, wantLen: len(testCodeBody) / 2},
+		{name: "unsupported simple value", code: testCodeBody + "a16161f8ff" + "0005"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code := mustHex(t, tt.code)
+			body, md := splitCodeMetadata(code)
+
+			// no metadata must keep the code intact
+			if !tt.wantMd {
+				if md != nil {
+					t.Fatalf("unexpected metadata %v", md.fields)
+				}
+				if len(body) != len(code) {
+					t.Fatalf("expected code length %d, got %d", len(code), len(body))
+				}
+				return
+			}
+
+			if md == nil {
+				t.Fatalf("metadata not found")
+			}
+			if len(body) != tt.wantLen {
+				t.Fatalf("expected body length %d, got %d", tt.wantLen, len(body))
+			}
+			if len(body)+len(md.raw) != len(code) {
+				t.Fatalf("body and metadata do not cover the code")
+			}
+
+			// check the compiler version
+			ver, ok := md.solcVersion()
+			if ok != (tt.wantVer != "") || ver != tt.wantVer {
+				t.Errorf("expected version %q, got %q", tt.wantVer, ver)
+			}
+		})
+	}
+}
+
+func TestCodeMetadataEquals(t *testing.T) {
+	_, a := splitCodeMetadata(mustHex(t, testCodeBody+testTailIpfs))
+	_, b := splitCodeMetadata(mustHex(t, testCodeBody+testTailIpfs))
+	_, c := splitCodeMetadata(mustHex(t, testCodeBody+strings.Replace(testTailIpfs, "33", "44", 32)))
+
+	if !a.equals(b) {
+		t.Errorf("same metadata not equal")
+	}
+	if a.equals(c) {
+		t.Errorf("different metadata equal")
+	}
+	if a.equals(nil) {
+		t.Errorf("metadata equal to nil")
+	}
+
+	var n *codeMetadata
+	if !n.equals(nil) {
+		t.Errorf("nil metadata not equal to nil")
+	}
+}
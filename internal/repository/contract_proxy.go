@@ -0,0 +1,318 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"bytes"
+	"encoding/json"
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eth "github.com/ethereum/go-ethereum/core/types"
+	"strings"
+	"time"
+)
+
+// proxyImplementationSlots represents the list of well known storage slots
+// upgradable proxies use to store the address of their implementation.
+var proxyImplementationSlots = []struct {
+	kind string
+	slot common.Hash
+}{
+	// bytes32(uint256(keccak256('eip1967.proxy.implementation')) - 1)
+	{types.ContractProxyEIP1967, common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")},
+	// keccak256("PROXIABLE")
+	{types.ContractProxyEIP1822, common.HexToHash("0xc5f16f0fcc639fa48a6947836d9850f504798523bf8c9a3a87d5876cf622bcf7")},
+	// keccak256("org.zeppelinos.proxy.implementation")
+	{types.ContractProxyOpenZeppelin, common.HexToHash("0x7050c9e0f4ca769c69bd3a8ef740bc37934f8e2c036e5a723fd8ee048ed3f8c3")},
+}
+
+var (
+	// minimalProxyPrefix represents the runtime code of EIP-1167 minimal proxy
+	// in front of the implementation address.
+	minimalProxyPrefix = common.FromHex("0x363d3d373d3d3d363d73")
+
+	// minimalProxySuffix represents the runtime code of EIP-1167 minimal proxy
+	// behind the implementation address.
+	minimalProxySuffix = common.FromHex("0x5af43d82803e903d91602b57fd5bf3")
+)
+
+// proxyRecheckInterval represents the time after which the implementation
+// of a detected upgradable proxy is checked again.
+const proxyRecheckInterval = time.Hour
+
+// nonProxyRecheckInterval represents the time after which a contract
+// not recognized as a proxy is checked again.
+const nonProxyRecheckInterval = 24 * time.Hour
+
+// ContractProxy detects if the contract is a proxy and resolves
+// the address of its current implementation. Nil is returned
+// for contracts not recognized as a proxy. The detection result
+// is stored with the contract and re-used until it expires.
+func (p *proxy) ContractProxy(sc *types.Contract) (*types.ContractProxy, error) {
+	// do we have a recent detection result?
+	if isContractProxyKnown(sc) {
+		return sc.Proxy, nil
+	}
+
+	// detect the proxy on chain
+	px, err := p.detectContractProxy(&sc.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	// store the result so we don't need to detect it again
+	if err := p.db.UpdateContractProxy(&sc.Address, px); err != nil {
+		p.log.Errorf("can not store proxy of contract %s; %s", sc.Address.String(), err.Error())
+	}
+
+	ts := hexutil.Uint64(time.Now().Unix())
+	sc.Proxy = px
+	sc.ProxyChecked = &ts
+	return px, nil
+}
+
+// isContractProxyKnown checks if the stored proxy detection
+// of the contract is still valid.
+func isContractProxyKnown(sc *types.Contract) bool {
+	// was the contract checked at all?
+	if sc.ProxyChecked == nil {
+		return false
+	}
+
+	// minimal proxy clones can not change their implementation
+	if sc.Proxy != nil && sc.Proxy.Type == types.ContractProxyEIP1167 {
+		return true
+	}
+
+	// upgradable proxies are checked more often than regular contracts
+	age := time.Since(time.Unix(int64(*sc.ProxyChecked), 0))
+	if sc.Proxy != nil {
+		return age < proxyRecheckInterval
+	}
+	return age < nonProxyRecheckInterval
+}
+
+// detectContractProxy checks the contract code and the well known
+// implementation slots to detect the proxy type and implementation.
+func (p *proxy) detectContractProxy(addr *common.Address) (*types.ContractProxy, error) {
+	// get the deployed code so we can check for minimal proxy
+	code, err := p.rpc.ContractCode(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	// is this a minimal proxy clone?
+	if impl := minimalProxyImplementation(code); impl != nil {
+		return &types.ContractProxy{Type: types.ContractProxyEIP1167, Implementation: *impl}, nil
+	}
+
+	// check the known implementation slots
+	for _, ps := range proxyImplementationSlots {
+		val, err := p.rpc.StorageAt(addr, &ps.slot)
+		if err != nil {
+			return nil, err
+		}
+
+		// do we have an address here?
+		if val != (common.Hash{}) {
+			return &types.ContractProxy{Type: ps.kind, Implementation: common.BytesToAddress(val.Bytes())}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// minimalProxyImplementation extracts the implementation address
+// from the EIP-1167 minimal proxy runtime code, if the code is such proxy.
+func minimalProxyImplementation(code []byte) *common.Address {
+	// check the size of the code
+	if len(code) != len(minimalProxyPrefix)+common.AddressLength+len(minimalProxySuffix) {
+		return nil
+	}
+
+	// check the code around the address
+	if !bytes.HasPrefix(code, minimalProxyPrefix) || !bytes.HasSuffix(code, minimalProxySuffix) {
+		return nil
+	}
+
+	addr := common.BytesToAddress(code[len(minimalProxyPrefix) : len(minimalProxyPrefix)+common.AddressLength])
+	return &addr
+}
+
+// ContractUpgrades returns the list of implementation upgrades
+// of the proxy contract. Contracts not recognized as upgradable proxy
+// have no upgrades and their logs are not scanned at all.
+func (p *proxy) ContractUpgrades(sc *types.Contract) ([]types.ContractUpgrade, error) {
+	// is this an upgradable proxy?
+	px, err := p.ContractProxy(sc)
+	if err != nil {
+		return nil, err
+	}
+	if px == nil || px.Type == types.ContractProxyEIP1167 {
+		return []types.ContractUpgrade{}, nil
+	}
+
+	// upgrades emitted before the contract was recognized as a proxy are indexed once
+	if !sc.UpgradesIndexed {
+		if err := p.indexContractUpgrades(sc); err != nil {
+			return nil, err
+		}
+	}
+
+	return p.db.ContractUpgrades(&sc.Address)
+}
+
+// indexContractUpgrades stores the past implementation upgrades of the proxy contract
+// so the logs don't need to be scanned again; new upgrades are added by the chain scanner.
+func (p *proxy) indexContractUpgrades(sc *types.Contract) error {
+	// get the deployment transaction so we know where to start
+	tx, err := p.Transaction(&sc.TransactionHash)
+	if err != nil {
+		return err
+	}
+
+	// the deployment block
+	var from uint64
+	if tx.BlockNumber != nil {
+		from = uint64(*tx.BlockNumber)
+	}
+
+	// load the upgrades
+	list, err := p.rpc.ContractUpgrades(&sc.Address, from)
+	if err != nil {
+		return err
+	}
+
+	// store them
+	for i := range list {
+		if err := p.db.AddContractUpgrade(&list[i]); err != nil {
+			return err
+		}
+	}
+
+	// mark the contract so we don't scan the logs again
+	if err := p.db.SetContractUpgradesIndexed(&sc.Address); err != nil {
+		return err
+	}
+	sc.UpgradesIndexed = true
+	return nil
+}
+
+// addContractUpgrades stores the implementation upgrades of proxy contracts
+// found in the given transaction event logs.
+func (p *proxy) addContractUpgrades(logs []*eth.Log) error {
+	for _, up := range p.rpc.ContractUpgradeEvents(logs) {
+		if err := p.db.AddContractUpgrade(&up); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ContractAbi returns the ABI of the contract. The ABI of the implementation
+// is merged into the contract ABI for proxy contracts so the calls
+// made through the proxy can be decoded.
+func (p *proxy) ContractAbi(sc *types.Contract) (string, error) {
+	// detect the proxy
+	// the contract's own ABI is still valid if the detection fails
+	px, err := p.ContractProxy(sc)
+	if err != nil {
+		p.log.Errorf("can not detect proxy of contract %s; %s", sc.Address.String(), err.Error())
+		return sc.Abi, nil
+	}
+	if px == nil {
+		return sc.Abi, nil
+	}
+
+	// get the implementation details
+	impl, err := p.db.Contract(&px.Implementation)
+	if err != nil {
+		return sc.Abi, err
+	}
+
+	// do we have the implementation ABI?
+	if impl == nil || 0 == len(impl.Abi) {
+		return sc.Abi, nil
+	}
+
+	return mergeContractAbi(sc.Abi, impl.Abi), nil
+}
+
+// mergeContractAbi merges the implementation ABI into the proxy ABI.
+// Elements of the proxy take precedence, the implementation constructor
+// is not included since it's never called through the proxy.
+func mergeContractAbi(proxyAbi string, implAbi string) string {
+	// decode both definitions; invalid proxy ABI is treated as empty
+	var pxa, ima []map[string]interface{}
+	if 0 < len(proxyAbi) {
+		if err := json.Unmarshal([]byte(proxyAbi), &pxa); err != nil {
+			pxa = nil
+		}
+	}
+	if err := json.Unmarshal([]byte(implAbi), &ima); err != nil {
+		return proxyAbi
+	}
+
+	// collect the proxy elements
+	known := make(map[string]bool, len(pxa))
+	merged := make([]map[string]interface{}, 0, len(pxa)+len(ima))
+	for _, el := range pxa {
+		known[abiElementKey(el)] = true
+		merged = append(merged, el)
+	}
+
+	// add implementation elements not known to the proxy
+	for _, el := range ima {
+		if el["type"] == "constructor" || known[abiElementKey(el)] {
+			continue
+		}
+		merged = append(merged, el)
+	}
+
+	// encode the result
+	res, err := json.Marshal(merged)
+	if err != nil {
+		return proxyAbi
+	}
+	return string(res)
+}
+
+// abiElementKey builds a unique key of an ABI element
+// from its type, name and types of inputs.
+func abiElementKey(el map[string]interface{}) string {
+	var sb strings.Builder
+	sb.WriteString(elementString(el, "type"))
+	sb.WriteString(":")
+	sb.WriteString(elementString(el, "name"))
+	sb.WriteString("(")
+
+	// add input types
+	if inputs, ok := el["inputs"].([]interface{}); ok {
+		for i, in := range inputs {
+			if i > 0 {
+				sb.WriteString(",")
+			}
+			if arg, ok := in.(map[string]interface{}); ok {
+				sb.WriteString(elementString(arg, "type"))
+			}
+		}
+	}
+
+	sb.WriteString(")")
+	return sb.String()
+}
+
+// elementString extracts a string value from the ABI element.
+func elementString(el map[string]interface{}, key string) string {
+	if val, ok := el[key].(string); ok {
+		return val
+	}
+	return ""
+}
@@ -0,0 +1,203 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"bytes"
+	"encoding/json"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+const (
+	// solcSourceFileName represents the name of the source unit
+	// passed to the Solidity compiler.
+	solcSourceFileName = "Contract.sol"
+
+	// solcDefaultOptimizeRuns represents the default number of optimizer runs
+	// used if the optimizer is enabled, but the number of runs is not specified.
+	solcDefaultOptimizeRuns = 200
+)
+
+// solcInput represents the Solidity compiler standard JSON input.
+// @see https://solidity.readthedocs.io/en/latest/using-the-compiler.html#compiler-input-and-output-json-description
+type solcInput struct {
+	Language string                     `json:"language"`
+	Sources  map[string]solcInputSource `json:"sources"`
+	Settings solcInputSettings          `json:"settings"`
+}
+
+// solcInputSource represents a source unit of the standard JSON input.
+type solcInputSource struct {
+	Content string `json:"content"`
+}
+
+// solcInputSettings represents the compiler settings of the standard JSON input.
+type solcInputSettings struct {
+	Optimizer       solcOptimizer                  `json:"optimizer"`
+	OutputSelection map[string]map[string][]string `json:"outputSelection"`
+}
+
+// solcOptimizer represents the optimizer settings of the standard JSON input.
+type solcOptimizer struct {
+	Enabled bool  `json:"enabled"`
+	Runs    int32 `json:"runs"`
+}
+
+// solcOutput represents the Solidity compiler standard JSON output.
+type solcOutput struct {
+	Errors    []solcOutputError                        `json:"errors"`
+	Contracts map[string]map[string]solcOutputContract `json:"contracts"`
+}
+
+// solcOutputError represents an error, or a warning, reported by the compiler.
+type solcOutputError struct {
+	Severity         string `json:"severity"`
+	FormattedMessage string `json:"formattedMessage"`
+}
+
+// solcOutputContract represents a compiled contract of the standard JSON output.
+type solcOutputContract struct {
+	Abi json.RawMessage `json:"abi"`
+	Evm struct {
+		Bytecode struct {
+			Object string `json:"object"`
+		} `json:"bytecode"`
+		DeployedBytecode struct {
+			Object              string                 `json:"object"`
+			ImmutableReferences map[string][]codeRange `json:"immutableReferences"`
+		} `json:"deployedBytecode"`
+	} `json:"evm"`
+}
+
+// compileSolidity compiles the Solidity source code of the given contract
+// using the compiler standard JSON interface so the optimizer settings
+// of the contract are respected.
+func (p *proxy) compileSolidity(sc *types.Contract, ver *string, code []byte) ([]*compiledContract, error) {
+	// pick the compiler to be used
+	sol, err := p.solidityCompiler(sc, ver, code)
+	if err != nil {
+		p.log.Errorf("can not find Solidity compiler for contract validation; %s", err.Error())
+		return nil, err
+	}
+
+	// prep the compiler input
+	in, err := json.Marshal(solcStandardInput(sc))
+	if err != nil {
+		p.log.Errorf("can not encode Solidity compiler input; %s", err.Error())
+		return nil, err
+	}
+
+	// run the compiler
+	var stderr, stdout bytes.Buffer
+	cmd := exec.Command(sol.Path, "--standard-json")
+	cmd.Stdin = bytes.NewReader(in)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		p.log.Errorf("solidity compiler failed; %s", stderr.String())
+		return nil, fmt.Errorf("solc: %v\n%s", err, stderr.Bytes())
+	}
+
+	// decode the output
+	var out solcOutput
+	if err := json.Unmarshal(stdout.Bytes(), &out); err != nil {
+		p.log.Errorf("can not decode Solidity compiler output; %s", err.Error())
+		return nil, err
+	}
+
+	// any compilation errors?
+	if msg := out.errors(); 0 < len(msg) {
+		p.log.Errorf("solidity code compilation failed")
+		return nil, fmt.Errorf("solidity code compilation failed; %s", msg)
+	}
+
+	return p.solcCompiled(&out, sc, sol.Version), nil
+}
+
+// solcStandardInput builds the Solidity compiler standard JSON input
+// for the given contract.
+func solcStandardInput(sc *types.Contract) *solcInput {
+	// make sure to use sane number of optimization runs
+	runs := sc.OptimizeRuns
+	if sc.IsOptimized && runs <= 0 {
+		runs = solcDefaultOptimizeRuns
+	}
+
+	return &solcInput{
+		Language: types.ContractLanguageSolidity,
+		Sources:  map[string]solcInputSource{solcSourceFileName: {Content: sc.SourceCode}},
+		Settings: solcInputSettings{
+			Optimizer: solcOptimizer{Enabled: sc.IsOptimized, Runs: runs},
+			OutputSelection: map[string]map[string][]string{
+				"*": {"*": {
+					"abi",
+					"evm.bytecode.object",
+					"evm.deployedBytecode.object",
+					"evm.deployedBytecode.immutableReferences",
+				}},
+			},
+		},
+	}
+}
+
+// errors collects error messages reported by the compiler, if any.
+func (out *solcOutput) errors() string {
+	var str strings.Builder
+	for _, e := range out.Errors {
+		if e.Severity == "error" {
+			str.WriteString(e.FormattedMessage)
+		}
+	}
+	return str.String()
+}
+
+// solcCompiled converts compiler output into the list of compiled contracts.
+// Contracts without byte code (interfaces, abstract contracts), or with unlinked
+// libraries, can not be validated and are skipped.
+func (p *proxy) solcCompiled(out *solcOutput, sc *types.Contract, ver string) []*compiledContract {
+	list := make([]*compiledContract, 0)
+	for _, unit := range out.Contracts {
+		for name, c := range unit {
+			// decode the byte code
+			code, err := hexToCode(c.Evm.Bytecode.Object)
+			if err != nil || 0 == len(code) {
+				p.log.Debugf("contract %s skipped; %v", name, err)
+				continue
+			}
+
+			// decode the runtime code
+			runtime, err := hexToCode(c.Evm.DeployedBytecode.Object)
+			if err != nil {
+				p.log.Debugf("contract %s skipped; %s", name, err.Error())
+				continue
+			}
+
+			// collect immutable references
+			imm := make([]codeRange, 0)
+			for _, refs := range c.Evm.DeployedBytecode.ImmutableReferences {
+				imm = append(imm, refs...)
+			}
+
+			list = append(list, &compiledContract{
+				Name:        name,
+				Code:        code,
+				RuntimeCode: runtime,
+				Immutables:  imm,
+				Abi:         string(c.Abi),
+				Language:    types.ContractLanguageSolidity,
+				Version:     ver,
+				Source:      sc.SourceCode,
+			})
+		}
+	}
+	return list
+}
@@ -0,0 +1,131 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"encoding/json"
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common/compiler"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+const (
+	// vyperSourceFileSuffix represents the suffix of Vyper source files.
+	vyperSourceFileSuffix = ".vy"
+
+	// vyperDefaultFileName represents the name of Vyper source file
+	// used if the contract name is not available.
+	vyperDefaultFileName = "Contract"
+)
+
+// vyperFileNameRegexp represents a regular expression for testing if the contract
+// name can be used as the Vyper source file name. Vyper compiler uses the file
+// name as the name of the contract.
+var vyperFileNameRegexp = regexp.MustCompile("^\\w+$")
+
+// compileVyper compiles the Vyper source code of the given contract.
+// Vyper compiler accepts only source files so we need to store the source
+// code into a temporary file first.
+func (p *proxy) compileVyper(sc *types.Contract, ver *string) ([]*compiledContract, error) {
+	// pick the compiler to be used
+	var v string
+	if ver != nil {
+		v = *ver
+	}
+
+	vy, err := p.compilers.vyper(v)
+	if err != nil {
+		p.log.Errorf("can not find Vyper compiler for contract validation; %s", err.Error())
+		return nil, err
+	}
+
+	// store the source code into a temporary directory
+	dir, err := ioutil.TempDir("", "vyper")
+	if err != nil {
+		p.log.Errorf("can not create Vyper source directory; %s", err.Error())
+		return nil, err
+	}
+
+	// make sure to clean up after we are done
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			p.log.Errorf("can not remove Vyper source directory %s; %s", dir, err.Error())
+		}
+	}()
+
+	// write the source file
+	fn := vyperSourceFile(dir, sc)
+	if err := ioutil.WriteFile(fn, []byte(sc.SourceCode), 0600); err != nil {
+		p.log.Errorf("can not store Vyper source code; %s", err.Error())
+		return nil, err
+	}
+
+	// compile the source file
+	contracts, err := compiler.CompileVyper(vy.Path, fn)
+	if err != nil {
+		p.log.Errorf("vyper code compilation failed")
+		return nil, err
+	}
+
+	return p.vyperCompiled(contracts, vy.Version), nil
+}
+
+// vyperCompiled converts Vyper compiler output into the list of compiled contracts.
+func (p *proxy) vyperCompiled(contracts map[string]*compiler.Contract, ver string) []*compiledContract {
+	list := make([]*compiledContract, 0, len(contracts))
+	for name, c := range contracts {
+		// decode the byte code
+		code, err := hexToCode(c.Code)
+		if err != nil {
+			p.log.Debugf("contract %s skipped; %s", name, err.Error())
+			continue
+		}
+
+		// decode the runtime code
+		runtime, err := hexToCode(c.RuntimeCode)
+		if err != nil {
+			p.log.Debugf("contract %s skipped; %s", name, err.Error())
+			continue
+		}
+
+		// encode the ABI
+		abi, err := json.Marshal(c.Info.AbiDefinition)
+		if err != nil {
+			p.log.Debugf("contract %s skipped; %s", name, err.Error())
+			continue
+		}
+
+		// immutables are stored in the data section after the runtime code,
+		// there are no immutable references inside the code to be masked
+		list = append(list, &compiledContract{
+			Name:        name,
+			Code:        code,
+			RuntimeCode: runtime,
+			Immutables:  make([]codeRange, 0),
+			Abi:         string(abi),
+			Language:    types.ContractLanguageVyper,
+			Version:     ver,
+			Source:      c.Info.Source,
+		})
+	}
+	return list
+}
+
+// vyperSourceFile builds the path of Vyper source file for the given contract.
+func vyperSourceFile(dir string, sc *types.Contract) string {
+	// use the contract name for the file name, if possible
+	if 0 < len(sc.Name) && vyperFileNameRegexp.MatchString(sc.Name) {
+		return filepath.Join(dir, sc.Name+vyperSourceFileSuffix)
+	}
+
+	return filepath.Join(dir, vyperDefaultFileName+vyperSourceFileSuffix)
+}
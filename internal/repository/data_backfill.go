@@ -0,0 +1,98 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/logger"
+	"sync"
+	"time"
+)
+
+const (
+	// backfillPause represents the pause between two backfill steps
+	// so the backfill does not overload the node and the database.
+	backfillPause = 250 * time.Millisecond
+
+	// backfillIdleInterval represents the interval of checking for new work
+	// after all the backfill jobs are complete.
+	backfillIdleInterval = 10 * time.Minute
+)
+
+// dataBackfill implements a service filling in the data stored
+// before the fields and collections using them were introduced.
+type dataBackfill struct {
+	service
+}
+
+// newDataBackfill creates a new data backfill service and starts it.
+func newDataBackfill(repo Repository, log logger.Logger, wg *sync.WaitGroup) *dataBackfill {
+	// create new backfill
+	bf := dataBackfill{
+		service: newService("data backfill", repo, log, wg),
+	}
+
+	// add self to the wait group and run the backfill routine
+	wg.Add(1)
+	go bf.run()
+
+	return &bf
+}
+
+// run executes the backfill steps until all the jobs are complete.
+func (bf *dataBackfill) run() {
+	// don't forget to sign off after we are done
+	defer func() {
+		// log finish
+		bf.log.Notice("data backfill done")
+		bf.wg.Done()
+	}()
+
+	// inform about the action
+	bf.log.Notice("data backfill is running")
+
+	for {
+		// do the next step; wait longer if there is nothing to do
+		wait := backfillIdleInterval
+		if bf.step() {
+			wait = backfillPause
+		}
+
+		select {
+		case <-bf.sigStop:
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// step executes a single step of all the unfinished backfill jobs.
+// It returns true if any of the jobs has more work to do.
+func (bf *dataBackfill) step() bool {
+	jobs := []struct {
+		name string
+		run  func() (bool, error)
+	}{
+		{"blocks", bf.repo.BackfillBlocks},
+		{"transactions", bf.repo.BackfillTransactions},
+		{"accounts", bf.repo.BackfillAccounts},
+		{"ballots", bf.repo.BackfillBallots},
+	}
+
+	busy := false
+	for _, job := range jobs {
+		done, err := job.run()
+		if err != nil {
+			bf.log.Errorf("%s backfill failed; %s", job.name, err.Error())
+		}
+		if !done {
+			busy = true
+		}
+	}
+	return busy
+}
@@ -46,6 +46,17 @@ const (
 	// fiAccountTxTimeStamp is the name of the account transaction time stamp field.
 	fiAccountTxTimeStamp = "ts"
 
+	// fiAccountTxCounterparty is the name of the account transaction counterparty address field.
+	fiAccountTxCounterparty = "cp"
+
+	// fiAccountTxValueDecimal is the name of the account transaction value in WEI field
+	// in the decimal form usable for range queries and sums.
+	fiAccountTxValueDecimal = "vdc"
+
+	// fiAccountFirstSeen is the name of the time stamp field of the first known account transaction.
+	// db.account.createIndex({fs:1})
+	fiAccountFirstSeen = "fs"
+
 	// fiScCreationTx is the hash of the transaction which created the contract.
 	fiScCreationTx = "sc"
 
@@ -160,12 +171,17 @@ func (db *MongoDbBridge) AddAccountTransaction(acc *types.Account, block *types.
 				{fiAccountActivity, uint64(block.TimeStamp)},
 				{fiAccountBalance, ftm.Uint64()},
 			}},
+			{"$min", bson.D{
+				{fiAccountFirstSeen, uint64(block.TimeStamp)},
+			}},
 			{"$addToSet", bson.D{
 				{fiAccountTxList, bson.D{
 					{fiAccountTxHash, trx.Hash.String()},
 					{fiAccountTxDirection, dir},
 					{fiAccountTxValue, trx.Value.String()},
 					{fiAccountTxTimeStamp, uint64(block.TimeStamp)},
+					{fiAccountTxCounterparty, accountTrxCounterparty(dir, trx.From.String(), optionalAddress(trx.To), optionalAddress(trx.ContractAddress))},
+					{fiAccountTxValueDecimal, decimalValue(trx.Value.ToInt())},
 				}},
 			}},
 		})
@@ -179,6 +195,21 @@ func (db *MongoDbBridge) AddAccountTransaction(acc *types.Account, block *types.
 	return nil
 }
 
+// accountTrxCounterparty resolves the address of the other side of the account transaction
+// of the given direction from the sender, the recipient and the created contract.
+func accountTrxCounterparty(dir int, from string, to interface{}, sc interface{}) interface{} {
+	// incoming transactions and contract creations come from the sender
+	if dir != 0 {
+		return from
+	}
+
+	// outgoing transactions go to the recipient, or create a contract
+	if to != nil {
+		return to
+	}
+	return sc
+}
+
 // isAccountTransactionKnown verifies if the transaction is already listed for the account address given.
 func (db *MongoDbBridge) isAccountTransactionKnown(addr *common.Address, hash *types.Hash) (bool, error) {
 	// get the collection for account transactions
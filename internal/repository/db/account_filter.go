@@ -0,0 +1,323 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"context"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"math/big"
+)
+
+// fiAccountTxIndex is the name of the field carrying the index of the account transaction
+// inside the embedded transaction list in the filtered list pipeline.
+const fiAccountTxIndex = "ix"
+
+// accountTrxFilterQuery creates the query of the filtered list of account transactions.
+// The conditions apply to the transaction records embedded in the account document.
+func accountTrxFilterQuery(f *types.AccountTransactionFilter) (bson.D, error) {
+	filter := bson.D{}
+
+	// apply the direction; contract creation is received by the contract
+	if f.Direction != nil {
+		switch *f.Direction {
+		case types.TransactionDirectionOut:
+			filter = append(filter, bson.E{Key: accountTxPath(fiAccountTxDirection), Value: 0})
+		case types.TransactionDirectionIn:
+			filter = append(filter, bson.E{Key: accountTxPath(fiAccountTxDirection), Value: bson.D{{"$in", bson.A{1, 2}}}})
+		default:
+			return nil, fmt.Errorf("unknown transaction direction %s", *f.Direction)
+		}
+	}
+
+	// limit to the counterparty
+	if f.Counterparty != nil {
+		filter = append(filter, bson.E{Key: accountTxPath(fiAccountTxCounterparty), Value: f.Counterparty.String()})
+	}
+
+	// value range
+	rng, err := decimalRangeQuery(f.MinValue, f.MaxValue)
+	if err != nil {
+		return nil, err
+	}
+	if rng != nil {
+		filter = append(filter, bson.E{Key: accountTxPath(fiAccountTxValueDecimal), Value: rng})
+	}
+
+	// time range
+	if rng := txRangeQuery(uint64RangeValue(f.FromTime), uint64RangeValue(f.ToTime)); rng != nil {
+		filter = append(filter, bson.E{Key: accountTxPath(fiAccountTxTimeStamp), Value: rng})
+	}
+
+	return filter, nil
+}
+
+// accountTxPath builds the path of the given field of the embedded account transaction.
+func accountTxPath(field string) string {
+	return fiAccountTxList + "." + field
+}
+
+// accountTrxFilterPipeline creates the base of the aggregation pipeline unwinding
+// the account transactions matching the given filter with their list index.
+func accountTrxFilterPipeline(addr *common.Address, filter bson.D) bson.A {
+	return bson.A{
+		bson.D{{"$match", bson.D{{fiAccountPk, addr.String()}}}},
+		bson.D{{"$unwind", bson.D{
+			{"path", "$" + fiAccountTxList},
+			{"includeArrayIndex", fiAccountTxIndex},
+		}}},
+		bson.D{{"$match", filter}},
+	}
+}
+
+// AccountTransactionsFiltered loads list of hashes of account transactions
+// matching the given filter. The cursor is the hash of a transaction, same as
+// for the unfiltered account transactions list.
+func (db *MongoDbBridge) AccountTransactionsFiltered(acc *types.Account, cursor *string, count int32, f *types.AccountTransactionFilter) (*types.TransactionHashList, error) {
+	// nothing to load?
+	if count == 0 {
+		return nil, fmt.Errorf("nothing to do, zero transactions requested")
+	}
+
+	// no account given?
+	if acc == nil {
+		return nil, fmt.Errorf("can not list transactions of empty account")
+	}
+
+	// build the filter
+	filter, err := accountTrxFilterQuery(f)
+	if err != nil {
+		return nil, err
+	}
+
+	// get the collection
+	col := db.client.Database(db.dbName).Collection(coAccounts)
+
+	// count the matching transactions
+	total, err := db.accountTrxFilteredCount(col, &acc.Address, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// prep the list
+	list := types.TransactionHashList{
+		Collection: make([]*types.Hash, 0),
+		Total:      total,
+	}
+
+	// build the loading pipeline; new transactions are on the bottom of the embedded list
+	pipeline := accountTrxFilterPipeline(&acc.Address, filter)
+	if cursor != nil {
+		// find the cursor position
+		cix, err := db.accountTrxIndex(col, &acc.Address, cursor)
+		if err != nil {
+			db.log.Errorf("invalid cursor value; %s", err.Error())
+			return nil, err
+		}
+
+		op := "$gt"
+		if count > 0 {
+			op = "$lt"
+		}
+		pipeline = append(pipeline, bson.D{{"$match", bson.D{{fiAccountTxIndex, bson.D{{op, cix}}}}}})
+	}
+
+	// sort and limit; we try to get one more to see if we reached the boundary
+	dir, limit := -1, int64(count)
+	if count < 0 {
+		dir, limit = 1, -limit
+	}
+	pipeline = append(pipeline,
+		bson.D{{"$sort", bson.D{{fiAccountTxIndex, dir}}}},
+		bson.D{{"$limit", limit + 1}},
+		bson.D{{"$project", bson.D{
+			{fiAccountTxHash, "$" + accountTxPath(fiAccountTxHash)},
+			{fiAccountTxIndex, true},
+		}}},
+	)
+
+	// load the list
+	if err := db.accountTrxFilteredLoad(col, pipeline, limit, &list); err != nil {
+		return nil, err
+	}
+
+	// check the boundaries; the list is loaded new to old for positive count
+	more := int64(len(list.Collection)) > limit
+	if more {
+		list.Collection = list.Collection[:limit]
+	}
+	if count > 0 {
+		list.IsStart, list.IsEnd = cursor == nil, !more
+	} else {
+		list.IsStart, list.IsEnd = !more, cursor == nil
+		list.Reverse()
+	}
+
+	return &list, nil
+}
+
+// accountTrxFilteredCount calculates the number of account transactions matching the filter.
+func (db *MongoDbBridge) accountTrxFilteredCount(col *mongo.Collection, addr *common.Address, filter bson.D) (uint64, error) {
+	// get the context
+	ctx := context.Background()
+
+	// count the transactions
+	ld, err := col.Aggregate(ctx, append(accountTrxFilterPipeline(addr, filter), bson.D{{"$count", "total"}}))
+	if err != nil {
+		db.log.Errorf("can not count transactions of %s; %s", addr.String(), err.Error())
+		return 0, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing account transactions count cursor; %s", err.Error())
+		}
+	}()
+
+	// no row means no matching transactions
+	if !ld.Next(ctx) {
+		return 0, ld.Err()
+	}
+
+	var row struct {
+		Total int64 `bson:"total"`
+	}
+	if err := ld.Decode(&row); err != nil {
+		db.log.Errorf("can not decode transactions count; %s", err.Error())
+		return 0, err
+	}
+	return uint64(row.Total), nil
+}
+
+// accountTrxFilteredLoad loads the hashes of the account transactions using the given pipeline.
+func (db *MongoDbBridge) accountTrxFilteredLoad(col *mongo.Collection, pipeline bson.A, limit int64, list *types.TransactionHashList) error {
+	// get the context
+	ctx := context.Background()
+
+	// load the rows
+	ld, err := col.Aggregate(ctx, pipeline)
+	if err != nil {
+		db.log.Errorf("can not load filtered account transactions; %s", err.Error())
+		return err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing account transactions cursor; %s", err.Error())
+		}
+	}()
+
+	for ld.Next(ctx) {
+		var row struct {
+			Hash  string `bson:"hash"`
+			Index uint64 `bson:"ix"`
+		}
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode account transaction; %s", err.Error())
+			return err
+		}
+
+		// keep the index range of the page
+		if int64(len(list.Collection)) < limit {
+			if 0 == len(list.Collection) {
+				list.First = row.Index
+			}
+			list.Last = row.Index
+		}
+
+		hash := types.HexToHash(row.Hash)
+		list.Collection = append(list.Collection, &hash)
+	}
+	return ld.Err()
+}
+
+// AccountTxSummary aggregates the transactions of the account stored
+// in the account document. Contract creation is counted as received.
+func (db *MongoDbBridge) AccountTxSummary(acc *types.Account) (*types.AccountTxSummary, error) {
+	// get the collection and context
+	col := db.client.Database(db.dbName).Collection(coAccounts)
+	ctx := context.Background()
+
+	// the direction and the value of the embedded transaction
+	isSent := bson.D{{"$eq", bson.A{"$" + accountTxPath(fiAccountTxDirection), 0}}}
+	value := bson.D{{"$ifNull", bson.A{"$" + accountTxPath(fiAccountTxValueDecimal), decimalValue(new(big.Int))}}}
+
+	// sum the embedded transaction records
+	ld, err := col.Aggregate(ctx, bson.A{
+		bson.D{{"$match", bson.D{{fiAccountPk, acc.Address.String()}}}},
+		bson.D{{"$unwind", "$" + fiAccountTxList}},
+		bson.D{{"$group", bson.D{
+			{"_id", nil},
+			{"sent", bson.D{{"$sum", bson.D{{"$cond", bson.A{isSent, value, decimalValue(new(big.Int))}}}}}},
+			{"received", bson.D{{"$sum", bson.D{{"$cond", bson.A{isSent, decimalValue(new(big.Int)), value}}}}}},
+			{"sentCount", bson.D{{"$sum", bson.D{{"$cond", bson.A{isSent, 1, 0}}}}}},
+			{"receivedCount", bson.D{{"$sum", bson.D{{"$cond", bson.A{isSent, 0, 1}}}}}},
+			{"first", bson.D{{"$min", "$" + accountTxPath(fiAccountTxTimeStamp)}}},
+			{"last", bson.D{{"$max", "$" + accountTxPath(fiAccountTxTimeStamp)}}},
+		}}},
+	})
+	if err != nil {
+		db.log.Errorf("can not aggregate transactions of %s; %s", acc.Address.String(), err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing account transactions cursor; %s", err.Error())
+		}
+	}()
+
+	// no transactions at all?
+	if !ld.Next(ctx) {
+		if err := ld.Err(); err != nil {
+			return nil, err
+		}
+		return &types.AccountTxSummary{}, nil
+	}
+
+	// decode the totals
+	var row struct {
+		Sent          primitive.Decimal128 `bson:"sent"`
+		Received      primitive.Decimal128 `bson:"received"`
+		SentCount     int64                `bson:"sentCount"`
+		ReceivedCount int64                `bson:"receivedCount"`
+		First         int64                `bson:"first"`
+		Last          int64                `bson:"last"`
+	}
+	if err := ld.Decode(&row); err != nil {
+		db.log.Errorf("can not decode account transactions summary; %s", err.Error())
+		return nil, err
+	}
+
+	return accountTxSummary(row.Sent, row.Received, row.SentCount, row.ReceivedCount, row.First, row.Last)
+}
+
+// accountTxSummary builds the account transactions summary from the aggregated values.
+func accountTxSummary(sent, received primitive.Decimal128, sentCount, receivedCount, first, last int64) (*types.AccountTxSummary, error) {
+	// convert the totals
+	ts, err := decimalToBig(sent)
+	if err != nil {
+		return nil, err
+	}
+	tr, err := decimalToBig(received)
+	if err != nil {
+		return nil, err
+	}
+
+	fa, la := hexutil.Uint64(first), hexutil.Uint64(last)
+	return &types.AccountTxSummary{
+		TotalSent:     hexutil.Big(*ts),
+		TotalReceived: hexutil.Big(*tr),
+		SentCount:     hexutil.Uint64(sentCount),
+		ReceivedCount: hexutil.Uint64(receivedCount),
+		FirstActivity: &fa,
+		LastActivity:  &la,
+	}, nil
+}
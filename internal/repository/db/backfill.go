@@ -0,0 +1,413 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"context"
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"math/big"
+)
+
+const (
+	// coBackfill is the name of the off-chain database collection keeping the progress
+	// of jobs filling in the data stored before the fields were introduced.
+	coBackfill = "db_backfill"
+
+	// fiBackfillPk is the name of the primary key field of the backfill collection, the job id.
+	fiBackfillPk = "_id"
+
+	// fiBackfillPosition is the name of the field of the last processed position of the job.
+	fiBackfillPosition = "pos"
+
+	// fiBackfillDone is the name of the field signalling the job is complete.
+	fiBackfillDone = "done"
+
+	// BackfillTransactions is the id of the job filling the value and status of stored transactions.
+	BackfillTransactions = "trx_value_status"
+
+	// BackfillBlocks is the id of the job storing the blocks created before the blocks were stored.
+	BackfillBlocks = "block_history"
+
+	// BackfillAccounts is the id of the job filling the counterparty and value of account transactions.
+	BackfillAccounts = "account_trx"
+
+	// BackfillBallots is the id of the job detecting official ballots among the stored contracts.
+	BackfillBallots = "ballot_contracts"
+
+	// BackfillNetworkStats is the prefix of the id of the job aggregating the network stats
+	// of the past periods; the resolution of the stats is appended.
+	BackfillNetworkStats = "network_stats_"
+
+	// backfillLookupBatch is the max number of transactions looked up at once by the accounts backfill.
+	backfillLookupBatch = 1000
+)
+
+// backfillRow defines a row in the backfill collection.
+type backfillRow struct {
+	Id       string `bson:"_id"`
+	Position string `bson:"pos"`
+	Done     bool   `bson:"done"`
+}
+
+// BackfillPosition returns the last processed position of the given backfill job
+// and the flag signalling the job is complete. Empty position is returned
+// for a job not started yet.
+func (db *MongoDbBridge) BackfillPosition(job string) (string, bool, error) {
+	// get the collection
+	col := db.client.Database(db.dbName).Collection(coBackfill)
+
+	// try to find the job
+	var row backfillRow
+	err := col.FindOne(context.Background(), bson.D{{fiBackfillPk, job}}).Decode(&row)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return "", false, nil
+		}
+
+		db.log.Errorf("can not load backfill %s progress; %s", job, err.Error())
+		return "", false, err
+	}
+	return row.Position, row.Done, nil
+}
+
+// SetBackfillPosition stores the last processed position of the given backfill job.
+func (db *MongoDbBridge) SetBackfillPosition(job string, pos string, done bool) error {
+	// get the collection
+	col := db.client.Database(db.dbName).Collection(coBackfill)
+
+	// do the upsert
+	_, err := col.UpdateOne(context.Background(),
+		bson.D{{fiBackfillPk, job}},
+		bson.D{{"$set", bson.D{
+			{fiBackfillPosition, pos},
+			{fiBackfillDone, done},
+		}}},
+		options.Update().SetUpsert(true))
+	if err != nil {
+		db.log.Errorf("can not store backfill %s progress; %s", job, err.Error())
+		return err
+	}
+
+	// inform about the completion
+	if done {
+		db.log.Noticef("backfill %s done", job)
+	}
+	return nil
+}
+
+// IsBackfillDone checks if the given backfill job is complete.
+func (db *MongoDbBridge) IsBackfillDone(job string) bool {
+	_, done, err := db.BackfillPosition(job)
+	return err == nil && done
+}
+
+// backfillTransactionRow defines the fields of a transaction row
+// inspected by the transactions backfill.
+type backfillTransactionRow struct {
+	Hash    string        `bson:"_id"`
+	Orx     uint64        `bson:"orx"`
+	Value   string        `bson:"val"`
+	Decimal bson.RawValue `bson:"vdc"`
+	Status  bson.RawValue `bson:"stat"`
+}
+
+// BackfillTransactionValues fills the decimal value of the stored transactions following
+// the given ordinal index. It returns the ordinal index of the last inspected transaction
+// and the list of inspected transactions without the status, the status is not available
+// in the database. The same index is returned if there are no more transactions.
+func (db *MongoDbBridge) BackfillTransactionValues(after uint64, limit int64) (uint64, []types.Hash, error) {
+	// get the collection and context
+	col := db.client.Database(db.dbName).Collection(coTransactions)
+	ctx := context.Background()
+
+	// load the batch
+	ld, err := col.Find(ctx, bson.D{{fiTransactionOrdinalIndex, bson.D{{"$gt", after}}}}, options.Find().
+		SetSort(bson.D{{fiTransactionOrdinalIndex, 1}}).
+		SetLimit(limit).
+		SetProjection(bson.D{
+			{fiTransactionOrdinalIndex, true},
+			{fiTransactionValue, true},
+			{fiTransactionValueDecimal, true},
+			{fiTransactionStatus, true},
+		}))
+	if err != nil {
+		db.log.Errorf("can not load transactions to backfill; %s", err.Error())
+		return after, nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing transactions backfill cursor; %s", err.Error())
+		}
+	}()
+
+	// inspect the transactions
+	last := after
+	noStatus := make([]types.Hash, 0)
+	updates := make([]mongo.WriteModel, 0)
+	for ld.Next(ctx) {
+		var row backfillTransactionRow
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode transaction to backfill; %s", err.Error())
+			return after, nil, err
+		}
+		last = row.Orx
+
+		// missing decimal value?
+		if row.Decimal.Type == 0 {
+			val, err := hexutil.DecodeBig(row.Value)
+			if err != nil {
+				db.log.Errorf("invalid value of transaction %s; %s", row.Hash, err.Error())
+				val = new(big.Int)
+			}
+
+			updates = append(updates, mongo.NewUpdateOneModel().
+				SetFilter(bson.D{{fiTransactionPk, row.Hash}}).
+				SetUpdate(bson.D{{"$set", bson.D{{fiTransactionValueDecimal, decimalValue(val)}}}}))
+		}
+
+		// missing status?
+		if row.Status.Type == 0 || row.Status.Type == bsontype.Null {
+			noStatus = append(noStatus, types.HexToHash(row.Hash))
+		}
+	}
+
+	// store the values
+	if 0 < len(updates) {
+		if _, err := col.BulkWrite(ctx, updates, options.BulkWrite().SetOrdered(false)); err != nil {
+			db.log.Errorf("can not backfill transaction values; %s", err.Error())
+			return after, nil, err
+		}
+	}
+	return last, noStatus, nil
+}
+
+// SetTransactionStatus stores the status of the given transactions.
+// Transactions with unknown status are skipped.
+func (db *MongoDbBridge) SetTransactionStatus(hashes []types.Hash, status []*hexutil.Uint64) error {
+	// prep the updates
+	updates := make([]mongo.WriteModel, 0, len(hashes))
+	for i, hash := range hashes {
+		if status[i] == nil {
+			continue
+		}
+
+		updates = append(updates, mongo.NewUpdateOneModel().
+			SetFilter(bson.D{{fiTransactionPk, hash.String()}}).
+			SetUpdate(bson.D{{"$set", bson.D{{fiTransactionStatus, uint64(*status[i])}}}}))
+	}
+
+	// anything to do?
+	if 0 == len(updates) {
+		return nil
+	}
+
+	// store the status
+	col := db.client.Database(db.dbName).Collection(coTransactions)
+	if _, err := col.BulkWrite(context.Background(), updates, options.BulkWrite().SetOrdered(false)); err != nil {
+		db.log.Errorf("can not backfill transaction status; %s", err.Error())
+		return err
+	}
+	return nil
+}
+
+// backfillAccountRow defines the fields of an account row inspected by the accounts backfill.
+type backfillAccountRow struct {
+	Id  string `bson:"_id"`
+	Trx []struct {
+		Hash         string        `bson:"hash"`
+		Direction    int           `bson:"dir"`
+		Value        string        `bson:"val"`
+		TimeStamp    uint64        `bson:"ts"`
+		Counterparty bson.RawValue `bson:"cp"`
+	} `bson:"trx"`
+}
+
+// BackfillAccountTransactions fills the counterparty and the decimal value of the transactions
+// embedded in the accounts following the given account address, and the first seen time
+// of the accounts. It returns the address
+// of the last inspected account, or the same address if there are no more accounts.
+func (db *MongoDbBridge) BackfillAccountTransactions(after string, limit int64) (string, error) {
+	// get the collection and context
+	col := db.client.Database(db.dbName).Collection(coAccounts)
+	ctx := context.Background()
+
+	// load the batch
+	ld, err := col.Find(ctx, bson.D{{fiAccountPk, bson.D{{"$gt", after}}}}, options.Find().
+		SetSort(bson.D{{fiAccountPk, 1}}).
+		SetLimit(limit).
+		SetProjection(bson.D{{fiAccountTxList, true}}))
+	if err != nil {
+		db.log.Errorf("can not load accounts to backfill; %s", err.Error())
+		return after, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing accounts backfill cursor; %s", err.Error())
+		}
+	}()
+
+	// inspect the accounts
+	last := after
+	for ld.Next(ctx) {
+		var row backfillAccountRow
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode account to backfill; %s", err.Error())
+			return after, err
+		}
+
+		if err := db.backfillAccount(col, &row); err != nil {
+			return last, err
+		}
+		last = row.Id
+	}
+	return last, nil
+}
+
+// backfillAccount fills the counterparty and the decimal value of the account transactions missing them
+// and the first seen time stamp of accounts not updated since the field was introduced.
+func (db *MongoDbBridge) backfillAccount(col *mongo.Collection, row *backfillAccountRow) error {
+	// collect the transactions to be updated
+	updates := make([]mongo.WriteModel, 0)
+
+	// the first seen time is the time of the oldest known transaction
+	if fs := backfillAccountFirstSeen(row); fs > 0 {
+		updates = append(updates, mongo.NewUpdateOneModel().
+			SetFilter(bson.D{{fiAccountPk, row.Id}}).
+			SetUpdate(bson.D{{"$min", bson.D{{fiAccountFirstSeen, fs}}}}))
+	}
+
+	for from := 0; from < len(row.Trx); from += backfillLookupBatch {
+		to := from + backfillLookupBatch
+		if to > len(row.Trx) {
+			to = len(row.Trx)
+		}
+
+		// find the transactions missing the counterparty
+		hashes := make(bson.A, 0)
+		for _, tx := range row.Trx[from:to] {
+			if tx.Counterparty.Type == 0 {
+				hashes = append(hashes, tx.Hash)
+			}
+		}
+		if 0 == len(hashes) {
+			continue
+		}
+
+		// load the sender, the recipient and the contract of the transactions
+		parties, err := db.transactionParties(hashes)
+		if err != nil {
+			return err
+		}
+
+		for _, tx := range row.Trx[from:to] {
+			if tx.Counterparty.Type != 0 {
+				continue
+			}
+
+			// decode the value
+			val, err := hexutil.DecodeBig(tx.Value)
+			if err != nil {
+				db.log.Errorf("invalid value of account transaction %s; %s", tx.Hash, err.Error())
+				val = new(big.Int)
+			}
+
+			// resolve the counterparty; unknown transactions get an empty one
+			var cp interface{}
+			if pt, ok := parties[tx.Hash]; ok {
+				cp = accountTrxCounterparty(tx.Direction, pt.From, optionalString(pt.To), optionalString(pt.Contract))
+			}
+
+			updates = append(updates, mongo.NewUpdateOneModel().
+				SetFilter(bson.D{{fiAccountPk, row.Id}, {fiAccountTxHashPath, tx.Hash}}).
+				SetUpdate(bson.D{{"$set", bson.D{
+					{fiAccountTxList + ".$." + fiAccountTxCounterparty, cp},
+					{fiAccountTxList + ".$." + fiAccountTxValueDecimal, decimalValue(val)},
+				}}}))
+		}
+	}
+
+	// anything to do?
+	if 0 == len(updates) {
+		return nil
+	}
+
+	// store the changes
+	if _, err := col.BulkWrite(context.Background(), updates, options.BulkWrite().SetOrdered(false)); err != nil {
+		db.log.Errorf("can not backfill transactions of account %s; %s", row.Id, err.Error())
+		return err
+	}
+	return nil
+}
+
+// backfillAccountFirstSeen finds the time stamp of the oldest transaction of the account.
+func backfillAccountFirstSeen(row *backfillAccountRow) uint64 {
+	var fs uint64
+	for _, tx := range row.Trx {
+		if tx.TimeStamp > 0 && (fs == 0 || tx.TimeStamp < fs) {
+			fs = tx.TimeStamp
+		}
+	}
+	return fs
+}
+
+// transactionPartiesRow defines the sender, the recipient and the created contract of a transaction.
+type transactionPartiesRow struct {
+	Hash     string  `bson:"_id"`
+	From     string  `bson:"from"`
+	To       *string `bson:"to"`
+	Contract *string `bson:"sc"`
+}
+
+// transactionParties loads the sender, the recipient and the created contract
+// of the given transactions by their hash.
+func (db *MongoDbBridge) transactionParties(hashes bson.A) (map[string]transactionPartiesRow, error) {
+	// get the collection and context
+	col := db.client.Database(db.dbName).Collection(coTransactions)
+	ctx := context.Background()
+
+	// load the transactions
+	ld, err := col.Find(ctx, bson.D{{fiTransactionPk, bson.D{{"$in", hashes}}}}, options.Find().SetProjection(bson.D{
+		{fiTransactionSender, true},
+		{fiTransactionRecipient, true},
+		{fiTransactionContract, true},
+	}))
+	if err != nil {
+		db.log.Errorf("can not load transaction parties; %s", err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing transaction parties cursor; %s", err.Error())
+		}
+	}()
+
+	// collect the parties
+	res := make(map[string]transactionPartiesRow, len(hashes))
+	for ld.Next(ctx) {
+		var row transactionPartiesRow
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode transaction parties; %s", err.Error())
+			return nil, err
+		}
+		res[row.Hash] = row
+	}
+	return res, ld.Err()
+}
+
+// optionalString returns the string value, or nil.
+func optionalString(val *string) interface{} {
+	if val == nil {
+		return nil
+	}
+	return *val
+}
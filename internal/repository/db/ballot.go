@@ -0,0 +1,346 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"context"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// coBallots is the name of the off-chain database collection
+	// storing official voting ballots.
+	coBallots = "ballot"
+
+	// fiBallotPk is the name of the primary key field of the ballot collection.
+	fiBallotPk = "_id"
+
+	// fiBallotName is the name of the ballot name field.
+	fiBallotName = "name"
+
+	// fiBallotDetailsUrl is the name of the ballot details URL field.
+	fiBallotDetailsUrl = "url"
+
+	// fiBallotFinalized is the name of the ballot finalized flag field.
+	fiBallotFinalized = "fin"
+
+	// fiBallotVotes is the name of the total number of votes field.
+	fiBallotVotes = "votes"
+
+	// fiBallotProposals is the name of the field of the proposals with their vote tally.
+	fiBallotProposals = "prop"
+
+	// fiBallotWinner is the name of the winning proposal field.
+	fiBallotWinner = "win"
+
+	// fiBallotStart is the name of the voting start field.
+	fiBallotStart = "start"
+
+	// fiBallotEnd is the name of the voting end field.
+	// db.ballot.createIndex({end:-1})
+	fiBallotEnd = "end"
+
+	// fiBallotTimestamp is the name of the ballot deployment time stamp field.
+	fiBallotTimestamp = "ts"
+
+	// coVotes is the name of the off-chain database collection
+	// storing votes cast in official ballots.
+	coVotes = "vote"
+
+	// fiVotePk is the name of the primary key field of the vote collection.
+	fiVotePk = "_id"
+
+	// fiVoteBallot is the name of the ballot address field.
+	fiVoteBallot = "ballot"
+
+	// fiVoteVoter is the name of the voter address field.
+	// db.vote.createIndex({voter:1,ts:-1})
+	fiVoteVoter = "voter"
+
+	// fiVoteProposal is the name of the proposal index field.
+	fiVoteProposal = "prop"
+
+	// fiVoteTransaction is the name of the vote transaction hash field.
+	fiVoteTransaction = "tx"
+
+	// fiVoteTimestamp is the name of the vote time stamp field.
+	fiVoteTimestamp = "ts"
+
+	// ballotsMaxList is the max number of ballots, or votes, returned in a list.
+	ballotsMaxList = 100
+)
+
+// ballotRow defines a row in the ballots collection.
+type ballotRow struct {
+	Address    string              `bson:"_id"`
+	Name       string              `bson:"name"`
+	DetailsUrl string              `bson:"url"`
+	Start      uint64              `bson:"start"`
+	End        uint64              `bson:"end"`
+	Finalized  bool                `bson:"fin"`
+	Votes      uint64              `bson:"votes"`
+	Proposals  []ballotProposalRow `bson:"prop"`
+	Winner     *int32              `bson:"win"`
+	TimeStamp  uint64              `bson:"ts"`
+}
+
+// ballotProposalRow defines a proposal record of a row in the ballots collection.
+type ballotProposalRow struct {
+	Name   string `bson:"name"`
+	Weight string `bson:"w"`
+	Votes  uint64 `bson:"votes"`
+}
+
+// voteRow defines a row in the votes collection.
+type voteRow struct {
+	Ballot      string `bson:"ballot"`
+	Voter       string `bson:"voter"`
+	Proposal    int32  `bson:"prop"`
+	Transaction string `bson:"tx"`
+	TimeStamp   uint64 `bson:"ts"`
+}
+
+// newBallot creates a new ballot from the database row.
+func newBallot(row *ballotRow) *types.Ballot {
+	ballot := types.Ballot{
+		Address:     common.HexToAddress(row.Address),
+		Name:        row.Name,
+		DetailsUrl:  row.DetailsUrl,
+		Start:       hexutil.Uint64(row.Start),
+		End:         hexutil.Uint64(row.End),
+		IsFinalized: row.Finalized,
+		TotalVotes:  hexutil.Uint64(row.Votes),
+		Proposals:   make([]types.BallotProposal, len(row.Proposals)),
+		Winner:      row.Winner,
+	}
+
+	for i, pr := range row.Proposals {
+		ballot.Proposals[i] = types.BallotProposal{
+			Name:   pr.Name,
+			Weight: hexutil.Big(*hexutil.MustDecodeBig(pr.Weight)),
+			Votes:  hexutil.Uint64(pr.Votes),
+		}
+	}
+
+	return &ballot
+}
+
+// AddBallot stores the official ballot with its details in the persistent storage.
+// Known ballots are updated with the current details, the deployment time stamp
+// is kept from the first insert.
+func (db *MongoDbBridge) AddBallot(ballot *types.Ballot, ts hexutil.Uint64) error {
+	// do we have the ballot?
+	if ballot == nil {
+		return fmt.Errorf("can not add empty ballot")
+	}
+
+	// get the collection for ballots
+	col := db.client.Database(db.dbName).Collection(coBallots)
+
+	// encode the proposals
+	props := make([]ballotProposalRow, len(ballot.Proposals))
+	for i, pr := range ballot.Proposals {
+		props[i] = ballotProposalRow{
+			Name:   pr.Name,
+			Weight: pr.Weight.String(),
+			Votes:  uint64(pr.Votes),
+		}
+	}
+
+	// do the upsert
+	_, err := col.UpdateOne(context.Background(),
+		bson.D{{fiBallotPk, ballot.Address.String()}},
+		bson.D{
+			{"$set", bson.D{
+				{fiBallotName, ballot.Name},
+				{fiBallotDetailsUrl, ballot.DetailsUrl},
+				{fiBallotStart, uint64(ballot.Start)},
+				{fiBallotEnd, uint64(ballot.End)},
+				{fiBallotFinalized, ballot.IsFinalized},
+				{fiBallotVotes, uint64(ballot.TotalVotes)},
+				{fiBallotProposals, props},
+				{fiBallotWinner, ballot.Winner},
+			}},
+			{"$setOnInsert", bson.D{{fiBallotTimestamp, uint64(ts)}}},
+		},
+		options.Update().SetUpsert(true))
+	if err != nil {
+		db.log.Errorf("can not store ballot; %s", err.Error())
+		return err
+	}
+
+	db.log.Debugf("ballot %s added", ballot.Address.String())
+	return nil
+}
+
+// IsBallot checks if the given address is a known official ballot.
+func (db *MongoDbBridge) IsBallot(addr *common.Address) (bool, error) {
+	// get the collection for ballots
+	col := db.client.Database(db.dbName).Collection(coBallots)
+
+	// count the ballots with the address
+	count, err := col.CountDocuments(context.Background(), bson.D{{fiBallotPk, addr.String()}})
+	if err != nil {
+		db.log.Errorf("can not check ballot %s; %s", addr.String(), err.Error())
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// Ballot loads the official ballot from the persistent storage; nil if not found.
+func (db *MongoDbBridge) Ballot(addr *common.Address) (*types.Ballot, error) {
+	// get the collection for ballots
+	col := db.client.Database(db.dbName).Collection(coBallots)
+
+	// try to find the ballot
+	res := col.FindOne(context.Background(), bson.D{{fiBallotPk, addr.String()}})
+	if res.Err() != nil {
+		// may be ErrNoDocuments, which we seek
+		if res.Err() == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+
+		db.log.Errorf("can not get ballot %s; %s", addr.String(), res.Err().Error())
+		return nil, res.Err()
+	}
+
+	// decode the row
+	var row ballotRow
+	if err := res.Decode(&row); err != nil {
+		db.log.Errorf("can not decode ballot %s; %s", addr.String(), err.Error())
+		return nil, err
+	}
+
+	return newBallot(&row), nil
+}
+
+// Ballots loads the list of official ballots sorted from the latest
+// voting end. Only ballots open for voting at the given time are loaded if active
+// is requested.
+func (db *MongoDbBridge) Ballots(active bool, now uint64) ([]*types.Ballot, error) {
+	// get the collection for ballots
+	col := db.client.Database(db.dbName).Collection(coBallots)
+
+	// filter active ballots if requested
+	filter := bson.D{}
+	if active {
+		filter = bson.D{
+			{fiBallotStart, bson.D{{"$lte", now}}},
+			{fiBallotEnd, bson.D{{"$gte", now}}},
+		}
+	}
+
+	// load the ballots
+	ctx := context.Background()
+	cursor, err := col.Find(ctx, filter, options.Find().
+		SetSort(bson.D{{fiBallotEnd, -1}}).
+		SetLimit(ballotsMaxList))
+	if err != nil {
+		db.log.Errorf("can not load ballots; %s", err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			db.log.Errorf("error closing ballots cursor; %s", err.Error())
+		}
+	}()
+
+	// decode the ballots
+	list := make([]*types.Ballot, 0)
+	for cursor.Next(ctx) {
+		var row ballotRow
+		if err := cursor.Decode(&row); err != nil {
+			db.log.Errorf("can not decode ballot; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, newBallot(&row))
+	}
+
+	return list, nil
+}
+
+// AddVote stores the vote cast in an official ballot in the persistent storage.
+// Each voter has one vote in a ballot; the latest vote replaces older one.
+func (db *MongoDbBridge) AddVote(vote *types.Vote) error {
+	// do we have the vote?
+	if vote == nil {
+		return fmt.Errorf("can not add empty vote")
+	}
+
+	// get the collection for votes
+	col := db.client.Database(db.dbName).Collection(coVotes)
+
+	// the vote is identified by the ballot and the voter
+	id := fmt.Sprintf("%s-%s", vote.Ballot.String(), vote.Voter.String())
+
+	// do the upsert
+	_, err := col.UpdateOne(context.Background(),
+		bson.D{{fiVotePk, id}},
+		bson.D{{"$set", bson.D{
+			{fiVoteBallot, vote.Ballot.String()},
+			{fiVoteVoter, vote.Voter.String()},
+			{fiVoteProposal, vote.Proposal},
+			{fiVoteTransaction, vote.TransactionHash.String()},
+			{fiVoteTimestamp, uint64(vote.TimeStamp)},
+		}}},
+		options.Update().SetUpsert(true))
+	if err != nil {
+		db.log.Errorf("can not store vote; %s", err.Error())
+		return err
+	}
+
+	db.log.Debugf("vote of %s in ballot %s added", vote.Voter.String(), vote.Ballot.String())
+	return nil
+}
+
+// Votes loads the list of votes cast by the given account
+// sorted from the newest to the oldest.
+func (db *MongoDbBridge) Votes(voter *common.Address) ([]types.Vote, error) {
+	// get the collection for votes
+	col := db.client.Database(db.dbName).Collection(coVotes)
+
+	// load the votes
+	ctx := context.Background()
+	cursor, err := col.Find(ctx,
+		bson.D{{fiVoteVoter, voter.String()}},
+		options.Find().SetSort(bson.D{{fiVoteTimestamp, -1}}).SetLimit(ballotsMaxList))
+	if err != nil {
+		db.log.Errorf("can not load votes of %s; %s", voter.String(), err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			db.log.Errorf("error closing votes cursor; %s", err.Error())
+		}
+	}()
+
+	// decode the votes
+	list := make([]types.Vote, 0)
+	for cursor.Next(ctx) {
+		var row voteRow
+		if err := cursor.Decode(&row); err != nil {
+			db.log.Errorf("can not decode vote; %s", err.Error())
+			return nil, err
+		}
+
+		list = append(list, types.Vote{
+			Ballot:          common.HexToAddress(row.Ballot),
+			Voter:           common.HexToAddress(row.Voter),
+			Proposal:        row.Proposal,
+			TransactionHash: types.HexToHash(row.Transaction),
+			TimeStamp:       hexutil.Uint64(row.TimeStamp),
+		})
+	}
+
+	return list, nil
+}
@@ -0,0 +1,203 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"context"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// coBlocks is the name of the off-chain database collection storing block headers.
+	coBlocks = "block"
+
+	// fiBlockPk is the name of the primary key field of the block collection,
+	// the number of the block.
+	fiBlockPk = "_id"
+
+	// fiBlockTimeStamp is the name of the block time stamp field.
+	// db.block.createIndex({ts:1})
+	fiBlockTimeStamp = "ts"
+)
+
+// blockRow defines a row in the block collection.
+type blockRow struct {
+	Number     uint64   `bson:"_id"`
+	Hash       string   `bson:"hash"`
+	ParentHash string   `bson:"parent"`
+	Miner      string   `bson:"miner"`
+	Difficulty uint64   `bson:"diff"`
+	Size       int32    `bson:"size"`
+	GasLimit   uint64   `bson:"gasLimit"`
+	GasUsed    uint64   `bson:"gasUsed"`
+	TimeStamp  uint64   `bson:"ts"`
+	Epoch      uint64   `bson:"epoch"`
+	TxCount    int32    `bson:"txCount"`
+	Txs        []string `bson:"txs"`
+}
+
+// newBlockRow creates a new block collection row from the block.
+func newBlockRow(blk *types.Block) *blockRow {
+	row := blockRow{
+		Number:     uint64(blk.Number),
+		Hash:       blk.Hash.String(),
+		ParentHash: blk.ParentHash.String(),
+		Miner:      blk.Miner.String(),
+		Difficulty: uint64(blk.Difficulty),
+		Size:       blk.Size,
+		GasLimit:   uint64(blk.GasLimit),
+		GasUsed:    uint64(blk.GasUsed),
+		TimeStamp:  uint64(blk.TimeStamp),
+		Epoch:      uint64(blk.Epoch),
+		TxCount:    int32(len(blk.Txs)),
+		Txs:        make([]string, len(blk.Txs)),
+	}
+
+	// add transactions
+	for i, hash := range blk.Txs {
+		row.Txs[i] = hash.String()
+	}
+
+	return &row
+}
+
+// newBlock creates a block from the block collection row.
+func newBlock(row *blockRow) *types.Block {
+	blk := types.Block{
+		Number:     hexutil.Uint64(row.Number),
+		Hash:       types.HexToHash(row.Hash),
+		ParentHash: types.HexToHash(row.ParentHash),
+		Miner:      common.HexToAddress(row.Miner),
+		Difficulty: hexutil.Uint64(row.Difficulty),
+		Size:       row.Size,
+		GasLimit:   hexutil.Uint64(row.GasLimit),
+		GasUsed:    hexutil.Uint64(row.GasUsed),
+		TimeStamp:  hexutil.Uint64(row.TimeStamp),
+		Epoch:      hexutil.Uint64(row.Epoch),
+		Txs:        make([]*types.Hash, len(row.Txs)),
+	}
+
+	// add transactions
+	for i, tx := range row.Txs {
+		hash := types.HexToHash(tx)
+		blk.Txs[i] = &hash
+	}
+
+	return &blk
+}
+
+// AddBlock stores the block header with the list of its transactions in the persistent storage.
+func (db *MongoDbBridge) AddBlock(blk *types.Block) error {
+	// do we have the block?
+	if blk == nil {
+		return fmt.Errorf("can not add empty block")
+	}
+
+	// get the collection for blocks
+	col := db.client.Database(db.dbName).Collection(coBlocks)
+
+	// do the upsert
+	_, err := col.ReplaceOne(context.Background(),
+		bson.D{{fiBlockPk, uint64(blk.Number)}},
+		newBlockRow(blk),
+		options.Replace().SetUpsert(true))
+	if err != nil {
+		db.log.Errorf("can not store block #%d; %s", uint64(blk.Number), err.Error())
+		return err
+	}
+	return nil
+}
+
+// findBlock loads a single block matching the filter; nil if not found.
+func (db *MongoDbBridge) findBlock(filter bson.D, opt *options.FindOneOptions) (*types.Block, error) {
+	// get the collection for blocks
+	col := db.client.Database(db.dbName).Collection(coBlocks)
+
+	// try to find the block
+	res := col.FindOne(context.Background(), filter, opt)
+	if res.Err() != nil {
+		// may be ErrNoDocuments, which we seek
+		if res.Err() == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+
+		db.log.Errorf("can not get block; %s", res.Err().Error())
+		return nil, res.Err()
+	}
+
+	// decode the row
+	var row blockRow
+	if err := res.Decode(&row); err != nil {
+		db.log.Errorf("can not decode block; %s", err.Error())
+		return nil, err
+	}
+
+	return newBlock(&row), nil
+}
+
+// Block loads the block of the given number from the persistent storage; nil if not found.
+func (db *MongoDbBridge) Block(num hexutil.Uint64) (*types.Block, error) {
+	return db.findBlock(bson.D{{fiBlockPk, uint64(num)}}, options.FindOne())
+}
+
+// BlockByTime loads the latest block created at, or before the given time stamp.
+// If after is set, the first block created at, or after the time stamp is loaded instead.
+// Nil is returned if no such block is stored.
+func (db *MongoDbBridge) BlockByTime(ts hexutil.Uint64, after bool) (*types.Block, error) {
+	// latest block before the time stamp
+	if !after {
+		return db.findBlock(bson.D{{fiBlockTimeStamp, bson.D{{"$lte", uint64(ts)}}}},
+			options.FindOne().SetSort(bson.D{{fiBlockTimeStamp, -1}, {fiBlockPk, -1}}))
+	}
+
+	// first block after the time stamp
+	return db.findBlock(bson.D{{fiBlockTimeStamp, bson.D{{"$gte", uint64(ts)}}}},
+		options.FindOne().SetSort(bson.D{{fiBlockTimeStamp, 1}, {fiBlockPk, 1}}))
+}
+
+// BlocksInRange loads stored blocks with numbers in the given inclusive range, newest first.
+// Blocks not yet stored are simply missing in the result.
+func (db *MongoDbBridge) BlocksInRange(lo uint64, hi uint64) ([]*types.Block, error) {
+	// get the collection and context
+	col := db.client.Database(db.dbName).Collection(coBlocks)
+	ctx := context.Background()
+
+	// load the data
+	ld, err := col.Find(ctx,
+		bson.D{{fiBlockPk, bson.D{{"$gte", lo}, {"$lte", hi}}}},
+		options.Find().SetSort(bson.D{{fiBlockPk, -1}}))
+	if err != nil {
+		db.log.Errorf("error loading blocks range; %s", err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing blocks range cursor; %s", err.Error())
+		}
+	}()
+
+	// loop and load
+	list := make([]*types.Block, 0)
+	for ld.Next(ctx) {
+		var row blockRow
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode the block range row; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, newBlock(&row))
+	}
+
+	return list, nil
+}
+
+// LowestBlock returns the stored block with the lowest number; nil if no block is stored.
+func (db *MongoDbBridge) LowestBlock() (*types.Block, error) {
+	return db.findBlock(bson.D{}, options.FindOne().SetSort(bson.D{{fiBlockPk, 1}}))
+}
@@ -46,11 +46,28 @@ func New(cfg *config.Config, log logger.Logger) (*MongoDbBridge, error) {
 	log.Notice("database backend connection established")
 
 	// make a new Bridge
-	return &MongoDbBridge{
+	db := &MongoDbBridge{
 		client: client,
 		log:    log,
 		dbName: cfg.MongoDatabase,
-	}, nil
+	}
+
+	// make sure the indexes exist and the stored data are updated;
+	// it may take a while on a big database, so we don't wait for it
+	go db.initDatabase()
+	return db, nil
+}
+
+// initDatabase creates the missing indexes and applies the data migrations.
+func (db *MongoDbBridge) initDatabase() {
+	db.initIndexes()
+
+	// update the stored data if needed
+	if err := db.runMigrations(); err != nil {
+		db.log.Errorf("database migrations not finished; %s", err.Error())
+		return
+	}
+	db.log.Notice("database indexes and migrations done")
 }
 
 // Close will terminate or finish all operations and close the connection to Mongo database.
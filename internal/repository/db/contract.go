@@ -11,6 +11,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -25,6 +26,13 @@ const (
 	// db.contract.createIndex({_id:1,orx:-1},{unique:true})
 	fiContractOrdinalIndex = "orx"
 
+	// contractOrdinalCreationBits is the number of bits of the contract ordinal index
+	// used for the index of the contract creation inside the creating transaction.
+	contractOrdinalCreationBits = 12
+
+	// ContractMaxCreationIndex is the highest creation index of a contract inside a transaction.
+	ContractMaxCreationIndex = 1<<contractOrdinalCreationBits - 1
+
 	// fiContractAddress is the name of the address field of the contract.
 	fiContractAddress = "adr"
 
@@ -32,12 +40,23 @@ const (
 	// field of the sender's account.
 	fiContractTransaction = "tx"
 
+	// fiContractFactory is the name of the field of the contract deploying this contract.
+	fiContractFactory = "fac"
+
+	// fiContractCodeHash is the name of the contract runtime code hash field.
+	// db.contract.createIndex({coh:1})
+	fiContractCodeHash = "coh"
+
 	// fiContractTimestamp is the name of the contract time stamp field.
 	fiContractTimestamp = "ts"
 
 	// fiContractName is the name of the contract name field.
 	fiContractName = "name"
 
+	// fiContractNameLower is the name of the lower case contract name field used by the prefix search.
+	// db.contract.createIndex({nlc:1})
+	fiContractNameLower = "nlc"
+
 	// fiContractSupport is the name of the contract support contact field.
 	fiContractSupport = "sup"
 
@@ -47,6 +66,9 @@ const (
 	// fiContractCompiler is the name of the contract compiler id field.
 	fiContractCompiler = "cv"
 
+	// fiContractLanguage is the name of the contract source code language field.
+	fiContractLanguage = "lang"
+
 	// fiContractLicense is the name of the contract open source license field.
 	fiContractLicense = "lic"
 
@@ -65,48 +87,87 @@ const (
 	// fiContractAbi is the name of the contract ABI field.
 	fiContractAbi = "abi"
 
+	// fiContractArgs is the name of the contract raw constructor arguments field.
+	fiContractArgs = "args"
+
+	// fiContractArguments is the name of the contract decoded constructor arguments field.
+	fiContractArguments = "argv"
+
+	// fiContractMatch is the name of the contract validation match quality field.
+	fiContractMatch = "match"
+
 	// fiContractSourceValidated is the name of the contract source code
 	// validation timestamp field.
 	fiContractSourceValidated = "ok"
+
+	// fiContractProxyType is the name of the detected proxy type field.
+	fiContractProxyType = "pxt"
+
+	// fiContractImplementation is the name of the proxy implementation address field.
+	fiContractImplementation = "impl"
+
+	// fiContractProxyChecked is the name of the proxy detection timestamp field.
+	fiContractProxyChecked = "pxc"
+
+	// fiContractUpgradesIndexed is the name of the field signaling
+	// the past upgrade events of the contract are stored.
+	fiContractUpgradesIndexed = "upx"
+
+	// fiContractOrdinalMigrated is the name of the field signaling
+	// the ordinal index of the contract has the creation index room.
+	fiContractOrdinalMigrated = "orxm"
 )
 
 // ContractRow defines a row in the Contract collection.
 type contractRow struct {
-	Id             string  `bson:"_id"`
-	Orx            uint64  `bson:"orx"`
-	Address        string  `bson:"adr"`
-	Transaction    string  `bson:"tx"`
-	TimeStamp      uint64  `bson:"ts"`
-	Name           *string `bson:"name"`
-	Support        *string `bson:"sup"`
-	Version        *string `bson:"ver"`
-	Compiler       *string `bson:"cv"`
-	License        *string `bson:"lic"`
-	IsOptimized    bool    `bson:"isOpt"`
-	OptimizerRuns  uint64  `bson:"runs"`
-	SourceCode     *string `bson:"sol"`
-	SourceCodeHash *string `bson:"soh"`
-	Abi            *string `bson:"abi"`
-	Validated      *uint64 `bson:"ok"`
+	Id             string                `bson:"_id"`
+	Orx            uint64                `bson:"orx"`
+	Address        string                `bson:"adr"`
+	Transaction    string                `bson:"tx"`
+	Factory        *string               `bson:"fac"`
+	CodeHash       *string               `bson:"coh"`
+	TimeStamp      uint64                `bson:"ts"`
+	Name           *string               `bson:"name"`
+	Support        *string               `bson:"sup"`
+	Version        *string               `bson:"ver"`
+	Compiler       *string               `bson:"cv"`
+	Language       *string               `bson:"lang"`
+	License        *string               `bson:"lic"`
+	IsOptimized    bool                  `bson:"isOpt"`
+	OptimizerRuns  uint64                `bson:"runs"`
+	SourceCode     *string               `bson:"sol"`
+	SourceCodeHash *string               `bson:"soh"`
+	Abi            *string               `bson:"abi"`
+	Args           *string               `bson:"args"`
+	Arguments      []contractArgumentRow `bson:"argv"`
+	Match          *string               `bson:"match"`
+	Validated      *uint64               `bson:"ok"`
+	ProxyType      *string               `bson:"pxt"`
+	Implementation *string               `bson:"impl"`
+	ProxyChecked   *uint64               `bson:"pxc"`
+	UpgradesIdx    bool                  `bson:"upx"`
+}
+
+// contractArgumentRow defines a decoded constructor argument
+// stored with the contract.
+type contractArgumentRow struct {
+	Name  string `bson:"name"`
+	Type  string `bson:"type"`
+	Value string `bson:"val"`
 }
 
 // AddContract stores a smart contract reference in connected persistent storage.
-func (db *MongoDbBridge) AddContract(block *types.Block, trx *types.Transaction) error {
+func (db *MongoDbBridge) AddContract(sc *types.Contract) error {
 	// do we have all needed data?
-	if block == nil || trx == nil {
-		return fmt.Errorf("can not add empty contract transaction")
-	}
-
-	// make sure this is indeed a contract transaction
-	if trx.ContractAddress == nil {
-		return fmt.Errorf("not a contract creation transaction")
+	if sc == nil {
+		return fmt.Errorf("can not add empty contract")
 	}
 
 	// get the collection for contracts
 	col := db.client.Database(db.dbName).Collection(coContract)
 
 	// check if the contract already exists
-	exists, err := db.isContractKnown(col, trx.ContractAddress)
+	exists, err := db.isContractKnown(col, &sc.Address)
 	if err != nil {
 		db.log.Critical(err)
 		return err
@@ -119,22 +180,34 @@ func (db *MongoDbBridge) AddContract(block *types.Block, trx *types.Transaction)
 
 	// try to do the insert
 	_, err = col.InsertOne(context.Background(), bson.D{
-		{fiContractPk, trx.ContractAddress.String()},
-		{fiContractOrdinalIndex, db.TransactionIndex(block, trx)},
-		{fiContractAddress, trx.ContractAddress.String()},
-		{fiContractTransaction, trx.Hash.String()},
-		{fiContractTimestamp, uint64(block.TimeStamp)},
+		{fiContractPk, sc.Address.String()},
+		{fiContractOrdinalIndex, sc.OrdinalIndex},
+		{fiContractAddress, sc.Address.String()},
+		{fiContractTransaction, sc.TransactionHash.String()},
+		{fiContractFactory, optionalAddress(sc.Factory)},
+		{fiContractCodeHash, optionalHash(sc.CodeHash)},
+		{fiContractTimestamp, uint64(sc.TimeStamp)},
 		{fiContractName, nil},
+		{fiContractNameLower, nil},
 		{fiContractSupport, nil},
 		{fiContractVersion, nil},
 		{fiContractCompiler, nil},
+		{fiContractLanguage, nil},
 		{fiContractSource, nil},
 		{fiContractSourceHash, nil},
 		{fiContractLicense, nil},
 		{fiContractIsOptimized, true},
 		{fiContractOptimizationRuns, 200},
 		{fiContractAbi, nil},
+		{fiContractArgs, nil},
+		{fiContractArguments, nil},
+		{fiContractMatch, nil},
 		{fiContractSourceValidated, nil},
+		{fiContractProxyType, nil},
+		{fiContractImplementation, nil},
+		{fiContractProxyChecked, nil},
+		{fiContractUpgradesIndexed, false},
+		{fiContractOrdinalMigrated, true},
 	})
 	if err != nil {
 		db.log.Critical(err)
@@ -142,10 +215,26 @@ func (db *MongoDbBridge) AddContract(block *types.Block, trx *types.Transaction)
 	}
 
 	// inform and quit
-	db.log.Debugf("added smart contract reference [%s]", trx.ContractAddress.String())
+	db.log.Debugf("added smart contract reference [%s]", sc.Address.String())
 	return nil
 }
 
+// optionalAddress returns the string representation of the address, or nil.
+func optionalAddress(addr *common.Address) interface{} {
+	if addr == nil {
+		return nil
+	}
+	return addr.String()
+}
+
+// optionalHash returns the string representation of the hash, or nil.
+func optionalHash(hash *types.Hash) interface{} {
+	if hash == nil {
+		return nil
+	}
+	return hash.String()
+}
+
 // UpdateContract updates smart contract information in database to reflect
 // new validation or similar changes passed from repository.
 func (db *MongoDbBridge) UpdateContract(sc *types.Contract) error {
@@ -174,21 +263,7 @@ func (db *MongoDbBridge) UpdateContract(sc *types.Contract) error {
 	// update the contract details
 	_, err = col.UpdateOne(context.Background(),
 		bson.D{{fiContractPk, sc.Address.String()}},
-		bson.D{
-			{"$set", bson.D{
-				{fiContractName, sc.Name},
-				{fiContractSupport, sc.SupportContact},
-				{fiContractVersion, sc.Version},
-				{fiContractCompiler, sc.Compiler},
-				{fiContractLicense, sc.License},
-				{fiContractIsOptimized, sc.IsOptimized},
-				{fiContractOptimizationRuns, sc.OptimizeRuns},
-				{fiContractSource, sc.SourceCode},
-				{fiContractSourceHash, sc.SourceCodeHash.String()},
-				{fiContractAbi, sc.Abi},
-				{fiContractSourceValidated, uint64(*sc.Validated)},
-			}},
-		})
+		bson.D{{"$set", contractValidationSet(sc)}})
 
 	// error on update?
 	if err != nil {
@@ -199,6 +274,149 @@ func (db *MongoDbBridge) UpdateContract(sc *types.Contract) error {
 	return nil
 }
 
+// UpdateContractProxy stores the result of the proxy detection of the given contract.
+// The proxy is nil for contracts not recognized as a proxy.
+func (db *MongoDbBridge) UpdateContractProxy(addr *common.Address, px *types.ContractProxy) error {
+	// get the collection for contracts
+	col := db.client.Database(db.dbName).Collection(coContract)
+
+	// prep the proxy details
+	var pxt, impl interface{}
+	if px != nil {
+		pxt = px.Type
+		impl = px.Implementation.String()
+	}
+
+	// update the contract
+	_, err := col.UpdateOne(context.Background(),
+		bson.D{{fiContractPk, addr.String()}},
+		bson.D{{"$set", bson.D{
+			{fiContractProxyType, pxt},
+			{fiContractImplementation, impl},
+			{fiContractProxyChecked, uint64(time.Now().Unix())},
+		}}})
+	if err != nil {
+		db.log.Errorf("can not update proxy of contract %s; %s", addr.String(), err.Error())
+		return err
+	}
+	return nil
+}
+
+// SetContractUpgradesIndexed marks the past upgrade events of the given contract as stored.
+func (db *MongoDbBridge) SetContractUpgradesIndexed(addr *common.Address) error {
+	// get the collection for contracts
+	col := db.client.Database(db.dbName).Collection(coContract)
+
+	// update the contract
+	_, err := col.UpdateOne(context.Background(),
+		bson.D{{fiContractPk, addr.String()}},
+		bson.D{{"$set", bson.D{{fiContractUpgradesIndexed, true}}}})
+	if err != nil {
+		db.log.Errorf("can not mark upgrades of contract %s; %s", addr.String(), err.Error())
+		return err
+	}
+	return nil
+}
+
+// contractValidationSet builds the set of contract fields
+// updated by the contract validation.
+func contractValidationSet(sc *types.Contract) bson.D {
+	return bson.D{
+		{fiContractName, sc.Name},
+		{fiContractNameLower, strings.ToLower(sc.Name)},
+		{fiContractSupport, sc.SupportContact},
+		{fiContractVersion, sc.Version},
+		{fiContractCompiler, sc.Compiler},
+		{fiContractLanguage, sc.Language},
+		{fiContractLicense, sc.License},
+		{fiContractIsOptimized, sc.IsOptimized},
+		{fiContractOptimizationRuns, sc.OptimizeRuns},
+		{fiContractSource, sc.SourceCode},
+		{fiContractSourceHash, sc.SourceCodeHash.String()},
+		{fiContractAbi, sc.Abi},
+		{fiContractArgs, sc.ConstructorArgs.String()},
+		{fiContractArguments, contractArgumentRows(sc.ConstructorArguments)},
+		{fiContractMatch, sc.Match},
+		{fiContractCodeHash, optionalHash(sc.CodeHash)},
+		{fiContractSourceValidated, uint64(*sc.Validated)},
+	}
+}
+
+// ValidatedContractByCode returns a validated smart contract with the given
+// runtime code hash, if any. Nil is returned if no such contract exists.
+func (db *MongoDbBridge) ValidatedContractByCode(hash *types.Hash) (*types.Contract, error) {
+	// get the collection for contracts
+	col := db.client.Database(db.dbName).Collection(coContract)
+
+	// try to find a validated contract with the same code
+	sr := col.FindOne(context.Background(), bson.D{
+		{fiContractCodeHash, hash.String()},
+		{fiContractSourceValidated, bson.D{{"$ne", nil}}},
+	})
+
+	// error on lookup?
+	if sr.Err() != nil {
+		// may be ErrNoDocuments, which we seek
+		if sr.Err() == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+
+		db.log.Errorf("can not find validated contract by code; %s", sr.Err().Error())
+		return nil, sr.Err()
+	}
+
+	// try to decode
+	var row contractRow
+	if err := sr.Decode(&row); err != nil {
+		db.log.Errorf("can not decode validated contract; %s", err.Error())
+		return nil, err
+	}
+
+	return newContract(&row), nil
+}
+
+// ValidateSimilarContracts copies the validation details of the given contract
+// to all not yet validated contracts with the same runtime code hash.
+// Constructor arguments are not copied since they differ per deployment.
+func (db *MongoDbBridge) ValidateSimilarContracts(sc *types.Contract) (int64, error) {
+	// we need the code hash and the validation time stamp
+	if sc.CodeHash == nil || sc.Validated == nil {
+		return 0, nil
+	}
+
+	// get the collection for contracts
+	col := db.client.Database(db.dbName).Collection(coContract)
+
+	// prep the validation set without the deployment specific arguments
+	src := *sc
+	src.ConstructorArgs = nil
+	src.ConstructorArguments = nil
+
+	// update all the unvalidated contracts with the same code
+	res, err := col.UpdateMany(context.Background(),
+		bson.D{
+			{fiContractCodeHash, sc.CodeHash.String()},
+			{fiContractSourceValidated, nil},
+		},
+		bson.D{{"$set", contractValidationSet(&src)}})
+	if err != nil {
+		db.log.Errorf("can not validate similar contracts; %s", err.Error())
+		return 0, err
+	}
+
+	return res.ModifiedCount, nil
+}
+
+// contractArgumentRows converts decoded constructor arguments
+// into the database representation.
+func contractArgumentRows(args []types.ContractArgument) []contractArgumentRow {
+	list := make([]contractArgumentRow, len(args))
+	for i, arg := range args {
+		list[i] = contractArgumentRow{Name: arg.Name, Type: arg.Type, Value: arg.Value}
+	}
+	return list
+}
+
 // isContractKnown checks if a smart contract document already exists in the database.
 func (db *MongoDbBridge) isContractKnown(col *mongo.Collection, addr *common.Address) (bool, error) {
 	// try to find the contract in the database (it may already exist)
@@ -253,6 +471,18 @@ func newContract(row *contractRow) *types.Contract {
 		OptimizeRuns:    int32(row.OptimizerRuns),
 	}
 
+	// do we have the factory contract?
+	if row.Factory != nil {
+		fac := common.HexToAddress(*row.Factory)
+		con.Factory = &fac
+	}
+
+	// do we have the code hash?
+	if row.CodeHash != nil {
+		hash := types.HexToHash(*row.CodeHash)
+		con.CodeHash = &hash
+	}
+
 	// do we have the source code?
 	if row.SourceCode != nil {
 		con.SourceCode = *row.SourceCode
@@ -294,12 +524,47 @@ func newContract(row *contractRow) *types.Contract {
 		con.Compiler = *row.Compiler
 	}
 
+	// do we have the contract language?
+	if row.Language != nil {
+		con.Language = *row.Language
+	}
+
+	// do we have the constructor arguments?
+	if row.Args != nil {
+		con.ConstructorArgs = common.FromHex(*row.Args)
+	}
+
+	// do we have the decoded constructor arguments?
+	if row.Arguments != nil {
+		con.ConstructorArguments = make([]types.ContractArgument, len(row.Arguments))
+		for i, arg := range row.Arguments {
+			con.ConstructorArguments[i] = types.ContractArgument{Name: arg.Name, Type: arg.Type, Value: arg.Value}
+		}
+	}
+
+	// do we have the validation match quality?
+	if row.Match != nil {
+		con.Match = *row.Match
+	}
+
 	// do we have the validation time stamp?
 	if row.Validated != nil {
 		val := *row.Validated
 		con.Validated = (*hexutil.Uint64)(&val)
 	}
 
+	// do we have the proxy detected?
+	if row.ProxyType != nil && row.Implementation != nil {
+		con.Proxy = &types.ContractProxy{Type: *row.ProxyType, Implementation: common.HexToAddress(*row.Implementation)}
+	}
+
+	// do we have the proxy detection time stamp?
+	if row.ProxyChecked != nil {
+		val := *row.ProxyChecked
+		con.ProxyChecked = (*hexutil.Uint64)(&val)
+	}
+
+	con.UpgradesIndexed = row.UpgradesIdx
 	return &con
 }
 
@@ -337,6 +602,50 @@ func (db *MongoDbBridge) Contract(addr *common.Address) (*types.Contract, error)
 	return newContract(&row), nil
 }
 
+// ContractsAfter loads a batch of contracts with the ordinal index above the given one
+// sorted by the ordinal index. Only the creation details of the contracts are loaded.
+func (db *MongoDbBridge) ContractsAfter(orx uint64, count int64) ([]*types.Contract, error) {
+	// get the collection for contracts
+	col := db.client.Database(db.dbName).Collection(coContract)
+	ctx := context.Background()
+
+	// load the batch
+	ld, err := col.Find(ctx, bson.D{{fiContractOrdinalIndex, bson.D{{"$gt", orx}}}}, options.Find().
+		SetSort(bson.D{{fiContractOrdinalIndex, 1}}).
+		SetLimit(count).
+		SetProjection(bson.D{
+			{fiContractOrdinalIndex, true},
+			{fiContractAddress, true},
+			{fiContractTransaction, true},
+			{fiContractFactory, true},
+			{fiContractTimestamp, true},
+		}))
+	if err != nil {
+		db.log.Errorf("can not load contracts after %d; %s", orx, err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing contracts cursor; %s", err.Error())
+		}
+	}()
+
+	// decode the contracts
+	list := make([]*types.Contract, 0)
+	for ld.Next(ctx) {
+		var row contractRow
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode contract; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, newContract(&row))
+	}
+
+	return list, nil
+}
+
 // contractListTotal find the total amount of contracts for the criteria and populates the list
 func (db *MongoDbBridge) contractListTotal(col *mongo.Collection, validatedOnly bool, list *types.ContractList) error {
 	// prep the empty filter
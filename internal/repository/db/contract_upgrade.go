@@ -0,0 +1,118 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"context"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// coContractUpgrades is the name of the off-chain database collection
+	// storing implementation upgrades of proxy contracts.
+	coContractUpgrades = "contract_upgrade"
+
+	// fiContractUpgradePk is the name of the primary key field of the upgrades collection.
+	fiContractUpgradePk = "_id"
+
+	// fiContractUpgradeProxy is the name of the proxy contract address field.
+	// db.contract_upgrade.createIndex({proxy:1,blk:1,lix:1})
+	fiContractUpgradeProxy = "proxy"
+
+	// fiContractUpgradeBlock is the name of the upgrade block number field.
+	fiContractUpgradeBlock = "blk"
+
+	// fiContractUpgradeLogIndex is the name of the upgrade event log index field.
+	fiContractUpgradeLogIndex = "lix"
+)
+
+// contractUpgradeRow defines a row in the contract upgrades collection.
+type contractUpgradeRow struct {
+	Id             string `bson:"_id"`
+	Proxy          string `bson:"proxy"`
+	Implementation string `bson:"impl"`
+	Block          uint64 `bson:"blk"`
+	Transaction    string `bson:"tx"`
+	LogIndex       uint64 `bson:"lix"`
+}
+
+// AddContractUpgrade stores the implementation upgrade of a proxy contract.
+// Existing upgrades are replaced so re-scanning the chain is safe.
+func (db *MongoDbBridge) AddContractUpgrade(up *types.ContractUpgrade) error {
+	// do we have the upgrade?
+	if up == nil {
+		return fmt.Errorf("can not add empty contract upgrade")
+	}
+
+	// get the collection for upgrades
+	col := db.client.Database(db.dbName).Collection(coContractUpgrades)
+
+	// the upgrade is identified by the transaction and the event log index
+	id := fmt.Sprintf("%s-%d", up.TransactionHash.String(), uint64(up.LogIndex))
+
+	// do the upsert
+	_, err := col.ReplaceOne(context.Background(),
+		bson.D{{fiContractUpgradePk, id}},
+		contractUpgradeRow{
+			Id:             id,
+			Proxy:          up.Proxy.String(),
+			Implementation: up.Implementation.String(),
+			Block:          uint64(up.BlockNumber),
+			Transaction:    up.TransactionHash.String(),
+			LogIndex:       uint64(up.LogIndex),
+		},
+		options.Replace().SetUpsert(true))
+	if err != nil {
+		db.log.Errorf("can not store upgrade of contract %s; %s", up.Proxy.String(), err.Error())
+		return err
+	}
+
+	db.log.Debugf("upgrade of contract %s to %s added", up.Proxy.String(), up.Implementation.String())
+	return nil
+}
+
+// ContractUpgrades loads the list of implementation upgrades of the given proxy
+// contract sorted from the oldest to the newest.
+func (db *MongoDbBridge) ContractUpgrades(addr *common.Address) ([]types.ContractUpgrade, error) {
+	// get the collection for upgrades
+	col := db.client.Database(db.dbName).Collection(coContractUpgrades)
+	ctx := context.Background()
+
+	// load the upgrades
+	ld, err := col.Find(ctx, bson.D{{fiContractUpgradeProxy, addr.String()}}, options.Find().
+		SetSort(bson.D{{fiContractUpgradeBlock, 1}, {fiContractUpgradeLogIndex, 1}}))
+	if err != nil {
+		db.log.Errorf("can not load upgrades of contract %s; %s", addr.String(), err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing contract upgrades cursor; %s", err.Error())
+		}
+	}()
+
+	// decode the list
+	list := make([]types.ContractUpgrade, 0)
+	for ld.Next(ctx) {
+		var row contractUpgradeRow
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode contract upgrade; %s", err.Error())
+			return nil, err
+		}
+
+		list = append(list, types.ContractUpgrade{
+			Proxy:           common.HexToAddress(row.Proxy),
+			Implementation:  common.HexToAddress(row.Implementation),
+			BlockNumber:     hexutil.Uint64(row.Block),
+			TransactionHash: types.HexToHash(row.Transaction),
+			LogIndex:        hexutil.Uint64(row.LogIndex),
+		})
+	}
+	return list, ld.Err()
+}
@@ -0,0 +1,135 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"context"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// coFMintRewardClaims is the name of the off-chain database collection
+	// storing fMint reward claims.
+	coFMintRewardClaims = "fmint_claim"
+
+	// fiFMintClaimPk is the name of the primary key field of the reward claim collection.
+	fiFMintClaimPk = "_id"
+
+	// fiFMintClaimAccount is the name of the claiming account field.
+	// db.fmint_claim.createIndex({acc:1,ts:-1})
+	fiFMintClaimAccount = "acc"
+
+	// fiFMintClaimAmount is the name of the claimed amount field.
+	fiFMintClaimAmount = "amo"
+
+	// fiFMintClaimTransaction is the name of the claim transaction hash field.
+	fiFMintClaimTransaction = "tx"
+
+	// fiFMintClaimLogIndex is the name of the claim event log index field.
+	fiFMintClaimLogIndex = "lix"
+
+	// fiFMintClaimTimestamp is the name of the claim time stamp field.
+	fiFMintClaimTimestamp = "ts"
+
+	// fMintClaimsMaxList is the max number of reward claims returned for an account.
+	fMintClaimsMaxList = 100
+)
+
+// fMintClaimRow defines a row in the fMint reward claims collection.
+type fMintClaimRow struct {
+	Id          string `bson:"_id"`
+	Account     string `bson:"acc"`
+	Amount      string `bson:"amo"`
+	Transaction string `bson:"tx"`
+	LogIndex    uint64 `bson:"lix"`
+	TimeStamp   uint64 `bson:"ts"`
+}
+
+// AddFMintRewardClaim stores the fMint reward claim in the persistent storage.
+// Existing claims are replaced so re-scanning the chain is safe.
+func (db *MongoDbBridge) AddFMintRewardClaim(rc *types.FMintRewardClaim) error {
+	// do we have the claim?
+	if rc == nil {
+		return fmt.Errorf("can not add empty reward claim")
+	}
+
+	// get the collection for claims
+	col := db.client.Database(db.dbName).Collection(coFMintRewardClaims)
+
+	// the claim is identified by the transaction and the event log index
+	id := fmt.Sprintf("%s-%d", rc.TransactionHash.String(), uint64(rc.LogIndex))
+
+	// do the upsert
+	_, err := col.UpdateOne(context.Background(),
+		bson.D{{fiFMintClaimPk, id}},
+		bson.D{{"$set", bson.D{
+			{fiFMintClaimAccount, rc.Account.String()},
+			{fiFMintClaimAmount, rc.Amount.String()},
+			{fiFMintClaimTransaction, rc.TransactionHash.String()},
+			{fiFMintClaimLogIndex, uint64(rc.LogIndex)},
+			{fiFMintClaimTimestamp, uint64(rc.TimeStamp)},
+		}}},
+		options.Update().SetUpsert(true))
+	if err != nil {
+		db.log.Errorf("can not store reward claim; %s", err.Error())
+		return err
+	}
+
+	db.log.Debugf("fMint reward claim of %s added", rc.Account.String())
+	return nil
+}
+
+// FMintRewardClaims loads the list of fMint reward claims of the given account
+// sorted from the newest to the oldest.
+func (db *MongoDbBridge) FMintRewardClaims(addr *common.Address) ([]types.FMintRewardClaim, error) {
+	// get the collection for claims
+	col := db.client.Database(db.dbName).Collection(coFMintRewardClaims)
+
+	// load the claims
+	ctx := context.Background()
+	cursor, err := col.Find(ctx,
+		bson.D{{fiFMintClaimAccount, addr.String()}},
+		options.Find().SetSort(bson.D{{fiFMintClaimTimestamp, -1}}).SetLimit(fMintClaimsMaxList))
+	if err != nil {
+		db.log.Errorf("can not load reward claims of %s; %s", addr.String(), err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			db.log.Errorf("error closing reward claims cursor; %s", err.Error())
+		}
+	}()
+
+	// decode the claims
+	list := make([]types.FMintRewardClaim, 0)
+	for cursor.Next(ctx) {
+		var row fMintClaimRow
+		if err := cursor.Decode(&row); err != nil {
+			db.log.Errorf("can not decode reward claim; %s", err.Error())
+			return nil, err
+		}
+
+		// decode the amount
+		amount, err := hexutil.DecodeBig(row.Amount)
+		if err != nil {
+			db.log.Errorf("invalid reward claim amount %s; %s", row.Amount, err.Error())
+			return nil, err
+		}
+
+		list = append(list, types.FMintRewardClaim{
+			Account:         common.HexToAddress(row.Account),
+			Amount:          hexutil.Big(*amount),
+			TransactionHash: types.HexToHash(row.Transaction),
+			LogIndex:        hexutil.Uint64(row.LogIndex),
+			TimeStamp:       hexutil.Uint64(row.TimeStamp),
+		})
+	}
+
+	return list, nil
+}
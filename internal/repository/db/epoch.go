@@ -0,0 +1,405 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"context"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"math/big"
+	"strconv"
+)
+
+const (
+	// coEpochs is the name of the off-chain database collection storing sealed epochs.
+	coEpochs = "epoch"
+
+	// fiEpochPk is the name of the primary key field of the epoch collection,
+	// the id of the epoch.
+	fiEpochPk = "_id"
+
+	// fiEpochEndTime is the name of the epoch end time stamp field.
+	// db.epoch.createIndex({end:-1})
+	fiEpochEndTime = "end"
+)
+
+// epochValidatorRow defines a validator record of a row in the epoch collection.
+type epochValidatorRow struct {
+	StakerId          uint64  `bson:"sid"`
+	StakeAmount       string  `bson:"stake"`
+	DelegatedMe       string  `bson:"dlg"`
+	BaseRewardWeight  string  `bson:"brw"`
+	TxRewardWeight    string  `bson:"trw"`
+	Reward            string  `bson:"rew"`
+	MissedBlocks      *uint64 `bson:"mis,omitempty"`
+	Downtime          *uint64 `bson:"dwn,omitempty"`
+	MissedBlocksTotal *uint64 `bson:"mist,omitempty"`
+	DowntimeTotal     *uint64 `bson:"dwnt,omitempty"`
+}
+
+// epochRow defines a row in the epoch collection.
+type epochRow struct {
+	Id                     uint64              `bson:"_id"`
+	EndTime                uint64              `bson:"end"`
+	Duration               string              `bson:"dur"`
+	EpochFee               string              `bson:"fee"`
+	TotalBaseRewardWeight  string              `bson:"brw"`
+	TotalTxRewardWeight    string              `bson:"trw"`
+	BaseRewardPerSecond    string              `bson:"brps"`
+	StakeTotalAmount       string              `bson:"stake"`
+	DelegationsTotalAmount string              `bson:"dlg"`
+	TotalSupply            string              `bson:"supply"`
+	Validators             []epochValidatorRow `bson:"val"`
+}
+
+// optionalUint64 converts an optional numeric value into the database form.
+func optionalUint64(val *hexutil.Uint64) *uint64 {
+	if val == nil {
+		return nil
+	}
+	v := uint64(*val)
+	return &v
+}
+
+// newEpochRow creates a new epoch collection row from the epoch and its validators.
+func newEpochRow(ep *types.Epoch, vals []types.EpochValidator) *epochRow {
+	row := epochRow{
+		Id:                     uint64(ep.Id),
+		EndTime:                ep.EndTime.ToInt().Uint64(),
+		Duration:               ep.Duration.String(),
+		EpochFee:               ep.EpochFee.String(),
+		TotalBaseRewardWeight:  ep.TotalBaseRewardWeight.String(),
+		TotalTxRewardWeight:    ep.TotalTxRewardWeight.String(),
+		BaseRewardPerSecond:    ep.BaseRewardPerSecond.String(),
+		StakeTotalAmount:       ep.StakeTotalAmount.String(),
+		DelegationsTotalAmount: ep.DelegationsTotalAmount.String(),
+		TotalSupply:            ep.TotalSupply.String(),
+		Validators:             make([]epochValidatorRow, len(vals)),
+	}
+
+	// add validators
+	for i, val := range vals {
+		row.Validators[i] = epochValidatorRow{
+			StakerId:          uint64(val.StakerId),
+			StakeAmount:       val.StakeAmount.String(),
+			DelegatedMe:       val.DelegatedMe.String(),
+			BaseRewardWeight:  val.BaseRewardWeight.String(),
+			TxRewardWeight:    val.TxRewardWeight.String(),
+			Reward:            val.Reward.String(),
+			MissedBlocks:      optionalUint64(val.MissedBlocks),
+			Downtime:          optionalUint64(val.Downtime),
+			MissedBlocksTotal: optionalUint64(val.MissedBlocksTotal),
+			DowntimeTotal:     optionalUint64(val.DowntimeTotal),
+		}
+	}
+
+	return &row
+}
+
+// newEpoch creates an epoch from the epoch collection row.
+func newEpoch(row *epochRow) *types.Epoch {
+	return &types.Epoch{
+		Id:                     hexutil.Uint64(row.Id),
+		EndTime:                (hexutil.Big)(*new(big.Int).SetUint64(row.EndTime)),
+		Duration:               (hexutil.Big)(*hexutil.MustDecodeBig(row.Duration)),
+		EpochFee:               (hexutil.Big)(*hexutil.MustDecodeBig(row.EpochFee)),
+		TotalBaseRewardWeight:  (hexutil.Big)(*hexutil.MustDecodeBig(row.TotalBaseRewardWeight)),
+		TotalTxRewardWeight:    (hexutil.Big)(*hexutil.MustDecodeBig(row.TotalTxRewardWeight)),
+		BaseRewardPerSecond:    (hexutil.Big)(*hexutil.MustDecodeBig(row.BaseRewardPerSecond)),
+		StakeTotalAmount:       (hexutil.Big)(*hexutil.MustDecodeBig(row.StakeTotalAmount)),
+		DelegationsTotalAmount: (hexutil.Big)(*hexutil.MustDecodeBig(row.DelegationsTotalAmount)),
+		TotalSupply:            (hexutil.Big)(*hexutil.MustDecodeBig(row.TotalSupply)),
+	}
+}
+
+// newEpochValidator creates an epoch validator from the epoch collection row.
+func newEpochValidator(epoch uint64, row *epochValidatorRow) types.EpochValidator {
+	return types.EpochValidator{
+		Epoch:             hexutil.Uint64(epoch),
+		StakerId:          hexutil.Uint64(row.StakerId),
+		StakeAmount:       (hexutil.Big)(*hexutil.MustDecodeBig(row.StakeAmount)),
+		DelegatedMe:       (hexutil.Big)(*hexutil.MustDecodeBig(row.DelegatedMe)),
+		BaseRewardWeight:  (hexutil.Big)(*hexutil.MustDecodeBig(row.BaseRewardWeight)),
+		TxRewardWeight:    (hexutil.Big)(*hexutil.MustDecodeBig(row.TxRewardWeight)),
+		Reward:            (hexutil.Big)(*hexutil.MustDecodeBig(row.Reward)),
+		MissedBlocks:      (*hexutil.Uint64)(row.MissedBlocks),
+		Downtime:          (*hexutil.Uint64)(row.Downtime),
+		MissedBlocksTotal: (*hexutil.Uint64)(row.MissedBlocksTotal),
+		DowntimeTotal:     (*hexutil.Uint64)(row.DowntimeTotal),
+	}
+}
+
+// AddEpoch stores the sealed epoch with the list of its validators in the persistent storage.
+func (db *MongoDbBridge) AddEpoch(ep *types.Epoch, vals []types.EpochValidator) error {
+	// do we have the epoch?
+	if ep == nil {
+		return fmt.Errorf("can not add empty epoch")
+	}
+
+	// get the collection for epochs
+	col := db.client.Database(db.dbName).Collection(coEpochs)
+
+	// do the upsert
+	_, err := col.ReplaceOne(context.Background(),
+		bson.D{{fiEpochPk, uint64(ep.Id)}},
+		newEpochRow(ep, vals),
+		options.Replace().SetUpsert(true))
+	if err != nil {
+		db.log.Errorf("can not store epoch #%d; %s", uint64(ep.Id), err.Error())
+		return err
+	}
+
+	db.log.Debugf("epoch #%d added", uint64(ep.Id))
+	return nil
+}
+
+// LastKnownEpoch returns the id of the latest epoch stored in the persistent storage.
+func (db *MongoDbBridge) LastKnownEpoch() (uint64, error) {
+	return db.epochBoundary(-1)
+}
+
+// FirstKnownEpoch returns the id of the lowest epoch stored in the persistent storage.
+func (db *MongoDbBridge) FirstKnownEpoch() (uint64, error) {
+	return db.epochBoundary(1)
+}
+
+// epochBoundary returns the id of the boundary epoch stored in the persistent storage
+// using the given sort direction; zero if no epoch is stored at all.
+func (db *MongoDbBridge) epochBoundary(dir int) (uint64, error) {
+	// get the collection for epochs
+	col := db.client.Database(db.dbName).Collection(coEpochs)
+
+	// find the boundary epoch
+	res := col.FindOne(context.Background(), bson.D{}, options.FindOne().
+		SetSort(bson.D{{fiEpochPk, dir}}).
+		SetProjection(bson.D{{fiEpochPk, true}}))
+	if res.Err() != nil {
+		// may be no epoch at all
+		if res.Err() == mongo.ErrNoDocuments {
+			db.log.Info("no epochs found in database")
+			return 0, nil
+		}
+
+		db.log.Errorf("can not get the boundary epoch; %s", res.Err().Error())
+		return 0, res.Err()
+	}
+
+	// get the actual value
+	var row struct {
+		Id uint64 `bson:"_id"`
+	}
+	if err := res.Decode(&row); err != nil {
+		db.log.Errorf("can not decode the boundary epoch; %s", err.Error())
+		return 0, err
+	}
+
+	return row.Id, nil
+}
+
+// epochRowById loads the row of the epoch of the given id; nil if not found.
+func (db *MongoDbBridge) epochRowById(id uint64) (*epochRow, error) {
+	// get the collection for epochs
+	col := db.client.Database(db.dbName).Collection(coEpochs)
+
+	// try to find the epoch
+	res := col.FindOne(context.Background(), bson.D{{fiEpochPk, id}})
+	if res.Err() != nil {
+		// may be ErrNoDocuments, which we seek
+		if res.Err() == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+
+		db.log.Errorf("can not get epoch #%d; %s", id, res.Err().Error())
+		return nil, res.Err()
+	}
+
+	// decode the row
+	var row epochRow
+	if err := res.Decode(&row); err != nil {
+		db.log.Errorf("can not decode epoch #%d; %s", id, err.Error())
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+// Epoch loads the sealed epoch from the persistent storage; nil if not found.
+func (db *MongoDbBridge) Epoch(id hexutil.Uint64) (*types.Epoch, error) {
+	row, err := db.epochRowById(uint64(id))
+	if err != nil || row == nil {
+		return nil, err
+	}
+	return newEpoch(row), nil
+}
+
+// EpochValidators loads the list of validators of the sealed epoch; nil if the epoch is not known.
+func (db *MongoDbBridge) EpochValidators(id hexutil.Uint64) ([]types.EpochValidator, error) {
+	row, err := db.epochRowById(uint64(id))
+	if err != nil || row == nil {
+		return nil, err
+	}
+
+	// decode validators
+	list := make([]types.EpochValidator, len(row.Validators))
+	for i := range row.Validators {
+		list[i] = newEpochValidator(row.Id, &row.Validators[i])
+	}
+
+	return list, nil
+}
+
+// epochListInit initializes the list of epochs based on provided cursor and count.
+func (db *MongoDbBridge) epochListInit(col *mongo.Collection, cursor *string, count int32) (*types.EpochList, error) {
+	// make the list
+	list := types.EpochList{Collection: make([]*types.Epoch, 0)}
+
+	// find how many epochs do we have in the database
+	total, err := col.CountDocuments(context.Background(), bson.D{})
+	if err != nil {
+		db.log.Errorf("can not count epochs; %s", err.Error())
+		return nil, err
+	}
+	list.Total = uint64(total)
+
+	// the cursor is the id of the epoch
+	if cursor != nil {
+		list.First, err = strconv.ParseUint(*cursor, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor value; %s", err.Error())
+		}
+		return &list, nil
+	}
+
+	// no cursor; start at the top, or at the bottom of the collection
+	if count > 0 {
+		list.First, err = db.LastKnownEpoch()
+		list.IsStart = true
+	} else {
+		list.First = 0
+		list.IsEnd = true
+	}
+
+	return &list, err
+}
+
+// epochListFilter creates a filter for epoch list search.
+func epochListFilter(cursor *string, count int32, list *types.EpochList) bson.D {
+	// prep base operator
+	op := "$lte"
+
+	// no cursor and bottom up list
+	if cursor == nil && count < 0 {
+		op = "$gte"
+	}
+
+	// we have the cursor and we scan from top
+	if cursor != nil && count > 0 {
+		op = "$lt"
+	}
+
+	// we have the cursor and we scan from bottom
+	if cursor != nil && count < 0 {
+		op = "$gt"
+	}
+
+	return bson.D{{fiEpochPk, bson.D{{op, list.First}}}}
+}
+
+// epochListOptions creates a filter options set for epoch list search.
+func epochListOptions(count int32) *options.FindOptions {
+	// prep options
+	opt := options.Find()
+
+	// from high (new) to low (old) for positive count
+	if count > 0 {
+		opt.SetSort(bson.D{{fiEpochPk, -1}})
+	} else {
+		opt.SetSort(bson.D{{fiEpochPk, 1}})
+	}
+
+	// try to get one more to check the boundary
+	limit := int64(count)
+	if limit < 0 {
+		limit = -limit
+	}
+	opt.SetLimit(limit + 1)
+	return opt
+}
+
+// Epochs provides list of sealed epochs stored in the persistent storage.
+func (db *MongoDbBridge) Epochs(cursor *string, count int32) (*types.EpochList, error) {
+	// nothing to load?
+	if count == 0 {
+		return nil, fmt.Errorf("nothing to do, zero epochs requested")
+	}
+
+	// get the collection and context
+	col := db.client.Database(db.dbName).Collection(coEpochs)
+	ctx := context.Background()
+
+	// init the list
+	list, err := db.epochListInit(col, cursor, count)
+	if err != nil {
+		db.log.Errorf("can not build epoch list; %s", err.Error())
+		return nil, err
+	}
+
+	// load the data
+	ld, err := col.Find(ctx, epochListFilter(cursor, count, list), epochListOptions(count))
+	if err != nil {
+		db.log.Errorf("error loading epoch list; %s", err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing epoch list cursor; %s", err.Error())
+		}
+	}()
+
+	// the requested size of the list
+	size := int(count)
+	if size < 0 {
+		size = -size
+	}
+
+	// loop and load
+	hasMore := false
+	for ld.Next(ctx) {
+		// do we already have all we need?
+		if len(list.Collection) == size {
+			hasMore = true
+			break
+		}
+
+		// try to decode the next row
+		var row epochRow
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode the epoch list row; %s", err.Error())
+			return nil, err
+		}
+		list.Collection = append(list.Collection, newEpoch(&row))
+	}
+
+	// update the list boundaries
+	if 0 < len(list.Collection) {
+		list.First = uint64(list.Collection[0].Id)
+		list.Last = uint64(list.Collection[len(list.Collection)-1].Id)
+	}
+	if count > 0 {
+		list.IsEnd = !hasMore
+	} else {
+		list.IsStart = !hasMore
+	}
+
+	// reverse on negative so newer epochs will be on top
+	if count < 0 {
+		list.Reverse()
+	}
+
+	return list, nil
+}
@@ -0,0 +1,191 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"context"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// coGovernanceVotes is the name of the off-chain database collection
+	// storing votes cast in governance proposals.
+	coGovernanceVotes = "gov_vote"
+
+	// fiGovVotePk is the name of the primary key field of the governance vote collection.
+	fiGovVotePk = "_id"
+
+	// fiGovVoteGovernance is the name of the governance contract address field.
+	fiGovVoteGovernance = "gov"
+
+	// fiGovVoteProposal is the name of the proposal id field.
+	fiGovVoteProposal = "pid"
+
+	// fiGovVoteVoter is the name of the voter address field.
+	// db.gov_vote.createIndex({voter:1,ts:-1})
+	fiGovVoteVoter = "voter"
+
+	// fiGovVoteDelegatedTo is the name of the delegated to address field.
+	fiGovVoteDelegatedTo = "dlg"
+
+	// fiGovVoteChoices is the name of the chosen opinions field.
+	fiGovVoteChoices = "cho"
+
+	// fiGovVoteWeight is the name of the vote weight field.
+	fiGovVoteWeight = "wgh"
+
+	// fiGovVoteTransaction is the name of the vote transaction hash field.
+	fiGovVoteTransaction = "tx"
+
+	// fiGovVoteLogIndex is the name of the vote event log index field.
+	fiGovVoteLogIndex = "lix"
+
+	// fiGovVoteTimestamp is the name of the vote time stamp field.
+	fiGovVoteTimestamp = "ts"
+
+	// govVotesMaxList is the max number of governance votes returned in a list.
+	govVotesMaxList = 100
+)
+
+// govVoteRow defines a row in the governance votes collection.
+type govVoteRow struct {
+	Governance  string   `bson:"gov"`
+	ProposalId  uint64   `bson:"pid"`
+	Voter       string   `bson:"voter"`
+	DelegatedTo string   `bson:"dlg"`
+	Choices     []uint64 `bson:"cho"`
+	Weight      string   `bson:"wgh"`
+	Transaction string   `bson:"tx"`
+	LogIndex    uint64   `bson:"lix"`
+	TimeStamp   uint64   `bson:"ts"`
+}
+
+// govVoteId builds the identifier of a governance vote; a voter has one vote
+// in a proposal for each validator the voting power is delegated to.
+func govVoteId(vote *types.GovernanceVote) string {
+	return fmt.Sprintf("%s-%d-%s-%s", vote.Governance.String(), uint64(vote.ProposalId), vote.Voter.String(), vote.DelegatedTo.String())
+}
+
+// AddGovernanceVote stores the governance vote in the persistent storage.
+// The latest vote of the voter in the proposal replaces older one.
+func (db *MongoDbBridge) AddGovernanceVote(vote *types.GovernanceVote) error {
+	// do we have the vote?
+	if vote == nil {
+		return fmt.Errorf("can not add empty governance vote")
+	}
+
+	// get the collection for votes
+	col := db.client.Database(db.dbName).Collection(coGovernanceVotes)
+
+	// convert the choices
+	choices := make([]uint64, len(vote.Choices))
+	for i, ch := range vote.Choices {
+		choices[i] = uint64(ch)
+	}
+
+	// do the upsert
+	_, err := col.UpdateOne(context.Background(),
+		bson.D{{fiGovVotePk, govVoteId(vote)}},
+		bson.D{{"$set", bson.D{
+			{fiGovVoteGovernance, vote.Governance.String()},
+			{fiGovVoteProposal, uint64(vote.ProposalId)},
+			{fiGovVoteVoter, vote.Voter.String()},
+			{fiGovVoteDelegatedTo, vote.DelegatedTo.String()},
+			{fiGovVoteChoices, choices},
+			{fiGovVoteWeight, vote.Weight.String()},
+			{fiGovVoteTransaction, vote.TransactionHash.String()},
+			{fiGovVoteLogIndex, uint64(vote.LogIndex)},
+			{fiGovVoteTimestamp, uint64(vote.TimeStamp)},
+		}}},
+		options.Update().SetUpsert(true))
+	if err != nil {
+		db.log.Errorf("can not store governance vote; %s", err.Error())
+		return err
+	}
+
+	db.log.Debugf("governance vote of %s on proposal #%d added", vote.Voter.String(), uint64(vote.ProposalId))
+	return nil
+}
+
+// RemoveGovernanceVote removes the canceled governance vote from the persistent storage.
+func (db *MongoDbBridge) RemoveGovernanceVote(vote *types.GovernanceVote) error {
+	// get the collection for votes
+	col := db.client.Database(db.dbName).Collection(coGovernanceVotes)
+
+	// remove the vote
+	if _, err := col.DeleteOne(context.Background(), bson.D{{fiGovVotePk, govVoteId(vote)}}); err != nil {
+		db.log.Errorf("can not remove governance vote; %s", err.Error())
+		return err
+	}
+
+	db.log.Debugf("governance vote of %s on proposal #%d removed", vote.Voter.String(), uint64(vote.ProposalId))
+	return nil
+}
+
+// GovernanceVotes loads the list of governance votes cast by the given account
+// sorted from the newest to the oldest, optionally filtered by the governance contract.
+func (db *MongoDbBridge) GovernanceVotes(voter *common.Address, gov *common.Address) ([]types.GovernanceVote, error) {
+	// get the collection for votes
+	col := db.client.Database(db.dbName).Collection(coGovernanceVotes)
+
+	// build the filter
+	filter := bson.D{{fiGovVoteVoter, voter.String()}}
+	if gov != nil {
+		filter = append(filter, bson.E{Key: fiGovVoteGovernance, Value: gov.String()})
+	}
+
+	// load the votes
+	ctx := context.Background()
+	cursor, err := col.Find(ctx, filter, options.Find().SetSort(bson.D{{fiGovVoteTimestamp, -1}}).SetLimit(govVotesMaxList))
+	if err != nil {
+		db.log.Errorf("can not load governance votes of %s; %s", voter.String(), err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			db.log.Errorf("error closing governance votes cursor; %s", err.Error())
+		}
+	}()
+
+	// decode the votes
+	list := make([]types.GovernanceVote, 0)
+	for cursor.Next(ctx) {
+		var row govVoteRow
+		if err := cursor.Decode(&row); err != nil {
+			db.log.Errorf("can not decode governance vote; %s", err.Error())
+			return nil, err
+		}
+
+		// decode the weight
+		weight, err := hexutil.DecodeBig(row.Weight)
+		if err != nil {
+			db.log.Errorf("invalid governance vote weight %s; %s", row.Weight, err.Error())
+			return nil, err
+		}
+
+		vote := types.GovernanceVote{
+			Governance:      common.HexToAddress(row.Governance),
+			ProposalId:      hexutil.Uint64(row.ProposalId),
+			Voter:           common.HexToAddress(row.Voter),
+			DelegatedTo:     common.HexToAddress(row.DelegatedTo),
+			Choices:         make([]hexutil.Uint64, len(row.Choices)),
+			Weight:          hexutil.Big(*weight),
+			TransactionHash: types.HexToHash(row.Transaction),
+			LogIndex:        hexutil.Uint64(row.LogIndex),
+			TimeStamp:       hexutil.Uint64(row.TimeStamp),
+		}
+		for i, ch := range row.Choices {
+			vote.Choices[i] = hexutil.Uint64(ch)
+		}
+
+		list = append(list, vote)
+	}
+
+	return list, nil
+}
@@ -0,0 +1,73 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"context"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// initIndexes makes sure the indexes used by the search
+// and by the filtered lists exist; existing indexes are left intact.
+func (db *MongoDbBridge) initIndexes() {
+	// the list of indexes by collection
+	ix := map[string][]bson.D{
+		// db.contract.createIndex({name:"text"})
+		// db.contract.createIndex({nlc:1})
+		// db.contract.createIndex({ts:1})
+		coContract: {
+			{{fiContractName, "text"}},
+			{{fiContractNameLower, 1}},
+			{{fiContractTimestamp, 1}},
+		},
+
+		// db.contract_upgrade.createIndex({proxy:1,blk:1,lix:1})
+		coContractUpgrades: {{{fiContractUpgradeProxy, 1}, {fiContractUpgradeBlock, 1}, {fiContractUpgradeLogIndex, 1}}},
+
+		// db.uniswap_pair.createIndex({ix:1})
+		coUniswapPairs: {{{fiUniswapPairIndex, 1}}},
+
+		// db.account.createIndex({fs:1})
+		coAccounts: {{{fiAccountFirstSeen, 1}}},
+
+		// db.staker_info.createIndex({name:"text"})
+		// db.staker_info.createIndex({nlc:1})
+		coStakerInfo: {
+			{{fiStakerInfoName, "text"}},
+			{{fiStakerInfoNameLower, 1}},
+		},
+
+		// db.block.createIndex({ts:1})
+		coBlocks: {{{fiBlockTimeStamp, 1}}},
+
+		// db.net_stats.createIndex({res:1,ts:1})
+		coNetworkStats: {{{fiNetworkStatsResolution, 1}, {fiNetworkStatsTimeStamp, 1}}},
+
+		// db.transaction.createIndex({orx:-1})
+		// db.transaction.createIndex({from:1,orx:-1})
+		// db.transaction.createIndex({to:1,orx:-1})
+		// db.transaction.createIndex({sc:1,orx:-1})
+		// db.transaction.createIndex({blk:1,orx:-1})
+		// db.transaction.createIndex({ts:1,orx:-1})
+		coTransactions: {
+			{{fiTransactionOrdinalIndex, -1}},
+			{{fiTransactionSender, 1}, {fiTransactionOrdinalIndex, -1}},
+			{{fiTransactionRecipient, 1}, {fiTransactionOrdinalIndex, -1}},
+			{{fiTransactionContract, 1}, {fiTransactionOrdinalIndex, -1}},
+			{{fiTransactionBlock, 1}, {fiTransactionOrdinalIndex, -1}},
+			{{fiTransactionTimestamp, 1}, {fiTransactionOrdinalIndex, -1}},
+		},
+	}
+
+	// create the indexes
+	for co, list := range ix {
+		models := make([]mongo.IndexModel, len(list))
+		for i, keys := range list {
+			models[i] = mongo.IndexModel{Keys: keys}
+		}
+
+		if _, err := db.client.Database(db.dbName).Collection(co).Indexes().CreateMany(context.Background(), models); err != nil {
+			db.log.Errorf("can not create indexes of %s; %s", co, err.Error())
+		}
+	}
+}
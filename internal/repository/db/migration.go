@@ -0,0 +1,129 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"context"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"strings"
+	"time"
+)
+
+// coMigrations is the name of the off-chain database collection keeping the list of applied migrations.
+const coMigrations = "db_migration"
+
+// migration represents a one-off update of the stored data.
+type migration struct {
+	id  string
+	run func(*MongoDbBridge) error
+}
+
+// migrations is the ordered list of data migrations applied on start.
+var migrations = []migration{
+	{id: "contract_ordinal_creation_index", run: migrateContractOrdinals},
+	{id: "contract_name_lower_case", run: migrateContractNames},
+	{id: "staker_info_name_lower_case", run: migrateStakerInfoNames},
+}
+
+// runMigrations applies the data migrations not applied yet.
+func (db *MongoDbBridge) runMigrations() error {
+	// get the collection of applied migrations
+	col := db.client.Database(db.dbName).Collection(coMigrations)
+
+	for _, mig := range migrations {
+		// is the migration already applied?
+		sr := col.FindOne(context.Background(), bson.D{{"_id", mig.id}})
+		if sr.Err() == nil {
+			continue
+		}
+		if sr.Err() != mongo.ErrNoDocuments {
+			db.log.Errorf("can not check migration %s; %s", mig.id, sr.Err().Error())
+			return sr.Err()
+		}
+
+		// apply the migration
+		db.log.Noticef("applying database migration %s", mig.id)
+		if err := mig.run(db); err != nil {
+			db.log.Errorf("migration %s failed; %s", mig.id, err.Error())
+			return err
+		}
+
+		// mark it as done
+		if _, err := col.InsertOne(context.Background(), bson.D{{"_id", mig.id}, {"ts", time.Now().UTC().Unix()}}); err != nil {
+			db.log.Errorf("can not mark migration %s; %s", mig.id, err.Error())
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateContractOrdinals shifts the ordinal index of stored contracts
+// to make room for the creation index inside the creating transaction.
+//
+// The contract list cursors are the ordinal indexes, so the cursors issued
+// before the migration no longer point to any contract and the list query
+// fails on them. Clients have to restart the contract list paging from the top.
+//
+// Each contract is flagged in the same update as the shift, so a repeated run
+// after an interrupted migration does not shift the already migrated contracts again.
+// New contracts are stored flagged, so the migration running in the background
+// does not shift contracts added by the scanner in the meantime.
+func migrateContractOrdinals(db *MongoDbBridge) error {
+	db.log.Warning("contract list cursors issued before the ordinal migration are invalidated")
+	col := db.client.Database(db.dbName).Collection(coContract)
+	_, err := col.UpdateMany(context.Background(),
+		bson.D{{fiContractOrdinalMigrated, bson.D{{"$exists", false}}}},
+		bson.D{
+			{"$mul", bson.D{{fiContractOrdinalIndex, int64(1 << contractOrdinalCreationBits)}}},
+			{"$set", bson.D{{fiContractOrdinalMigrated, true}}},
+		})
+	return err
+}
+
+// migrateContractNames adds the lower case name used by the prefix search to stored contracts.
+func migrateContractNames(db *MongoDbBridge) error {
+	return db.migrateLowerCase(db.client.Database(db.dbName).Collection(coContract), fiContractName, fiContractNameLower)
+}
+
+// migrateStakerInfoNames adds the lower case name used by the prefix search to stored staker info.
+func migrateStakerInfoNames(db *MongoDbBridge) error {
+	return db.migrateLowerCase(db.client.Database(db.dbName).Collection(coStakerInfo), fiStakerInfoName, fiStakerInfoNameLower)
+}
+
+// migrateLowerCase stores the lower case copy of the given string field
+// of all the documents of the collection having the field set.
+// Documents with the lower case copy already stored are skipped,
+// so a repeated run after an interrupted migration continues where it stopped.
+func (db *MongoDbBridge) migrateLowerCase(col *mongo.Collection, field string, lower string) error {
+	// find the documents with the field set and the lower case copy missing
+	ctx := context.Background()
+	ld, err := col.Find(ctx, bson.D{
+		{field, bson.D{{"$type", "string"}}},
+		{lower, bson.D{{"$exists", false}}},
+	}, options.Find().SetProjection(bson.D{{field, true}}))
+	if err != nil {
+		return err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing migration cursor; %s", err.Error())
+		}
+	}()
+
+	// update the documents one by one
+	for ld.Next(ctx) {
+		var row bson.M
+		if err := ld.Decode(&row); err != nil {
+			return err
+		}
+
+		val, _ := row[field].(string)
+		if _, err := col.UpdateOne(ctx, bson.D{{"_id", row["_id"]}}, bson.D{{"$set", bson.D{{lower, strings.ToLower(val)}}}}); err != nil {
+			return err
+		}
+	}
+	return ld.Err()
+}
@@ -0,0 +1,361 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"context"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"math/big"
+)
+
+const (
+	// coNetworkStats is the name of the off-chain database collection storing aggregated network statistics.
+	coNetworkStats = "net_stats"
+
+	// fiNetworkStatsPk is the name of the primary key field of the network statistics collection.
+	fiNetworkStatsPk = "_id"
+
+	// fiNetworkStatsResolution is the name of the aggregation resolution field.
+	// db.net_stats.createIndex({res:1,ts:1})
+	fiNetworkStatsResolution = "res"
+
+	// fiNetworkStatsTimeStamp is the name of the period start time stamp field.
+	fiNetworkStatsTimeStamp = "ts"
+)
+
+// networkStatsRow defines a row in the network statistics collection.
+type networkStatsRow struct {
+	Id           string `bson:"_id"`
+	Resolution   string `bson:"res"`
+	TimeStamp    uint64 `bson:"ts"`
+	Duration     uint64 `bson:"dur"`
+	TxCount      uint64 `bson:"txs"`
+	GasUsed      uint64 `bson:"gas"`
+	Senders      uint64 `bson:"snd"`
+	NewAccounts  uint64 `bson:"acc"`
+	NewContracts uint64 `bson:"sc"`
+	Volume       string `bson:"vol"`
+}
+
+// newNetworkStats creates network statistics from the collection row.
+func newNetworkStats(row *networkStatsRow) *types.NetworkStats {
+	// decode the volume; it's always encoded by us
+	vol, err := hexutil.DecodeBig(row.Volume)
+	if err != nil {
+		vol = new(big.Int)
+	}
+
+	return &types.NetworkStats{
+		Resolution:   row.Resolution,
+		TimeStamp:    hexutil.Uint64(row.TimeStamp),
+		Duration:     hexutil.Uint64(row.Duration),
+		TxCount:      hexutil.Uint64(row.TxCount),
+		GasUsed:      hexutil.Uint64(row.GasUsed),
+		Senders:      hexutil.Uint64(row.Senders),
+		NewAccounts:  hexutil.Uint64(row.NewAccounts),
+		NewContracts: hexutil.Uint64(row.NewContracts),
+		Volume:       hexutil.Big(*vol),
+	}
+}
+
+// StoreNetworkStats stores the aggregated network statistics of a period.
+func (db *MongoDbBridge) StoreNetworkStats(ns *types.NetworkStats) error {
+	// get the collection
+	col := db.client.Database(db.dbName).Collection(coNetworkStats)
+
+	// prep the row
+	row := networkStatsRow{
+		Id:           fmt.Sprintf("%s-%d", ns.Resolution, uint64(ns.TimeStamp)),
+		Resolution:   ns.Resolution,
+		TimeStamp:    uint64(ns.TimeStamp),
+		Duration:     uint64(ns.Duration),
+		TxCount:      uint64(ns.TxCount),
+		GasUsed:      uint64(ns.GasUsed),
+		Senders:      uint64(ns.Senders),
+		NewAccounts:  uint64(ns.NewAccounts),
+		NewContracts: uint64(ns.NewContracts),
+		Volume:       ns.Volume.String(),
+	}
+
+	// do the upsert
+	_, err := col.ReplaceOne(context.Background(),
+		bson.D{{fiNetworkStatsPk, row.Id}},
+		&row,
+		options.Replace().SetUpsert(true))
+	if err != nil {
+		db.log.Errorf("can not store network stats %s; %s", row.Id, err.Error())
+		return err
+	}
+	return nil
+}
+
+// NetworkStats loads the aggregated network statistics of the given resolution
+// with the period start in the given time range, ordered from old to new.
+func (db *MongoDbBridge) NetworkStats(res string, from uint64, to uint64) ([]*types.NetworkStats, error) {
+	// get the collection and context
+	col := db.client.Database(db.dbName).Collection(coNetworkStats)
+	ctx := context.Background()
+
+	// load the data
+	ld, err := col.Find(ctx, bson.D{
+		{fiNetworkStatsResolution, res},
+		{fiNetworkStatsTimeStamp, bson.D{{"$gte", from}, {"$lte", to}}},
+	}, options.Find().SetSort(bson.D{{fiNetworkStatsTimeStamp, 1}}))
+	if err != nil {
+		db.log.Errorf("can not load network stats; %s", err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing network stats cursor; %s", err.Error())
+		}
+	}()
+
+	// loop and load
+	list := make([]*types.NetworkStats, 0)
+	for ld.Next(ctx) {
+		var row networkStatsRow
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode network stats row; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, newNetworkStats(&row))
+	}
+
+	return list, nil
+}
+
+// LastNetworkStats loads the latest aggregated network statistics of the given resolution;
+// nil if none has been aggregated yet.
+func (db *MongoDbBridge) LastNetworkStats(res string) (*types.NetworkStats, error) {
+	// get the collection
+	col := db.client.Database(db.dbName).Collection(coNetworkStats)
+
+	// find the latest period
+	sr := col.FindOne(context.Background(),
+		bson.D{{fiNetworkStatsResolution, res}},
+		options.FindOne().SetSort(bson.D{{fiNetworkStatsTimeStamp, -1}}))
+	if sr.Err() != nil {
+		// no stats yet?
+		if sr.Err() == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+
+		db.log.Errorf("can not load the latest network stats; %s", sr.Err().Error())
+		return nil, sr.Err()
+	}
+
+	// decode the row
+	var row networkStatsRow
+	if err := sr.Decode(&row); err != nil {
+		db.log.Errorf("can not decode network stats row; %s", err.Error())
+		return nil, err
+	}
+
+	return newNetworkStats(&row), nil
+}
+
+// FirstTransactionTime returns the time stamp of the oldest known transaction;
+// zero if there are no transactions.
+func (db *MongoDbBridge) FirstTransactionTime() (uint64, error) {
+	// get the collection
+	col := db.client.Database(db.dbName).Collection(coTransactions)
+
+	// find the oldest transaction
+	sr := col.FindOne(context.Background(), bson.D{},
+		options.FindOne().
+			SetSort(bson.D{{fiTransactionTimestamp, 1}}).
+			SetProjection(bson.D{{fiTransactionTimestamp, true}}))
+	if sr.Err() != nil {
+		// no transactions yet?
+		if sr.Err() == mongo.ErrNoDocuments {
+			return 0, nil
+		}
+
+		db.log.Errorf("can not find the first transaction; %s", sr.Err().Error())
+		return 0, sr.Err()
+	}
+
+	// decode the time stamp
+	var row struct {
+		TimeStamp uint64 `bson:"ts"`
+	}
+	if err := sr.Decode(&row); err != nil {
+		db.log.Errorf("can not decode the first transaction; %s", err.Error())
+		return 0, err
+	}
+
+	return row.TimeStamp, nil
+}
+
+// AggregateNetworkStats calculates the network statistics of the given period
+// from the stored transactions, blocks, accounts and contracts.
+// The time range includes the from and excludes the to time stamp.
+func (db *MongoDbBridge) AggregateNetworkStats(res string, from uint64, to uint64) (*types.NetworkStats, error) {
+	// prep the stats
+	ns := types.NetworkStats{
+		Resolution: res,
+		TimeStamp:  hexutil.Uint64(from),
+		Duration:   hexutil.Uint64(to - from),
+	}
+
+	// the time range filter
+	rng := bson.D{{"$gte", from}, {"$lt", to}}
+
+	// transactions, senders and volume
+	if err := db.aggregateTxStats(rng, &ns); err != nil {
+		return nil, err
+	}
+
+	// gas used by blocks
+	gas, err := db.sumBlocksGas(rng)
+	if err != nil {
+		return nil, err
+	}
+	ns.GasUsed = hexutil.Uint64(gas)
+
+	// new accounts
+	acc, err := db.countInRange(coAccounts, fiAccountFirstSeen, rng)
+	if err != nil {
+		return nil, err
+	}
+	ns.NewAccounts = hexutil.Uint64(acc)
+
+	// new contracts
+	sc, err := db.countInRange(coContract, fiContractTimestamp, rng)
+	if err != nil {
+		return nil, err
+	}
+	ns.NewContracts = hexutil.Uint64(sc)
+
+	return &ns, nil
+}
+
+// aggregateTxStats calculates the number of transactions, unique senders and total volume
+// of transactions in the time range. The aggregation waits for the transactions backfill
+// so the decimal value is known; the fallback only keeps the sum a decimal.
+func (db *MongoDbBridge) aggregateTxStats(rng bson.D, ns *types.NetworkStats) error {
+	// get the collection and context
+	col := db.client.Database(db.dbName).Collection(coTransactions)
+	ctx := context.Background()
+
+	// group by sender first so we know the unique senders
+	ld, err := col.Aggregate(ctx, mongo.Pipeline{
+		{{"$match", bson.D{{fiTransactionTimestamp, rng}}}},
+		{{"$group", bson.D{
+			{"_id", "$" + fiTransactionSender},
+			{"txs", bson.D{{"$sum", 1}}},
+			{"vol", bson.D{{"$sum", bson.D{{"$ifNull", bson.A{"$" + fiTransactionValueDecimal, decimalValue(new(big.Int))}}}}}},
+		}}},
+		{{"$group", bson.D{
+			{"_id", nil},
+			{"snd", bson.D{{"$sum", 1}}},
+			{"txs", bson.D{{"$sum", "$txs"}}},
+			{"vol", bson.D{{"$sum", "$vol"}}},
+		}}},
+	})
+	if err != nil {
+		db.log.Errorf("can not aggregate transactions stats; %s", err.Error())
+		return err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing transactions stats cursor; %s", err.Error())
+		}
+	}()
+
+	// no transactions at all?
+	if !ld.Next(ctx) {
+		ns.Volume = hexutil.Big{}
+		return ld.Err()
+	}
+
+	// decode the result
+	var row struct {
+		Senders int64                `bson:"snd"`
+		TxCount int64                `bson:"txs"`
+		Volume  primitive.Decimal128 `bson:"vol"`
+	}
+	if err := ld.Decode(&row); err != nil {
+		db.log.Errorf("can not decode transactions stats; %s", err.Error())
+		return err
+	}
+
+	// decode the volume
+	vol, err := decimalToBig(row.Volume)
+	if err != nil {
+		db.log.Errorf("can not decode transactions volume; %s", err.Error())
+		return err
+	}
+
+	ns.Senders = hexutil.Uint64(row.Senders)
+	ns.TxCount = hexutil.Uint64(row.TxCount)
+	ns.Volume = hexutil.Big(*vol)
+	return nil
+}
+
+// sumBlocksGas calculates the total gas used by blocks in the time range.
+func (db *MongoDbBridge) sumBlocksGas(rng bson.D) (uint64, error) {
+	// get the collection and context
+	col := db.client.Database(db.dbName).Collection(coBlocks)
+	ctx := context.Background()
+
+	// sum the gas
+	ld, err := col.Aggregate(ctx, mongo.Pipeline{
+		{{"$match", bson.D{{fiBlockTimeStamp, rng}}}},
+		{{"$group", bson.D{
+			{"_id", nil},
+			{"gas", bson.D{{"$sum", "$gasUsed"}}},
+		}}},
+	})
+	if err != nil {
+		db.log.Errorf("can not aggregate blocks gas; %s", err.Error())
+		return 0, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing blocks gas cursor; %s", err.Error())
+		}
+	}()
+
+	// no blocks at all?
+	if !ld.Next(ctx) {
+		return 0, ld.Err()
+	}
+
+	// decode the result
+	var row struct {
+		Gas int64 `bson:"gas"`
+	}
+	if err := ld.Decode(&row); err != nil {
+		db.log.Errorf("can not decode blocks gas; %s", err.Error())
+		return 0, err
+	}
+
+	return uint64(row.Gas), nil
+}
+
+// countInRange counts documents of the collection with the time stamp field in the given range.
+func (db *MongoDbBridge) countInRange(co string, field string, rng bson.D) (uint64, error) {
+	// get the collection
+	col := db.client.Database(db.dbName).Collection(co)
+
+	// count the documents
+	total, err := col.CountDocuments(context.Background(), bson.D{{field, rng}})
+	if err != nil {
+		db.log.Errorf("can not count %s in range; %s", co, err.Error())
+		return 0, err
+	}
+	return uint64(total), nil
+}
@@ -0,0 +1,116 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"context"
+	"fantom-api-graphql/internal/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"regexp"
+	"strings"
+)
+
+// fiSearchScore is the name of the text search relevance score field.
+const fiSearchScore = "score"
+
+// search finds documents of the collection matching the text either by the full text
+// search, ordered by relevance, or by the prefix of the given lower case field.
+// The prefix is matched case sensitive so the index of the field is used. The decoder
+// is called for each document found and returns the unique key of the document
+// so the documents found by both methods are not repeated.
+func (db *MongoDbBridge) search(col *mongo.Collection, field string, text string, limit int64, decode func(*mongo.Cursor) (string, error)) error {
+	// the full text search goes first
+	filters := []bson.D{
+		{{"$text", bson.D{{"$search", text}}}},
+		{{field, primitive.Regex{Pattern: "^" + regexp.QuoteMeta(strings.ToLower(text))}}},
+	}
+	opts := []*options.FindOptions{
+		options.Find().
+			SetProjection(bson.D{{fiSearchScore, bson.D{{"$meta", "textScore"}}}}).
+			SetSort(bson.D{{fiSearchScore, bson.D{{"$meta", "textScore"}}}}).
+			SetLimit(limit),
+		options.Find().SetLimit(limit),
+	}
+
+	// run the searches and collect unique documents
+	found := make(map[string]bool)
+	for i := range filters {
+		if err := db.searchRun(col, filters[i], opts[i], limit, found, decode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// searchRun executes a single search query and passes documents not found yet to the decoder.
+func (db *MongoDbBridge) searchRun(col *mongo.Collection, filter bson.D, opt *options.FindOptions, limit int64, found map[string]bool, decode func(*mongo.Cursor) (string, error)) error {
+	// do we need more?
+	if int64(len(found)) >= limit {
+		return nil
+	}
+
+	// run the query
+	ctx := context.Background()
+	ld, err := col.Find(ctx, filter, opt)
+	if err != nil {
+		db.log.Errorf("search on %s failed; %s", col.Name(), err.Error())
+		return err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing search cursor; %s", err.Error())
+		}
+	}()
+
+	// loop and decode
+	for ld.Next(ctx) && int64(len(found)) < limit {
+		key, err := decode(ld)
+		if err != nil {
+			db.log.Errorf("can not decode search result; %s", err.Error())
+			return err
+		}
+		found[key] = true
+	}
+	return nil
+}
+
+// SearchContracts finds contracts by name using full text and prefix search.
+func (db *MongoDbBridge) SearchContracts(text string, limit int64) ([]*types.Contract, error) {
+	list := make([]*types.Contract, 0)
+	col := db.client.Database(db.dbName).Collection(coContract)
+
+	// search and decode the contracts
+	err := db.search(col, fiContractNameLower, text, limit, func(cur *mongo.Cursor) (string, error) {
+		var row contractRow
+		if err := cur.Decode(&row); err != nil {
+			return "", err
+		}
+
+		// skip contracts already found
+		for _, sc := range list {
+			if sc.Address.String() == row.Id {
+				return row.Id, nil
+			}
+		}
+
+		list = append(list, newContract(&row))
+		return row.Id, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// optionalLower provides the lower case version of the optional string used by the prefix search.
+func optionalLower(val *string) *string {
+	if val == nil {
+		return nil
+	}
+	lc := strings.ToLower(*val)
+	return &lc
+}
@@ -0,0 +1,114 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"context"
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// coStakerInfo is the name of the off-chain database collection storing extended staker information.
+	coStakerInfo = "staker_info"
+
+	// fiStakerInfoPk is the name of the primary key field of the staker info collection,
+	// the id of the staker.
+	fiStakerInfoPk = "_id"
+
+	// fiStakerInfoName is the name of the staker name field.
+	fiStakerInfoName = "name"
+
+	// fiStakerInfoNameLower is the name of the lower case staker name field used by the prefix search.
+	// db.staker_info.createIndex({nlc:1})
+	fiStakerInfoNameLower = "nlc"
+)
+
+// stakerInfoRow defines a row in the staker info collection.
+type stakerInfoRow struct {
+	Id      uint64  `bson:"_id"`
+	Name    *string `bson:"name"`
+	NameLc  *string `bson:"nlc"`
+	LogoUrl *string `bson:"logo"`
+	Website *string `bson:"web"`
+	Contact *string `bson:"contact"`
+}
+
+// UpdateStakerInfo stores the extended staker information in the persistent storage
+// so the stakers can be searched by name.
+func (db *MongoDbBridge) UpdateStakerInfo(id hexutil.Uint64, sti *types.StakerInfo) error {
+	// get the collection for staker info
+	col := db.client.Database(db.dbName).Collection(coStakerInfo)
+
+	// do the upsert
+	_, err := col.ReplaceOne(context.Background(),
+		bson.D{{fiStakerInfoPk, uint64(id)}},
+		stakerInfoRow{
+			Id:      uint64(id),
+			Name:    sti.Name,
+			NameLc:  optionalLower(sti.Name),
+			LogoUrl: sti.LogoUrl,
+			Website: sti.Website,
+			Contact: sti.Contact,
+		},
+		options.Replace().SetUpsert(true))
+	if err != nil {
+		db.log.Errorf("can not store info of staker #%d; %s", uint64(id), err.Error())
+		return err
+	}
+	return nil
+}
+
+// StakerInfo loads the last known extended staker information from the persistent storage.
+// Nil is returned if the info of the staker is not known.
+func (db *MongoDbBridge) StakerInfo(id hexutil.Uint64) (*types.StakerInfo, error) {
+	// get the collection for staker info
+	col := db.client.Database(db.dbName).Collection(coStakerInfo)
+
+	// try to find the row
+	var row stakerInfoRow
+	err := col.FindOne(context.Background(), bson.D{{fiStakerInfoPk, uint64(id)}}).Decode(&row)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+
+		db.log.Errorf("can not load info of staker #%d; %s", uint64(id), err.Error())
+		return nil, err
+	}
+
+	return &types.StakerInfo{
+		Name:    row.Name,
+		LogoUrl: row.LogoUrl,
+		Website: row.Website,
+		Contact: row.Contact,
+	}, nil
+}
+
+// SearchStakers finds ids of stakers by name using full text and prefix search.
+func (db *MongoDbBridge) SearchStakers(text string, limit int64) ([]hexutil.Uint64, error) {
+	list := make([]hexutil.Uint64, 0)
+	col := db.client.Database(db.dbName).Collection(coStakerInfo)
+
+	// search and decode the staker ids
+	found := make(map[uint64]bool)
+	err := db.search(col, fiStakerInfoNameLower, text, limit, func(cur *mongo.Cursor) (string, error) {
+		var row stakerInfoRow
+		if err := cur.Decode(&row); err != nil {
+			return "", err
+		}
+
+		// skip stakers already found
+		if !found[row.Id] {
+			found[row.Id] = true
+			list = append(list, hexutil.Uint64(row.Id))
+		}
+		return hexutil.EncodeUint64(row.Id), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
@@ -0,0 +1,167 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"context"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// coStakerSamples is the name of the off-chain database collection storing staker samples.
+	coStakerSamples = "staker_sample"
+
+	// fiStakerSampleStaker is the name of the staker id field of the sample.
+	// db.staker_sample.createIndex({sid:1,ep:-1},{unique:true})
+	fiStakerSampleStaker = "sid"
+
+	// fiStakerSampleEpoch is the name of the epoch field of the sample.
+	fiStakerSampleEpoch = "ep"
+)
+
+// stakerSampleRow defines a row in the staker sample collection.
+type stakerSampleRow struct {
+	StakerId         uint64 `bson:"sid"`
+	Epoch            uint64 `bson:"ep"`
+	Time             uint64 `bson:"ts"`
+	Status           uint64 `bson:"st"`
+	IsActive         bool   `bson:"act"`
+	IsOffline        bool   `bson:"off"`
+	IsCheater        bool   `bson:"cht"`
+	WasOffline       bool   `bson:"woff"`
+	OfflineIncidents int32  `bson:"inc"`
+	MissedBlocks     uint64 `bson:"mis"`
+	Downtime         uint64 `bson:"dwn"`
+	EpochMissed      uint64 `bson:"emis"`
+	EpochDowntime    uint64 `bson:"edwn"`
+	ObservedTime     uint64 `bson:"obs"`
+}
+
+// newStakerSample creates a staker sample from the staker sample collection row.
+func newStakerSample(row *stakerSampleRow) types.StakerSample {
+	return types.StakerSample{
+		StakerId:          hexutil.Uint64(row.StakerId),
+		Epoch:             hexutil.Uint64(row.Epoch),
+		Time:              hexutil.Uint64(row.Time),
+		Status:            hexutil.Uint64(row.Status),
+		IsActive:          row.IsActive,
+		IsOffline:         row.IsOffline,
+		IsCheater:         row.IsCheater,
+		WasOffline:        row.WasOffline,
+		OfflineIncidents:  row.OfflineIncidents,
+		MissedBlocks:      hexutil.Uint64(row.MissedBlocks),
+		Downtime:          hexutil.Uint64(row.Downtime),
+		EpochMissedBlocks: hexutil.Uint64(row.EpochMissed),
+		EpochDowntime:     hexutil.Uint64(row.EpochDowntime),
+		ObservedTime:      hexutil.Uint64(row.ObservedTime),
+	}
+}
+
+// AddStakerSample stores the staker sample in the persistent storage.
+// The sample replaces any previous sample of the staker in the same epoch.
+func (db *MongoDbBridge) AddStakerSample(sm *types.StakerSample) error {
+	// do we have the sample?
+	if sm == nil {
+		return fmt.Errorf("can not add empty staker sample")
+	}
+
+	// get the collection for samples
+	col := db.client.Database(db.dbName).Collection(coStakerSamples)
+
+	// do the upsert
+	_, err := col.ReplaceOne(context.Background(),
+		bson.D{{fiStakerSampleStaker, uint64(sm.StakerId)}, {fiStakerSampleEpoch, uint64(sm.Epoch)}},
+		stakerSampleRow{
+			StakerId:         uint64(sm.StakerId),
+			Epoch:            uint64(sm.Epoch),
+			Time:             uint64(sm.Time),
+			Status:           uint64(sm.Status),
+			IsActive:         sm.IsActive,
+			IsOffline:        sm.IsOffline,
+			IsCheater:        sm.IsCheater,
+			WasOffline:       sm.WasOffline,
+			OfflineIncidents: sm.OfflineIncidents,
+			MissedBlocks:     uint64(sm.MissedBlocks),
+			Downtime:         uint64(sm.Downtime),
+			EpochMissed:      uint64(sm.EpochMissedBlocks),
+			EpochDowntime:    uint64(sm.EpochDowntime),
+			ObservedTime:     uint64(sm.ObservedTime),
+		},
+		options.Replace().SetUpsert(true))
+	if err != nil {
+		db.log.Errorf("can not store sample of staker #%d; %s", uint64(sm.StakerId), err.Error())
+		return err
+	}
+	return nil
+}
+
+// LastStakerSample loads the latest sample of the given staker; nil if not found.
+func (db *MongoDbBridge) LastStakerSample(id hexutil.Uint64) (*types.StakerSample, error) {
+	// get the collection for samples
+	col := db.client.Database(db.dbName).Collection(coStakerSamples)
+
+	// find the latest sample
+	res := col.FindOne(context.Background(),
+		bson.D{{fiStakerSampleStaker, uint64(id)}},
+		options.FindOne().SetSort(bson.D{{fiStakerSampleEpoch, -1}}))
+	if res.Err() != nil {
+		// may be ErrNoDocuments, which we seek
+		if res.Err() == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+
+		db.log.Errorf("can not get the last sample of staker #%d; %s", uint64(id), res.Err().Error())
+		return nil, res.Err()
+	}
+
+	// decode the row
+	var row stakerSampleRow
+	if err := res.Decode(&row); err != nil {
+		db.log.Errorf("can not decode the last sample of staker #%d; %s", uint64(id), err.Error())
+		return nil, err
+	}
+
+	sm := newStakerSample(&row)
+	return &sm, nil
+}
+
+// StakerSamples loads samples of the given staker in the given range of epochs.
+func (db *MongoDbBridge) StakerSamples(id hexutil.Uint64, from hexutil.Uint64, to hexutil.Uint64) ([]types.StakerSample, error) {
+	// get the collection and context
+	col := db.client.Database(db.dbName).Collection(coStakerSamples)
+	ctx := context.Background()
+
+	// load the data
+	ld, err := col.Find(ctx, bson.D{
+		{fiStakerSampleStaker, uint64(id)},
+		{fiStakerSampleEpoch, bson.D{{"$gte", uint64(from)}, {"$lte", uint64(to)}}},
+	}, options.Find().SetSort(bson.D{{fiStakerSampleEpoch, 1}}))
+	if err != nil {
+		db.log.Errorf("can not load samples of staker #%d; %s", uint64(id), err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing staker samples cursor; %s", err.Error())
+		}
+	}()
+
+	// loop and load
+	list := make([]types.StakerSample, 0)
+	for ld.Next(ctx) {
+		var row stakerSampleRow
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode the staker sample; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, newStakerSample(&row))
+	}
+
+	return list, nil
+}
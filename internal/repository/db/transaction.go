@@ -5,9 +5,13 @@ import (
 	"context"
 	"fantom-api-graphql/internal/types"
 	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"math/big"
 	"math/rand"
 )
 
@@ -41,6 +45,13 @@ const (
 
 	// fiTransactionTimestamp is the name of the transaction time stamp field.
 	fiTransactionTimestamp = "ts"
+
+	// fiTransactionValueDecimal is the name of the value transferred in WEI field
+	// in the decimal form usable for the value range filter.
+	fiTransactionValueDecimal = "vdc"
+
+	// fiTransactionStatus is the name of the transaction status field.
+	fiTransactionStatus = "stat"
 )
 
 // shouldAddTransaction validates if the transaction should be added to the persistent storage.
@@ -85,6 +96,13 @@ func (db *MongoDbBridge) AddTransaction(block *types.Block, trx *types.Transacti
 		scAddress = &sca
 	}
 
+	// the status is known for processed transactions only
+	var status *uint64
+	if trx.Status != nil {
+		st := uint64(*trx.Status)
+		status = &st
+	}
+
 	// try to do the insert
 	_, err := col.InsertOne(context.Background(), bson.D{
 		{fiTransactionPk, trx.Hash.String()},
@@ -95,6 +113,8 @@ func (db *MongoDbBridge) AddTransaction(block *types.Block, trx *types.Transacti
 		{fiTransactionContract, scAddress},
 		{fiTransactionValue, trx.Value.String()},
 		{fiTransactionTimestamp, uint64(block.TimeStamp)},
+		{fiTransactionValueDecimal, decimalValue(trx.Value.ToInt())},
+		{fiTransactionStatus, status},
 	})
 
 	// check for errors
@@ -107,6 +127,161 @@ func (db *MongoDbBridge) AddTransaction(block *types.Block, trx *types.Transacti
 	return db.propagateTrxToAccounts(block, trx)
 }
 
+// decimalValue converts the value into the decimal form stored in the database.
+// Values out of the decimal range are stored as nil.
+func decimalValue(val *big.Int) *primitive.Decimal128 {
+	dec, ok := primitive.ParseDecimal128FromBigInt(val, 0)
+	if !ok {
+		return nil
+	}
+	return &dec
+}
+
+// txFilterQuery creates the base query of a filtered transaction list.
+// Transactions stored before the value and status fields were introduced
+// get the fields from the transactions backfill.
+func txFilterQuery(tf *types.TransactionFilter) (bson.D, error) {
+	// no filter, no conditions
+	filter := bson.D{}
+	if tf == nil {
+		return filter, nil
+	}
+
+	// counterparts
+	if tf.Sender != nil {
+		filter = append(filter, bson.E{Key: fiTransactionSender, Value: tf.Sender.String()})
+	}
+	if tf.Recipient != nil {
+		filter = append(filter, bson.E{Key: fiTransactionRecipient, Value: tf.Recipient.String()})
+	}
+	if tf.ContractCreation {
+		filter = append(filter, bson.E{Key: fiTransactionContract, Value: bson.D{{"$ne", nil}}})
+	}
+
+	// value range
+	rng, err := decimalRangeQuery(tf.MinValue, tf.MaxValue)
+	if err != nil {
+		return nil, err
+	}
+	if rng != nil {
+		filter = append(filter, bson.E{Key: fiTransactionValueDecimal, Value: rng})
+	}
+
+	// time and block range
+	if rng := txRangeQuery(uint64RangeValue(tf.FromTime), uint64RangeValue(tf.ToTime)); rng != nil {
+		filter = append(filter, bson.E{Key: fiTransactionTimestamp, Value: rng})
+	}
+	if rng := txRangeQuery(uint64RangeValue(tf.FromBlock), uint64RangeValue(tf.ToBlock)); rng != nil {
+		filter = append(filter, bson.E{Key: fiTransactionBlock, Value: rng})
+	}
+
+	// status
+	if tf.Status != nil {
+		filter = append(filter, bson.E{Key: fiTransactionStatus, Value: uint64(*tf.Status)})
+	}
+
+	return filter, nil
+}
+
+// txRangeQuery creates an inclusive range query for the given boundaries; nil if none is set.
+func txRangeQuery(from interface{}, to interface{}) bson.D {
+	var rng bson.D
+	if from != nil {
+		rng = append(rng, bson.E{Key: "$gte", Value: from})
+	}
+	if to != nil {
+		rng = append(rng, bson.E{Key: "$lte", Value: to})
+	}
+	return rng
+}
+
+// decimalRangeQuery creates an inclusive range query for the given value boundaries; nil if none is set.
+func decimalRangeQuery(from *hexutil.Big, to *hexutil.Big) (bson.D, error) {
+	low, err := decimalRangeValue(from)
+	if err != nil {
+		return nil, err
+	}
+
+	high, err := decimalRangeValue(to)
+	if err != nil {
+		return nil, err
+	}
+
+	return txRangeQuery(low, high), nil
+}
+
+// decimalRangeValue converts an optional range boundary into the decimal form.
+// Boundaries out of the decimal range can not be compared and are rejected.
+func decimalRangeValue(val *hexutil.Big) (interface{}, error) {
+	if val == nil {
+		return nil, nil
+	}
+
+	dec := decimalValue(val.ToInt())
+	if dec == nil {
+		return nil, fmt.Errorf("value %s out of range", val.String())
+	}
+	return *dec, nil
+}
+
+// uint64RangeValue converts an optional numeric range boundary into the database form.
+func uint64RangeValue(val *hexutil.Uint64) interface{} {
+	if val == nil {
+		return nil
+	}
+	return uint64(*val)
+}
+
+// TransactionSenders loads the senders of the given stored transactions.
+// Transactions not stored in the database are not included in the result.
+func (db *MongoDbBridge) TransactionSenders(hashes []types.Hash) (map[types.Hash]common.Address, error) {
+	// prep the result
+	senders := make(map[types.Hash]common.Address, len(hashes))
+	if 0 == len(hashes) {
+		return senders, nil
+	}
+
+	// get the collection for transactions
+	col := db.client.Database(db.dbName).Collection(coTransactions)
+	ctx := context.Background()
+
+	// make the list of ids
+	ids := make(bson.A, len(hashes))
+	for i := range hashes {
+		ids[i] = hashes[i].String()
+	}
+
+	// load the senders
+	ld, err := col.Find(ctx, bson.D{{fiTransactionPk, bson.D{{"$in", ids}}}},
+		options.Find().SetProjection(bson.D{{fiTransactionSender, true}}))
+	if err != nil {
+		db.log.Errorf("can not load transaction senders; %s", err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing transaction senders cursor; %s", err.Error())
+		}
+	}()
+
+	// collect the senders
+	for ld.Next(ctx) {
+		var row struct {
+			Hash   string `bson:"_id"`
+			Sender string `bson:"from"`
+		}
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode transaction sender; %s", err.Error())
+			return nil, err
+		}
+		senders[types.HexToHash(row.Hash)] = common.HexToAddress(row.Sender)
+	}
+
+	return senders, nil
+}
+
 // mustTransactionIndex always calculate the index of the current transaction
 func (db *MongoDbBridge) TransactionIndex(block *types.Block, trx *types.Transaction) uint64 {
 	// what is the transaction index
@@ -122,6 +297,13 @@ func (db *MongoDbBridge) TransactionIndex(block *types.Block, trx *types.Transac
 	return trxOrdinalIndex(uint64(block.Number), txIndex)
 }
 
+// ContractIndex calculates the ordinal index of a contract created by the given transaction.
+// The creation index makes contracts deployed by the same transaction unique,
+// the direct deployment uses zero.
+func (db *MongoDbBridge) ContractIndex(block *types.Block, trx *types.Transaction, ix uint64) uint64 {
+	return (db.TransactionIndex(block, trx) << contractOrdinalCreationBits) | ix
+}
+
 // getTrxOrdinalIndex calculates ordinal index in the whole blockchain.
 // This gives us about 700 years of index space with 50k blocks per second rate + 10 years to fix than.
 func trxOrdinalIndex(block uint64, trxIndex uint64) uint64 {
@@ -227,19 +409,24 @@ func (db *MongoDbBridge) LastKnownBlock() (uint64, error) {
 }
 
 // initTrxList initializes list of transactions based on provided cursor and count.
-func (db *MongoDbBridge) initTrxList(col *mongo.Collection, cursor *string, count int32) (*types.TransactionHashList, error) {
+func (db *MongoDbBridge) initTrxList(col *mongo.Collection, cursor *string, count int32, filter bson.D) (*types.TransactionHashList, error) {
 	// get the context
 	ctx := context.Background()
 
-	// find how many transactions do we have in the database
-	total, err := col.CountDocuments(ctx, bson.D{})
-	if err != nil {
-		db.log.Errorf("can not count transactions")
-		return nil, err
-	}
+	// find how many transactions do we have in the database; the filtered lists
+	// are counted on demand only since the count scans all the matching transactions
+	var total int64
+	var err error
+	if len(filter) == 0 {
+		total, err = col.CountDocuments(ctx, filter)
+		if err != nil {
+			db.log.Errorf("can not count transactions")
+			return nil, err
+		}
 
-	// inform what we are about to do
-	db.log.Debugf("found %d transactions in off-chain database", total)
+		// inform what we are about to do
+		db.log.Debugf("found %d transactions in off-chain database", total)
+	}
 
 	list := types.TransactionHashList{
 		Collection: make([]*types.Hash, 0),
@@ -254,14 +441,14 @@ func (db *MongoDbBridge) initTrxList(col *mongo.Collection, cursor *string, coun
 	if cursor == nil && count > 0 {
 		// get the highest available ordinal index (top transaction)
 		list.First, err = db.findBorderOrdinalIndex(col,
-			bson.D{},
+			filter,
 			options.FindOne().SetSort(bson.D{{fiTransactionOrdinalIndex, -1}}))
 		list.IsStart = true
 
 	} else if cursor == nil && count < 0 {
 		// get the lowest available ordinal index (top transaction)
 		list.First, err = db.findBorderOrdinalIndex(col,
-			bson.D{},
+			filter,
 			options.FindOne().SetSort(bson.D{{fiTransactionOrdinalIndex, 1}}))
 		list.IsEnd = true
 
@@ -272,6 +459,12 @@ func (db *MongoDbBridge) initTrxList(col *mongo.Collection, cursor *string, coun
 			options.FindOne())
 	}
 
+	// no transaction matches the filter
+	if err == mongo.ErrNoDocuments && cursor == nil {
+		list.IsStart, list.IsEnd = true, true
+		return &list, nil
+	}
+
 	// check the error
 	if err != nil {
 		db.log.Errorf("can not find the initial transactions")
@@ -306,26 +499,31 @@ func (db *MongoDbBridge) findBorderOrdinalIndex(col *mongo.Collection, filter bs
 }
 
 // txListFilter creates a filter for transaction list search.
-func (db *MongoDbBridge) txListFilter(cursor *string, count int32, list *types.TransactionHashList) *bson.D {
+func (db *MongoDbBridge) txListFilter(cursor *string, count int32, list *types.TransactionHashList, base bson.D) *bson.D {
 	// inform what we are about to do
 	db.log.Debugf("transaction filter starts from index %d", list.First)
 
 	// build the filter query
-	var filter bson.D
+	var op string
 	if cursor == nil {
 		if count > 0 {
-			filter = bson.D{{fiTransactionOrdinalIndex, bson.D{{"$lte", list.First}}}}
+			op = "$lte"
 		} else {
-			filter = bson.D{{fiTransactionOrdinalIndex, bson.D{{"$gte", list.First}}}}
+			op = "$gte"
 		}
 	} else {
 		if count > 0 {
-			filter = bson.D{{fiTransactionOrdinalIndex, bson.D{{"$lt", list.First}}}}
+			op = "$lt"
 		} else {
-			filter = bson.D{{fiTransactionOrdinalIndex, bson.D{{"$gt", list.First}}}}
+			op = "$gt"
 		}
 	}
 
+	// add the ordinal index condition to the base filter
+	filter := make(bson.D, len(base), len(base)+1)
+	copy(filter, base)
+	filter = append(filter, bson.E{Key: fiTransactionOrdinalIndex, Value: bson.D{{op, list.First}}})
+
 	return &filter
 }
 
@@ -360,12 +558,12 @@ func (db *MongoDbBridge) txListOptions(count int32) *options.FindOptions {
 }
 
 // txListLoad load the initialized list from database
-func (db *MongoDbBridge) txListLoad(col *mongo.Collection, cursor *string, count int32, list *types.TransactionHashList) error {
+func (db *MongoDbBridge) txListLoad(col *mongo.Collection, cursor *string, count int32, list *types.TransactionHashList, filter bson.D) error {
 	// get the context for loader
 	ctx := context.Background()
 
 	// load the data
-	ld, err := col.Find(ctx, db.txListFilter(cursor, count, list), db.txListOptions(count))
+	ld, err := col.Find(ctx, db.txListFilter(cursor, count, list, filter), db.txListOptions(count))
 	if err != nil {
 		db.log.Errorf("error loading transactions list; %s", err.Error())
 		return err
@@ -380,13 +578,8 @@ func (db *MongoDbBridge) txListLoad(col *mongo.Collection, cursor *string, count
 	}()
 
 	// loop and load
-	var hash *types.Hash
+	hashes := make([]*types.Hash, 0)
 	for ld.Next(ctx) {
-		// process the last found hash
-		if hash != nil {
-			list.Collection = append(list.Collection, hash)
-		}
-
 		// get the next hash
 		var row struct {
 			Id  string `bson:"_id"`
@@ -401,21 +594,32 @@ func (db *MongoDbBridge) txListLoad(col *mongo.Collection, cursor *string, count
 
 		// decode the value
 		h := types.HexToHash(row.Id)
-		hash = &h
+		hashes = append(hashes, &h)
 	}
 
-	// we should have all the items already; we may just need to check if a boundary was reached
-	if cursor != nil {
-		list.IsEnd = count > 0 && int32(len(list.Collection)) < count
-		list.IsStart = count < 0 && int32(len(list.Collection)) < -count
+	txListTrim(list, hashes, count)
+	return nil
+}
 
-		// add the last item as well
-		if (list.IsStart || list.IsEnd) && hash != nil {
-			list.Collection = append(list.Collection, hash)
-		}
+// txListTrim adds the loaded hashes to the list trimmed to the requested count.
+// The loader pulls one more row than requested; if it's not there, the boundary
+// of the list in the direction of the loading has been reached.
+func txListTrim(list *types.TransactionHashList, hashes []*types.Hash, count int32) {
+	// get the absolute limit
+	limit := int(count)
+	if limit < 0 {
+		limit = -limit
 	}
 
-	return nil
+	// check the boundary, or trim the extra row
+	if len(hashes) <= limit {
+		list.IsEnd = list.IsEnd || count > 0
+		list.IsStart = list.IsStart || count < 0
+	} else {
+		hashes = hashes[:limit]
+	}
+
+	list.Collection = append(list.Collection, hashes...)
 }
 
 // TransactionsCount returns the number of transactions stored in the database.
@@ -435,8 +639,39 @@ func (db *MongoDbBridge) TransactionsCount() (uint64, error) {
 	return uint64(total), nil
 }
 
+// TransactionsFilteredCount returns the number of transactions matching the given filter.
+func (db *MongoDbBridge) TransactionsFilteredCount(tf *types.TransactionFilter) (uint64, error) {
+	// build the filter
+	filter, err := txFilterQuery(tf)
+	if err != nil {
+		return 0, err
+	}
+
+	// get the collection and count
+	col := db.client.Database(db.dbName).Collection(coTransactions)
+	total, err := col.CountDocuments(context.Background(), filter)
+	if err != nil {
+		db.log.Errorf("can not count filtered transactions; %s", err.Error())
+		return 0, err
+	}
+	return uint64(total), nil
+}
+
 // Transactions pulls list of transaction hashes starting on the specified cursor.
-func (db *MongoDbBridge) Transactions(cursor *string, count int32) (*types.TransactionHashList, error) {
+// The list is limited to transactions matching the optional filter.
+func (db *MongoDbBridge) Transactions(cursor *string, count int32, tf *types.TransactionFilter) (*types.TransactionHashList, error) {
+	// build the filter
+	filter, err := txFilterQuery(tf)
+	if err != nil {
+		return nil, err
+	}
+
+	return db.filteredTransactions(cursor, count, filter)
+}
+
+// filteredTransactions pulls list of hashes of transactions matching the given filter
+// starting on the specified cursor.
+func (db *MongoDbBridge) filteredTransactions(cursor *string, count int32, filter bson.D) (*types.TransactionHashList, error) {
 	// nothing to load?
 	if count == 0 {
 		return nil, fmt.Errorf("nothing to do, zero transactions requested")
@@ -446,14 +681,14 @@ func (db *MongoDbBridge) Transactions(cursor *string, count int32) (*types.Trans
 	col := db.client.Database(db.dbName).Collection(coTransactions)
 
 	// init the list
-	list, err := db.initTrxList(col, cursor, count)
+	list, err := db.initTrxList(col, cursor, count, filter)
 	if err != nil {
 		db.log.Errorf("can not build transactions list; %s", err.Error())
 		return nil, err
 	}
 
 	// load data
-	err = db.txListLoad(col, cursor, count, list)
+	err = db.txListLoad(col, cursor, count, list, filter)
 	if err != nil {
 		db.log.Errorf("can not load transactions list from database; %s", err.Error())
 		return nil, err
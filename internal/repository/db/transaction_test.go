@@ -0,0 +1,62 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"fantom-api-graphql/internal/types"
+	"testing"
+)
+
+// testHashes creates a list of n distinct transaction hashes.
+func testHashes(n int) []*types.Hash {
+	list := make([]*types.Hash, n)
+	for i := range list {
+		h := types.Hash{byte(i + 1)}
+		list[i] = &h
+	}
+	return list
+}
+
+func TestTxListTrim(t *testing.T) {
+	tests := []struct {
+		name      string
+		loaded    int
+		count     int32
+		isStart   bool
+		wantLen   int
+		wantStart bool
+		wantEnd   bool
+	}{
+		{name: "first page single match", loaded: 1, count: 25, isStart: true, wantLen: 1, wantStart: true, wantEnd: true},
+		{name: "first page fewer matches", loaded: 7, count: 25, isStart: true, wantLen: 7, wantStart: true, wantEnd: true},
+		{name: "first page exact count", loaded: 25, count: 25, isStart: true, wantLen: 25, wantStart: true, wantEnd: true},
+		{name: "first page more matches", loaded: 26, count: 25, isStart: true, wantLen: 25, wantStart: true},
+		{name: "next page more matches", loaded: 26, count: 25, wantLen: 25},
+		{name: "next page last matches", loaded: 3, count: 25, wantLen: 3, wantEnd: true},
+		{name: "next page no match", loaded: 0, count: 25, wantLen: 0, wantEnd: true},
+		{name: "previous page more matches", loaded: 11, count: -10, wantLen: 10},
+		{name: "previous page fewer matches", loaded: 4, count: -10, wantLen: 4, wantStart: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := types.TransactionHashList{Collection: make([]*types.Hash, 0), IsStart: tt.isStart}
+			hashes := testHashes(tt.loaded)
+			txListTrim(&list, hashes, tt.count)
+
+			if len(list.Collection) != tt.wantLen {
+				t.Fatalf("expected %d hashes, got %d", tt.wantLen, len(list.Collection))
+			}
+			for i, h := range list.Collection {
+				if h != hashes[i] {
+					t.Errorf("unexpected hash at %d", i)
+				}
+			}
+			if list.IsStart != tt.wantStart {
+				t.Errorf("expected IsStart %t, got %t", tt.wantStart, list.IsStart)
+			}
+			if list.IsEnd != tt.wantEnd {
+				t.Errorf("expected IsEnd %t, got %t", tt.wantEnd, list.IsEnd)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"context"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// coUniswapActions is the name of the off-chain database collection
+	// storing swaps and liquidity changes of Uniswap pairs.
+	coUniswapActions = "uniswap_action"
+
+	// fiUniswapActionPk is the name of the primary key field of the action collection.
+	fiUniswapActionPk = "_id"
+
+	// fiUniswapActionPair is the name of the pair address field.
+	// db.uniswap_action.createIndex({pair:1,ts:-1})
+	fiUniswapActionPair = "pair"
+
+	// fiUniswapActionAccount is the name of the transaction sender field.
+	// db.uniswap_action.createIndex({acc:1,ts:-1})
+	fiUniswapActionAccount = "acc"
+
+	// fiUniswapActionType is the name of the action type field.
+	fiUniswapActionType = "typ"
+
+	// fiUniswapActionTimestamp is the name of the action time stamp field.
+	fiUniswapActionTimestamp = "ts"
+
+	// fiUniswapActionLogIndex is the name of the action event log index field.
+	fiUniswapActionLogIndex = "lix"
+
+	// uniswapActionsMaxList is the max number of Uniswap actions returned in a list.
+	uniswapActionsMaxList = 100
+)
+
+// uniswapActionRow defines a row in the Uniswap actions collection.
+type uniswapActionRow struct {
+	Id          string  `bson:"_id"`
+	Type        string  `bson:"typ"`
+	Pair        string  `bson:"pair"`
+	Account     string  `bson:"acc"`
+	Sender      string  `bson:"snd"`
+	Recipient   *string `bson:"rcp,omitempty"`
+	Amount0In   string  `bson:"a0i"`
+	Amount1In   string  `bson:"a1i"`
+	Amount0Out  string  `bson:"a0o"`
+	Amount1Out  string  `bson:"a1o"`
+	Reserve0    string  `bson:"r0"`
+	Reserve1    string  `bson:"r1"`
+	Transaction string  `bson:"tx"`
+	LogIndex    uint64  `bson:"lix"`
+	TimeStamp   uint64  `bson:"ts"`
+}
+
+// AddUniswapAction stores the Uniswap pair action in the persistent storage.
+// Existing actions are replaced so re-scanning the chain is safe; the returned
+// flag signals the action was not known before.
+func (db *MongoDbBridge) AddUniswapAction(act *types.UniswapAction) (bool, error) {
+	// do we have the action?
+	if act == nil {
+		return false, fmt.Errorf("can not add empty uniswap action")
+	}
+
+	// get the collection for actions
+	col := db.client.Database(db.dbName).Collection(coUniswapActions)
+
+	// the action is identified by the transaction and the event log index
+	id := fmt.Sprintf("%s-%d", act.TransactionHash.String(), uint64(act.LogIndex))
+
+	// make the row
+	row := uniswapActionRow{
+		Id:          id,
+		Type:        act.Type,
+		Pair:        act.Pair.String(),
+		Account:     act.Account.String(),
+		Sender:      act.Sender.String(),
+		Amount0In:   act.Amount0In.String(),
+		Amount1In:   act.Amount1In.String(),
+		Amount0Out:  act.Amount0Out.String(),
+		Amount1Out:  act.Amount1Out.String(),
+		Reserve0:    act.Reserve0.String(),
+		Reserve1:    act.Reserve1.String(),
+		Transaction: act.TransactionHash.String(),
+		LogIndex:    uint64(act.LogIndex),
+		TimeStamp:   uint64(act.TimeStamp),
+	}
+
+	// the recipient is optional
+	if act.Recipient != nil {
+		rcp := act.Recipient.String()
+		row.Recipient = &rcp
+	}
+
+	// do the upsert
+	res, err := col.ReplaceOne(context.Background(),
+		bson.D{{fiUniswapActionPk, id}},
+		row,
+		options.Replace().SetUpsert(true))
+	if err != nil {
+		db.log.Errorf("can not store uniswap action; %s", err.Error())
+		return false, err
+	}
+
+	db.log.Debugf("uniswap %s on pair %s added", act.Type, act.Pair.String())
+	return res.UpsertedCount > 0, nil
+}
+
+// UniswapActions loads the list of Uniswap actions sorted from the newest
+// to the oldest. The list can be filtered by the pair, the account sending
+// the transaction and the type of the action.
+func (db *MongoDbBridge) UniswapActions(pair *common.Address, account *common.Address, actionType *string, count int32) ([]types.UniswapAction, error) {
+	// get the collection for actions
+	col := db.client.Database(db.dbName).Collection(coUniswapActions)
+
+	// build the filter
+	filter := bson.D{}
+	if pair != nil {
+		filter = append(filter, bson.E{Key: fiUniswapActionPair, Value: pair.String()})
+	}
+	if account != nil {
+		filter = append(filter, bson.E{Key: fiUniswapActionAccount, Value: account.String()})
+	}
+	if actionType != nil {
+		filter = append(filter, bson.E{Key: fiUniswapActionType, Value: *actionType})
+	}
+
+	// limit the size of the list
+	if count <= 0 || count > uniswapActionsMaxList {
+		count = uniswapActionsMaxList
+	}
+
+	// load the actions
+	ctx := context.Background()
+	cursor, err := col.Find(ctx, filter, options.Find().
+		SetSort(bson.D{{fiUniswapActionTimestamp, -1}, {fiUniswapActionLogIndex, -1}}).
+		SetLimit(int64(count)))
+	if err != nil {
+		db.log.Errorf("can not load uniswap actions; %s", err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			db.log.Errorf("error closing uniswap actions cursor; %s", err.Error())
+		}
+	}()
+
+	// decode the actions
+	list := make([]types.UniswapAction, 0)
+	for cursor.Next(ctx) {
+		var row uniswapActionRow
+		if err := cursor.Decode(&row); err != nil {
+			db.log.Errorf("can not decode uniswap action; %s", err.Error())
+			return nil, err
+		}
+
+		act, err := row.action()
+		if err != nil {
+			db.log.Errorf("invalid uniswap action %s; %s", row.Id, err.Error())
+			return nil, err
+		}
+
+		list = append(list, *act)
+	}
+
+	return list, nil
+}
+
+// action converts the database row into the Uniswap action.
+func (row *uniswapActionRow) action() (*types.UniswapAction, error) {
+	act := types.UniswapAction{
+		Type:            row.Type,
+		Pair:            common.HexToAddress(row.Pair),
+		Account:         common.HexToAddress(row.Account),
+		Sender:          common.HexToAddress(row.Sender),
+		TransactionHash: types.HexToHash(row.Transaction),
+		LogIndex:        hexutil.Uint64(row.LogIndex),
+		TimeStamp:       hexutil.Uint64(row.TimeStamp),
+	}
+
+	// the recipient is optional
+	if row.Recipient != nil {
+		rcp := common.HexToAddress(*row.Recipient)
+		act.Recipient = &rcp
+	}
+
+	// decode the amounts
+	amounts := []struct {
+		val string
+		dst *hexutil.Big
+	}{
+		{row.Amount0In, &act.Amount0In},
+		{row.Amount1In, &act.Amount1In},
+		{row.Amount0Out, &act.Amount0Out},
+		{row.Amount1Out, &act.Amount1Out},
+		{row.Reserve0, &act.Reserve0},
+		{row.Reserve1, &act.Reserve1},
+	}
+	for _, am := range amounts {
+		val, err := hexutil.DecodeBig(am.val)
+		if err != nil {
+			return nil, err
+		}
+		*am.dst = hexutil.Big(*val)
+	}
+
+	return &act, nil
+}
@@ -0,0 +1,319 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"context"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"math/big"
+	"strconv"
+)
+
+const (
+	// coUniswapCandles is the name of the off-chain database collection
+	// storing OHLCV candles of Uniswap pairs.
+	coUniswapCandles = "uniswap_candle"
+
+	// fiUniswapCandlePk is the name of the primary key field of the candle collection.
+	fiUniswapCandlePk = "_id"
+
+	// fiUniswapCandlePair is the name of the pair address field.
+	// db.uniswap_candle.createIndex({pair:1,res:1,ts:1})
+	fiUniswapCandlePair = "pair"
+
+	// fiUniswapCandleResolution is the name of the candle resolution field.
+	fiUniswapCandleResolution = "res"
+
+	// fiUniswapCandleTimestamp is the name of the candle bucket start field.
+	fiUniswapCandleTimestamp = "ts"
+
+	// fiUniswapCandleOpen is the name of the open price field.
+	fiUniswapCandleOpen = "o"
+
+	// fiUniswapCandleHigh is the name of the high price field.
+	fiUniswapCandleHigh = "h"
+
+	// fiUniswapCandleLow is the name of the low price field.
+	fiUniswapCandleLow = "l"
+
+	// fiUniswapCandleClose is the name of the close price field.
+	fiUniswapCandleClose = "c"
+
+	// fiUniswapCandleVolume0 is the name of the first token volume field.
+	fiUniswapCandleVolume0 = "v0"
+
+	// fiUniswapCandleVolume1 is the name of the second token volume field.
+	fiUniswapCandleVolume1 = "v1"
+
+	// fiUniswapCandleSwaps is the name of the number of swaps field.
+	fiUniswapCandleSwaps = "cnt"
+
+	// uniswapCandlesMaxList is the max number of candles returned in a list.
+	uniswapCandlesMaxList = 1000
+)
+
+// uniswapCandleRow defines a row in the Uniswap candles collection.
+type uniswapCandleRow struct {
+	Pair       string               `bson:"pair"`
+	Resolution int64                `bson:"res"`
+	TimeStamp  uint64               `bson:"ts"`
+	Open       uniswapCandlePrice   `bson:"o"`
+	High       primitive.Decimal128 `bson:"h"`
+	Low        primitive.Decimal128 `bson:"l"`
+	Close      uniswapCandlePrice   `bson:"c"`
+	Volume0    primitive.Decimal128 `bson:"v0"`
+	Volume1    primitive.Decimal128 `bson:"v1"`
+	Swaps      int32                `bson:"cnt"`
+}
+
+// uniswapCandlePrice defines the price of a swap in the open and close fields of a candle.
+// The documents are compared field by field, so $min and $max on the whole document
+// keep the price of the first and the last swap regardless of the order the swaps are added in.
+type uniswapCandlePrice struct {
+	OrdinalIndex uint64               `bson:"orx"`
+	LogIndex     uint64               `bson:"lix"`
+	Price        primitive.Decimal128 `bson:"p"`
+}
+
+// uniswapVolumeRow defines the aggregated volume of Uniswap candles.
+type uniswapVolumeRow struct {
+	Volume0 primitive.Decimal128 `bson:"v0"`
+	Volume1 primitive.Decimal128 `bson:"v1"`
+	Swaps   int32                `bson:"cnt"`
+}
+
+// AddUniswapSwapToCandles aggregates the given swap with the given price
+// into the candles of all the supported resolutions.
+func (db *MongoDbBridge) AddUniswapSwapToCandles(act *types.UniswapAction, price *big.Float) error {
+	// get the collection for candles
+	col := db.client.Database(db.dbName).Collection(coUniswapCandles)
+
+	// calculate the swapped volumes
+	vol0, err := swapVolume(&act.Amount0In, &act.Amount0Out)
+	if err != nil {
+		return err
+	}
+	vol1, err := swapVolume(&act.Amount1In, &act.Amount1Out)
+	if err != nil {
+		return err
+	}
+
+	// encode the price
+	dec, err := priceDecimal(price)
+	if err != nil {
+		return err
+	}
+	swp := uniswapCandlePrice{OrdinalIndex: act.OrdinalIndex, LogIndex: uint64(act.LogIndex), Price: dec}
+
+	// update candles of all resolutions
+	for _, res := range types.UniswapCandleResolutions {
+		// calculate the time bucket of the swap
+		ts := uint64(act.TimeStamp) - uint64(act.TimeStamp)%uint64(res)
+		id := fmt.Sprintf("%s-%d-%d", act.Pair.String(), res, ts)
+
+		// do the upsert
+		_, err := col.UpdateOne(context.Background(),
+			bson.D{{fiUniswapCandlePk, id}},
+			bson.D{
+				{"$setOnInsert", bson.D{
+					{fiUniswapCandlePair, act.Pair.String()},
+					{fiUniswapCandleResolution, res},
+					{fiUniswapCandleTimestamp, ts},
+				}},
+				{"$max", bson.D{
+					{fiUniswapCandleHigh, dec},
+					{fiUniswapCandleClose, swp},
+				}},
+				{"$min", bson.D{
+					{fiUniswapCandleLow, dec},
+					{fiUniswapCandleOpen, swp},
+				}},
+				{"$inc", bson.D{
+					{fiUniswapCandleVolume0, vol0},
+					{fiUniswapCandleVolume1, vol1},
+					{fiUniswapCandleSwaps, 1},
+				}},
+			},
+			options.Update().SetUpsert(true))
+		if err != nil {
+			db.log.Errorf("can not update uniswap candle %s; %s", id, err.Error())
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UniswapCandles loads the list of candles of the given Uniswap pair
+// and resolution in the given time range sorted from the oldest.
+func (db *MongoDbBridge) UniswapCandles(pair *common.Address, res int64, from uint64, to uint64) ([]types.UniswapCandle, error) {
+	// get the collection for candles
+	col := db.client.Database(db.dbName).Collection(coUniswapCandles)
+
+	// load the candles
+	ctx := context.Background()
+	cursor, err := col.Find(ctx,
+		bson.D{
+			{fiUniswapCandlePair, pair.String()},
+			{fiUniswapCandleResolution, res},
+			{fiUniswapCandleTimestamp, bson.D{{"$gte", from - from%uint64(res)}, {"$lte", to}}},
+		},
+		options.Find().SetSort(bson.D{{fiUniswapCandleTimestamp, 1}}).SetLimit(uniswapCandlesMaxList))
+	if err != nil {
+		db.log.Errorf("can not load uniswap candles of %s; %s", pair.String(), err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			db.log.Errorf("error closing uniswap candles cursor; %s", err.Error())
+		}
+	}()
+
+	// decode the candles
+	list := make([]types.UniswapCandle, 0)
+	for cursor.Next(ctx) {
+		var row uniswapCandleRow
+		if err := cursor.Decode(&row); err != nil {
+			db.log.Errorf("can not decode uniswap candle; %s", err.Error())
+			return nil, err
+		}
+
+		// decode the volumes
+		vol0, err := decimalToBig(row.Volume0)
+		if err != nil {
+			return nil, err
+		}
+		vol1, err := decimalToBig(row.Volume1)
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, types.UniswapCandle{
+			Pair:       common.HexToAddress(row.Pair),
+			Resolution: types.UniswapCandleResolutionName(row.Resolution),
+			Time:       hexutil.Uint64(row.TimeStamp),
+			Open:       decimalToFloat(row.Open.Price),
+			High:       decimalToFloat(row.High),
+			Low:        decimalToFloat(row.Low),
+			Close:      decimalToFloat(row.Close.Price),
+			Volume0:    hexutil.Big(*vol0),
+			Volume1:    hexutil.Big(*vol1),
+			Swaps:      row.Swaps,
+		})
+	}
+
+	return list, nil
+}
+
+// UniswapVolume aggregates the trading volume of the given Uniswap pair
+// from candles of the given resolution starting at the given time.
+func (db *MongoDbBridge) UniswapVolume(pair *common.Address, res int64, from uint64) (*types.UniswapVolume, error) {
+	// get the collection for candles
+	col := db.client.Database(db.dbName).Collection(coUniswapCandles)
+
+	// aggregate the candles
+	ctx := context.Background()
+	cursor, err := col.Aggregate(ctx, bson.A{
+		bson.D{{"$match", bson.D{
+			{fiUniswapCandlePair, pair.String()},
+			{fiUniswapCandleResolution, res},
+			{fiUniswapCandleTimestamp, bson.D{{"$gte", from}}},
+		}}},
+		bson.D{{"$group", bson.D{
+			{"_id", nil},
+			{fiUniswapCandleVolume0, bson.D{{"$sum", "$" + fiUniswapCandleVolume0}}},
+			{fiUniswapCandleVolume1, bson.D{{"$sum", "$" + fiUniswapCandleVolume1}}},
+			{fiUniswapCandleSwaps, bson.D{{"$sum", "$" + fiUniswapCandleSwaps}}},
+		}}},
+	})
+	if err != nil {
+		db.log.Errorf("can not aggregate uniswap volume of %s; %s", pair.String(), err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			db.log.Errorf("error closing uniswap volume cursor; %s", err.Error())
+		}
+	}()
+
+	// no candles, no volume
+	if !cursor.Next(ctx) {
+		return &types.UniswapVolume{}, nil
+	}
+
+	// decode the aggregation
+	var row uniswapVolumeRow
+	if err := cursor.Decode(&row); err != nil {
+		db.log.Errorf("can not decode uniswap volume; %s", err.Error())
+		return nil, err
+	}
+
+	vol0, err := decimalToBig(row.Volume0)
+	if err != nil {
+		return nil, err
+	}
+	vol1, err := decimalToBig(row.Volume1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.UniswapVolume{
+		Volume0: hexutil.Big(*vol0),
+		Volume1: hexutil.Big(*vol1),
+		Swaps:   row.Swaps,
+	}, nil
+}
+
+// swapVolume calculates the volume of a token swapped as the sum of the input
+// and the output amounts encoded for the database aggregation.
+func swapVolume(in *hexutil.Big, out *hexutil.Big) (primitive.Decimal128, error) {
+	val := new(big.Int).Add(in.ToInt(), out.ToInt())
+	dec, ok := primitive.ParseDecimal128FromBigInt(val, 0)
+	if !ok {
+		return primitive.Decimal128{}, fmt.Errorf("swap volume %s out of range", val.String())
+	}
+	return dec, nil
+}
+
+// priceDecimal encodes the price into a database decimal value
+// keeping the 34 significant digits of the decimal format.
+func priceDecimal(price *big.Float) (primitive.Decimal128, error) {
+	dec, err := primitive.ParseDecimal128(price.Text('e', 33))
+	if err != nil {
+		return primitive.Decimal128{}, fmt.Errorf("swap price %s out of range; %s", price.String(), err.Error())
+	}
+	return dec, nil
+}
+
+// decimalToFloat converts the database decimal price into a float
+// value provided to the API clients.
+func decimalToFloat(dec primitive.Decimal128) float64 {
+	val, _ := strconv.ParseFloat(dec.String(), 64)
+	return val
+}
+
+// decimalToBig converts the aggregated database decimal value into a big integer.
+func decimalToBig(dec primitive.Decimal128) (*big.Int, error) {
+	val, exp, err := dec.BigInt()
+	if err != nil {
+		return nil, err
+	}
+
+	// apply the exponent
+	if exp > 0 {
+		val.Mul(val, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil))
+	} else if exp < 0 {
+		val.Quo(val, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(-exp)), nil))
+	}
+
+	return val, nil
+}
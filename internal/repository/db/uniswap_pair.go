@@ -0,0 +1,480 @@
+// Package db implements bridge to persistent storage represented by Mongo database.
+package db
+
+import (
+	"context"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"strconv"
+)
+
+const (
+	// coUniswapPairs is the name of the off-chain database collection
+	// storing the pairs of the Uniswap exchange and their state.
+	coUniswapPairs = "uniswap_pair"
+
+	// fiUniswapPairPk is the name of the primary key field of the pair collection,
+	// the address of the pair contract.
+	fiUniswapPairPk = "_id"
+
+	// fiUniswapPairIndex is the name of the field of the pair index in the factory list.
+	// db.uniswap_pair.createIndex({ix:1})
+	fiUniswapPairIndex = "ix"
+
+	// fiUniswapPairReserve0 is the name of the first token reserve field.
+	fiUniswapPairReserve0 = "r0"
+
+	// fiUniswapPairReserve1 is the name of the second token reserve field.
+	fiUniswapPairReserve1 = "r1"
+
+	// fiUniswapPairReservesTimeStamp is the name of the reserves time stamp field.
+	fiUniswapPairReservesTimeStamp = "rts"
+
+	// fiUniswapPairReservesBlock is the name of the field of the block the reserves were synced in.
+	fiUniswapPairReservesBlock = "rblk"
+
+	// fiUniswapPairTotalSupply is the name of the liquidity tokens total supply field.
+	fiUniswapPairTotalSupply = "sup"
+)
+
+// uniswapPairRow defines a row in the Uniswap pairs collection.
+type uniswapPairRow struct {
+	Address           string `bson:"_id"`
+	Index             uint64 `bson:"ix"`
+	Token0            string `bson:"t0"`
+	Token1            string `bson:"t1"`
+	Reserve0          string `bson:"r0"`
+	Reserve1          string `bson:"r1"`
+	ReservesTimeStamp uint64 `bson:"rts"`
+	ReservesBlock     uint64 `bson:"rblk"`
+	TotalSupply       string `bson:"sup"`
+}
+
+// newUniswapPair creates a new Uniswap pair from the database row.
+func newUniswapPair(row *uniswapPairRow) *types.UniswapPair {
+	return &types.UniswapPair{
+		Address:           common.HexToAddress(row.Address),
+		Index:             row.Index,
+		Token0:            common.HexToAddress(row.Token0),
+		Token1:            common.HexToAddress(row.Token1),
+		Reserve0:          hexutil.Big(*hexutil.MustDecodeBig(row.Reserve0)),
+		Reserve1:          hexutil.Big(*hexutil.MustDecodeBig(row.Reserve1)),
+		ReservesTimeStamp: hexutil.Uint64(row.ReservesTimeStamp),
+		TotalSupply:       hexutil.Big(*hexutil.MustDecodeBig(row.TotalSupply)),
+	}
+}
+
+// AddUniswapPair stores the Uniswap pair in the persistent storage. The state
+// of the pair is valid as of the given block. Pairs already known are not updated,
+// their state is maintained by the reserves sync.
+func (db *MongoDbBridge) AddUniswapPair(pair *types.UniswapPair, block uint64) error {
+	// do we have the pair?
+	if pair == nil {
+		return fmt.Errorf("can not add empty uniswap pair")
+	}
+
+	// get the collection for pairs
+	col := db.client.Database(db.dbName).Collection(coUniswapPairs)
+
+	// insert the pair if not known yet
+	_, err := col.UpdateOne(context.Background(),
+		bson.D{{fiUniswapPairPk, pair.Address.String()}},
+		bson.D{{"$setOnInsert", uniswapPairRow{
+			Address:           pair.Address.String(),
+			Index:             pair.Index,
+			Token0:            pair.Token0.String(),
+			Token1:            pair.Token1.String(),
+			Reserve0:          pair.Reserve0.String(),
+			Reserve1:          pair.Reserve1.String(),
+			ReservesTimeStamp: uint64(pair.ReservesTimeStamp),
+			ReservesBlock:     block,
+			TotalSupply:       pair.TotalSupply.String(),
+		}}},
+		options.Update().SetUpsert(true))
+	if err != nil {
+		db.log.Errorf("can not store uniswap pair %s; %s", pair.Address.String(), err.Error())
+		return err
+	}
+
+	db.log.Debugf("uniswap pair %s added", pair.Address.String())
+	return nil
+}
+
+// UpdateUniswapReserves updates the reserves of the Uniswap pair synced in the given block.
+// Reserves synced in an older block than the stored ones are ignored, so re-scanning
+// the chain does not roll the state back.
+func (db *MongoDbBridge) UpdateUniswapReserves(sync *types.UniswapSync, block uint64, ts uint64) error {
+	// get the collection for pairs
+	col := db.client.Database(db.dbName).Collection(coUniswapPairs)
+
+	// update the reserves
+	_, err := col.UpdateOne(context.Background(),
+		bson.D{
+			{fiUniswapPairPk, sync.Pair.String()},
+			{fiUniswapPairReservesBlock, bson.D{{"$lte", block}}},
+		},
+		bson.D{{"$set", bson.D{
+			{fiUniswapPairReserve0, sync.Reserve0.String()},
+			{fiUniswapPairReserve1, sync.Reserve1.String()},
+			{fiUniswapPairReservesTimeStamp, ts},
+			{fiUniswapPairReservesBlock, block},
+		}}})
+	if err != nil {
+		db.log.Errorf("can not update reserves of uniswap pair %s; %s", sync.Pair.String(), err.Error())
+		return err
+	}
+	return nil
+}
+
+// UpdateUniswapSupply updates the total supply of liquidity tokens of the Uniswap pair.
+func (db *MongoDbBridge) UpdateUniswapSupply(pair *common.Address, supply hexutil.Big) error {
+	// get the collection for pairs
+	col := db.client.Database(db.dbName).Collection(coUniswapPairs)
+
+	// update the supply
+	_, err := col.UpdateOne(context.Background(),
+		bson.D{{fiUniswapPairPk, pair.String()}},
+		bson.D{{"$set", bson.D{{fiUniswapPairTotalSupply, supply.String()}}}})
+	if err != nil {
+		db.log.Errorf("can not update supply of uniswap pair %s; %s", pair.String(), err.Error())
+		return err
+	}
+	return nil
+}
+
+// UniswapPair loads the Uniswap pair from the persistent storage; nil if not found.
+func (db *MongoDbBridge) UniswapPair(addr *common.Address) (*types.UniswapPair, error) {
+	// get the collection for pairs
+	col := db.client.Database(db.dbName).Collection(coUniswapPairs)
+
+	// try to find the pair
+	res := col.FindOne(context.Background(), bson.D{{fiUniswapPairPk, addr.String()}})
+	if res.Err() != nil {
+		// may be ErrNoDocuments, which we seek
+		if res.Err() == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+
+		db.log.Errorf("can not get uniswap pair %s; %s", addr.String(), res.Err().Error())
+		return nil, res.Err()
+	}
+
+	// decode the row
+	var row uniswapPairRow
+	if err := res.Decode(&row); err != nil {
+		db.log.Errorf("can not decode uniswap pair %s; %s", addr.String(), err.Error())
+		return nil, err
+	}
+
+	return newUniswapPair(&row), nil
+}
+
+// UniswapPairsKnown filters the given list of addresses to the set of known Uniswap pairs.
+func (db *MongoDbBridge) UniswapPairsKnown(addrs []common.Address) (map[common.Address]bool, error) {
+	// prep the result
+	known := make(map[common.Address]bool)
+	if 0 == len(addrs) {
+		return known, nil
+	}
+
+	// get the collection for pairs
+	col := db.client.Database(db.dbName).Collection(coUniswapPairs)
+	ctx := context.Background()
+
+	// make the list of ids
+	ids := make(bson.A, len(addrs))
+	for i := range addrs {
+		ids[i] = addrs[i].String()
+	}
+
+	// find the known ones
+	ld, err := col.Find(ctx, bson.D{{fiUniswapPairPk, bson.D{{"$in", ids}}}},
+		options.Find().SetProjection(bson.D{{fiUniswapPairPk, true}}))
+	if err != nil {
+		db.log.Errorf("can not check known uniswap pairs; %s", err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing uniswap pair cursor; %s", err.Error())
+		}
+	}()
+
+	// collect the addresses
+	for ld.Next(ctx) {
+		var row struct {
+			Address string `bson:"_id"`
+		}
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode uniswap pair address; %s", err.Error())
+			return nil, err
+		}
+		known[common.HexToAddress(row.Address)] = true
+	}
+
+	return known, nil
+}
+
+// UniswapPairIndexes loads the set of factory indexes of all the Uniswap pairs
+// stored in the persistent storage.
+func (db *MongoDbBridge) UniswapPairIndexes() (map[uint64]bool, error) {
+	// get the collection for pairs
+	col := db.client.Database(db.dbName).Collection(coUniswapPairs)
+	ctx := context.Background()
+
+	// load the indexes only
+	ld, err := col.Find(ctx, bson.D{}, options.Find().SetProjection(bson.D{{fiUniswapPairIndex, true}}))
+	if err != nil {
+		db.log.Errorf("can not load uniswap pair indexes; %s", err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing uniswap pair cursor; %s", err.Error())
+		}
+	}()
+
+	// collect the indexes
+	known := make(map[uint64]bool)
+	for ld.Next(ctx) {
+		var row struct {
+			Index uint64 `bson:"ix"`
+		}
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode uniswap pair index; %s", err.Error())
+			return nil, err
+		}
+		known[row.Index] = true
+	}
+
+	return known, nil
+}
+
+// UniswapPairAddresses loads the addresses of all the Uniswap pairs
+// stored in the persistent storage.
+func (db *MongoDbBridge) UniswapPairAddresses() ([]common.Address, error) {
+	// get the collection for pairs
+	col := db.client.Database(db.dbName).Collection(coUniswapPairs)
+	ctx := context.Background()
+
+	// load the addresses only
+	ld, err := col.Find(ctx, bson.D{}, options.Find().
+		SetSort(bson.D{{fiUniswapPairIndex, 1}}).
+		SetProjection(bson.D{{fiUniswapPairPk, true}}))
+	if err != nil {
+		db.log.Errorf("can not load uniswap pair addresses; %s", err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing uniswap pair cursor; %s", err.Error())
+		}
+	}()
+
+	// collect the addresses
+	list := make([]common.Address, 0)
+	for ld.Next(ctx) {
+		var row struct {
+			Address string `bson:"_id"`
+		}
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode uniswap pair address; %s", err.Error())
+			return nil, err
+		}
+		list = append(list, common.HexToAddress(row.Address))
+	}
+
+	return list, nil
+}
+
+// uniswapPairListInit initializes the list of Uniswap pairs based on provided cursor and count.
+func (db *MongoDbBridge) uniswapPairListInit(col *mongo.Collection, cursor *string, count int32) (*types.UniswapPairList, error) {
+	// make the list
+	list := types.UniswapPairList{Collection: make([]*types.UniswapPair, 0)}
+
+	// find how many pairs do we have in the database
+	total, err := col.CountDocuments(context.Background(), bson.D{})
+	if err != nil {
+		db.log.Errorf("can not count uniswap pairs; %s", err.Error())
+		return nil, err
+	}
+	list.Total = uint64(total)
+
+	// the cursor is the index of the pair
+	if cursor != nil {
+		list.First, err = strconv.ParseUint(*cursor, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor value; %s", err.Error())
+		}
+		return &list, nil
+	}
+
+	// no cursor; start at the top (the newest pair), or at the bottom of the collection
+	if count > 0 {
+		list.First, err = db.lastUniswapPairIndex(col)
+		list.IsStart = true
+	} else {
+		list.First = 0
+		list.IsEnd = true
+	}
+
+	return &list, err
+}
+
+// lastUniswapPairIndex returns the highest index of a Uniswap pair stored in the database.
+func (db *MongoDbBridge) lastUniswapPairIndex(col *mongo.Collection) (uint64, error) {
+	// find the top pair
+	res := col.FindOne(context.Background(), bson.D{}, options.FindOne().
+		SetSort(bson.D{{fiUniswapPairIndex, -1}}).
+		SetProjection(bson.D{{fiUniswapPairIndex, true}}))
+	if res.Err() != nil {
+		// may be no pair at all
+		if res.Err() == mongo.ErrNoDocuments {
+			return 0, nil
+		}
+
+		db.log.Errorf("can not get the top uniswap pair; %s", res.Err().Error())
+		return 0, res.Err()
+	}
+
+	// get the actual value
+	var row struct {
+		Index uint64 `bson:"ix"`
+	}
+	if err := res.Decode(&row); err != nil {
+		db.log.Errorf("can not decode the top uniswap pair; %s", err.Error())
+		return 0, err
+	}
+
+	return row.Index, nil
+}
+
+// uniswapPairListFilter creates a filter for Uniswap pair list search.
+func uniswapPairListFilter(cursor *string, count int32, list *types.UniswapPairList) bson.D {
+	// prep base operator
+	op := "$lte"
+
+	// no cursor and bottom up list
+	if cursor == nil && count < 0 {
+		op = "$gte"
+	}
+
+	// we have the cursor and we scan from top
+	if cursor != nil && count > 0 {
+		op = "$lt"
+	}
+
+	// we have the cursor and we scan from bottom
+	if cursor != nil && count < 0 {
+		op = "$gt"
+	}
+
+	return bson.D{{fiUniswapPairIndex, bson.D{{op, list.First}}}}
+}
+
+// uniswapPairListOptions creates a filter options set for Uniswap pair list search.
+func uniswapPairListOptions(count int32) *options.FindOptions {
+	// prep options
+	opt := options.Find()
+
+	// from high (new) to low (old) for positive count
+	if count > 0 {
+		opt.SetSort(bson.D{{fiUniswapPairIndex, -1}})
+	} else {
+		opt.SetSort(bson.D{{fiUniswapPairIndex, 1}})
+	}
+
+	// try to get one more to check the boundary
+	limit := int64(count)
+	if limit < 0 {
+		limit = -limit
+	}
+	opt.SetLimit(limit + 1)
+	return opt
+}
+
+// UniswapPairs provides list of Uniswap pairs stored in the persistent storage.
+func (db *MongoDbBridge) UniswapPairs(cursor *string, count int32) (*types.UniswapPairList, error) {
+	// nothing to load?
+	if count == 0 {
+		return nil, fmt.Errorf("nothing to do, zero uniswap pairs requested")
+	}
+
+	// get the collection and context
+	col := db.client.Database(db.dbName).Collection(coUniswapPairs)
+	ctx := context.Background()
+
+	// init the list
+	list, err := db.uniswapPairListInit(col, cursor, count)
+	if err != nil {
+		db.log.Errorf("can not build uniswap pair list; %s", err.Error())
+		return nil, err
+	}
+
+	// load the data
+	ld, err := col.Find(ctx, uniswapPairListFilter(cursor, count, list), uniswapPairListOptions(count))
+	if err != nil {
+		db.log.Errorf("error loading uniswap pair list; %s", err.Error())
+		return nil, err
+	}
+
+	// close the cursor as we leave
+	defer func() {
+		if err := ld.Close(ctx); err != nil {
+			db.log.Errorf("error closing uniswap pair list cursor; %s", err.Error())
+		}
+	}()
+
+	// the requested size of the list
+	size := int(count)
+	if size < 0 {
+		size = -size
+	}
+
+	// loop and load
+	hasMore := false
+	for ld.Next(ctx) {
+		// do we already have all we need?
+		if len(list.Collection) == size {
+			hasMore = true
+			break
+		}
+
+		// try to decode the next row
+		var row uniswapPairRow
+		if err := ld.Decode(&row); err != nil {
+			db.log.Errorf("can not decode the uniswap pair list row; %s", err.Error())
+			return nil, err
+		}
+		list.Collection = append(list.Collection, newUniswapPair(&row))
+	}
+
+	// update the list boundaries
+	if 0 < len(list.Collection) {
+		list.First = list.Collection[0].Index
+		list.Last = list.Collection[len(list.Collection)-1].Index
+	}
+	if count > 0 {
+		list.IsEnd = !hasMore
+	} else {
+		list.IsStart = !hasMore
+	}
+
+	// reverse on negative so newer pairs will be on top
+	if count < 0 {
+		list.Reverse()
+	}
+
+	return list, nil
+}
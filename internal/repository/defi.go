@@ -0,0 +1,107 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eth "github.com/ethereum/go-ethereum/core/types"
+)
+
+// DefiConfiguration resolves the current configuration of the fMint DeFi module.
+func (p *proxy) DefiConfiguration() (*types.DefiSettings, error) {
+	return p.rpc.DefiConfiguration()
+}
+
+// FMintAccount resolves the collateral and debt positions of the given account
+// in the fMint DeFi module.
+func (p *proxy) FMintAccount(owner *common.Address) (*types.FMintAccount, error) {
+	return p.rpc.FMintAccount(owner)
+}
+
+// DefiTokens resolves the list of tokens registered in the DeFi tokens registry.
+func (p *proxy) DefiTokens() ([]*types.DefiToken, error) {
+	// try the cache first
+	if list := p.cache.PullDefiTokens(); list != nil {
+		return list, nil
+	}
+
+	// load the list from the registry
+	list, err := p.rpc.DefiTokens()
+	if err != nil {
+		return nil, err
+	}
+
+	// store the list in cache for the next time
+	if err := p.cache.PushDefiTokens(list); err != nil {
+		p.log.Errorf("can not store DeFi tokens in cache; %s", err.Error())
+	}
+
+	return list, nil
+}
+
+// DefiTokenPrice resolves the current price of the given token
+// from the DeFi price oracle.
+func (p *proxy) DefiTokenPrice(token *common.Address) (hexutil.Big, error) {
+	// try the cache first
+	if val := p.cache.PullDefiTokenPrice(token); val != nil {
+		return *val, nil
+	}
+
+	// load the price from the oracle
+	val, err := p.rpc.DefiTokenPrice(token)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+
+	// store the price in cache for the next time
+	if err := p.cache.PushDefiTokenPrice(token, val); err != nil {
+		p.log.Errorf("can not store DeFi token price in cache; %s", err.Error())
+	}
+
+	return val, nil
+}
+
+// FMintRewardPool resolves the state of the fMint reward distribution pool.
+func (p *proxy) FMintRewardPool() (*types.FMintRewardPool, error) {
+	return p.rpc.FMintRewardPool()
+}
+
+// FMintRewards resolves the state of fMint rewards of the given account.
+func (p *proxy) FMintRewards(owner *common.Address) (*types.FMintRewards, error) {
+	return p.rpc.FMintRewards(owner)
+}
+
+// FMintRewardClaims resolves the list of fMint reward claims of the given account.
+func (p *proxy) FMintRewardClaims(owner *common.Address) ([]types.FMintRewardClaim, error) {
+	return p.db.FMintRewardClaims(owner)
+}
+
+// addFMintRewardClaims extracts fMint reward claims of the given transaction
+// from its event logs and stores them in the persistent storage.
+func (p *proxy) addFMintRewardClaims(block *types.Block, trx *types.Transaction, logs []*eth.Log) error {
+	// get the list of claims
+	list, err := p.rpc.FMintRewardClaims(trx, logs)
+	if err != nil {
+		// fMint may not be available on the network, don't block the scanner
+		p.log.Errorf("can not get reward claims of %s; %s", trx.Hash.String(), err.Error())
+		return nil
+	}
+
+	// store all of them
+	for i := range list {
+		list[i].TimeStamp = block.TimeStamp
+		if err := p.db.AddFMintRewardClaim(&list[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
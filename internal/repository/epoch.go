@@ -0,0 +1,113 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Epochs returns the list of sealed epochs stored in the repository.
+func (p *proxy) Epochs(cursor *string, count int32) (*types.EpochList, error) {
+	return p.db.Epochs(cursor, count)
+}
+
+// EpochValidators returns the list of validators of the given sealed epoch.
+func (p *proxy) EpochValidators(id hexutil.Uint64) ([]types.EpochValidator, error) {
+	// try the persistent storage first
+	list, err := p.db.EpochValidators(id)
+	if err != nil || list != nil {
+		return list, err
+	}
+
+	// the epoch is not known yet, go to the SFC contract
+	ep, err := p.rpc.Epoch(id)
+	if err != nil {
+		return nil, err
+	}
+	return p.rpc.EpochValidators(&ep)
+}
+
+// LastKnownEpoch returns the id of the latest epoch known to the repository.
+func (p *proxy) LastKnownEpoch() (uint64, error) {
+	return p.db.LastKnownEpoch()
+}
+
+// FirstKnownEpoch returns the id of the lowest epoch known to the repository.
+func (p *proxy) FirstKnownEpoch() (uint64, error) {
+	return p.db.FirstKnownEpoch()
+}
+
+// AddEpoch loads the sealed epoch with its validators from the SFC contract
+// and stores it in the repository. The validators downtime is available
+// for the latest sealed epoch only, since the SFC keeps the current state only.
+func (p *proxy) AddEpoch(id hexutil.Uint64, isLatest bool) error {
+	// get the epoch snapshot
+	ep, err := p.rpc.Epoch(id)
+	if err != nil {
+		p.log.Errorf("can not load epoch #%d; %s", uint64(id), err.Error())
+		return err
+	}
+
+	// get the validators
+	vals, err := p.rpc.EpochValidators(&ep)
+	if err != nil {
+		p.log.Errorf("can not load validators of epoch #%d; %s", uint64(id), err.Error())
+		return err
+	}
+
+	// add the validators downtime for the latest sealed epoch
+	if isLatest {
+		p.addEpochDowntime(id, vals)
+	}
+
+	return p.db.AddEpoch(&ep, vals)
+}
+
+// addEpochDowntime adds the current missed blocks and downtime of the validators
+// to the list of epoch validators. The values in the epoch are calculated from
+// the totals of the previous epoch, if known.
+func (p *proxy) addEpochDowntime(id hexutil.Uint64, vals []types.EpochValidator) {
+	// get the previous epoch validators to calculate the difference
+	prev := make(map[hexutil.Uint64]*types.EpochValidator)
+	if 0 < id {
+		pl, err := p.db.EpochValidators(id - 1)
+		if err != nil {
+			p.log.Errorf("can not load validators of epoch #%d; %s", uint64(id-1), err.Error())
+		}
+		for i := range pl {
+			prev[pl[i].StakerId] = &pl[i]
+		}
+	}
+
+	// update the validators
+	for i := range vals {
+		st, err := p.rpc.Staker(vals[i].StakerId)
+		if err != nil {
+			p.log.Errorf("can not load staker #%d; %s", uint64(vals[i].StakerId), err.Error())
+			continue
+		}
+
+		// keep the totals
+		missed, down := st.MissedBlocks, st.Downtime
+		vals[i].MissedBlocksTotal = &missed
+		vals[i].DowntimeTotal = &down
+
+		// calculate the epoch values if possible
+		pv, ok := prev[vals[i].StakerId]
+		if !ok || pv.MissedBlocksTotal == nil || pv.DowntimeTotal == nil {
+			continue
+		}
+		if *pv.MissedBlocksTotal <= missed && *pv.DowntimeTotal <= down {
+			em, ed := missed-*pv.MissedBlocksTotal, down-*pv.DowntimeTotal
+			vals[i].MissedBlocks = &em
+			vals[i].Downtime = &ed
+		}
+	}
+}
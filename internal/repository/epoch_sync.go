@@ -0,0 +1,154 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/logger"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"sync"
+	"time"
+)
+
+// epochSyncInterval represents the interval of checking for newly sealed epochs.
+const epochSyncInterval = 15 * time.Second
+
+// epochSyncBatch represents the max number of epochs stored in a single sync
+// so catching up after a downtime does not flood the node with RPC calls.
+const epochSyncBatch = 50
+
+// epochSyncer implements a service storing sealed epochs in the repository.
+type epochSyncer struct {
+	service
+}
+
+// newEpochSyncer creates a new epoch syncer service and starts it.
+func newEpochSyncer(repo Repository, log logger.Logger, wg *sync.WaitGroup) *epochSyncer {
+	// create new syncer
+	es := epochSyncer{
+		service: newService("epoch syncer", repo, log, wg),
+	}
+
+	// add self to the wait group and run the sync routine
+	wg.Add(1)
+	go es.run()
+
+	return &es
+}
+
+// run checks for newly sealed epochs periodically and stores them.
+func (es *epochSyncer) run() {
+	// don't forget to sign off after we are done
+	defer func() {
+		// log finish
+		es.log.Notice("epoch syncer done")
+		es.wg.Done()
+	}()
+
+	// inform about the action
+	es.log.Notice("epoch syncer is running")
+
+	// check the epochs on each tick
+	ticker := time.NewTicker(epochSyncInterval)
+	defer ticker.Stop()
+
+	for {
+		// sync what we have
+		es.sync()
+
+		select {
+		case <-es.sigStop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sync stores the sealed epochs not known to the repository yet. An empty repository
+// starts with the latest sealed epoch and the history is loaded backwards
+// down to the first epoch in subsequent syncs.
+func (es *epochSyncer) sync() {
+	// get the latest sealed epoch
+	sealed, err := es.repo.CurrentSealedEpoch()
+	if err != nil {
+		es.log.Errorf("can not get the latest sealed epoch; %s", err.Error())
+		return
+	}
+
+	// get the latest known epoch
+	last, err := es.repo.LastKnownEpoch()
+	if err != nil {
+		es.log.Errorf("can not get the latest known epoch; %s", err.Error())
+		return
+	}
+
+	// start with the latest sealed epoch on empty repository
+	from := hexutil.Uint64(last + 1)
+	if last == 0 && 0 < sealed.Id {
+		from = sealed.Id
+	}
+
+	// add the missing epochs, but not too many at once
+	to := sealed.Id
+	if from+epochSyncBatch <= to {
+		to = from + epochSyncBatch - 1
+	}
+
+	for id := from; id <= to; id++ {
+		// add the epoch
+		if !es.add(id, id == sealed.Id) {
+			return
+		}
+	}
+
+	// load the history below the lowest known epoch
+	es.history(sealed.Id)
+}
+
+// history stores the epochs below the lowest epoch known to the repository,
+// walking down to the first epoch, but not too many at once.
+func (es *epochSyncer) history(sealed hexutil.Uint64) {
+	// get the lowest known epoch
+	first, err := es.repo.FirstKnownEpoch()
+	if err != nil {
+		es.log.Errorf("can not get the lowest known epoch; %s", err.Error())
+		return
+	}
+
+	// nothing to do if the history is complete
+	if first <= 1 {
+		return
+	}
+
+	// find the bottom of this batch
+	to := hexutil.Uint64(1)
+	if epochSyncBatch < first {
+		to = hexutil.Uint64(first - epochSyncBatch)
+	}
+
+	for id := hexutil.Uint64(first - 1); to <= id; id-- {
+		// add the epoch
+		if !es.add(id, id == sealed) {
+			return
+		}
+	}
+}
+
+// add stores the epoch of the given id unless the syncer is requested to terminate.
+// It returns false if the sync should not continue.
+func (es *epochSyncer) add(id hexutil.Uint64, isLatest bool) bool {
+	// terminate if requested
+	select {
+	case <-es.sigStop:
+		// the channel is closed after the signal so the main loop sees it too
+		return false
+	default:
+	}
+
+	return es.repo.AddEpoch(id, isLatest) == nil
+}
@@ -0,0 +1,49 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"math/big"
+)
+
+// GasPrice returns the current gas price suggested by the connected node.
+func (p *proxy) GasPrice() (*hexutil.Big, error) {
+	return p.rpc.GasPrice()
+}
+
+// EstimateGas returns the amount of gas needed to execute the given call.
+func (p *proxy) EstimateGas(call *types.CallInput) (*hexutil.Uint64, error) {
+	return p.rpc.EstimateGas(call)
+}
+
+// GasPriceSuggestion returns slow, standard and fast gas price suggestions
+// derived from gas prices of transactions in recent blocks.
+func (p *proxy) GasPriceSuggestion() (*types.GasPriceSuggestion, error) {
+	// get the node price as the floor
+	floor, err := p.rpc.GasPrice()
+	if err != nil {
+		return nil, err
+	}
+
+	return p.gpo.suggest(floor.ToInt()), nil
+}
+
+// ObserveGasPrices records gas prices of transactions of the given block
+// to be used for the gas price suggestions.
+func (p *proxy) ObserveGasPrices(blk *types.Block, txs []*types.Transaction) {
+	// collect the prices
+	prices := make([]*big.Int, len(txs))
+	for i, trx := range txs {
+		prices[i] = new(big.Int).Set(trx.GasPrice.ToInt())
+	}
+
+	p.gpo.add(uint64(blk.Number), prices)
+}
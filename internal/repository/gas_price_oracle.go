@@ -0,0 +1,122 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"math/big"
+	"sort"
+	"sync"
+)
+
+const (
+	// gasPriceOracleBlocks represents the number of recent blocks with transactions
+	// used to derive the gas price suggestions.
+	gasPriceOracleBlocks = 20
+
+	// gasPriceSlowPercentile represents the percentile of sampled gas prices suggested for slow transactions.
+	gasPriceSlowPercentile = 30
+
+	// gasPriceStandardPercentile represents the percentile of sampled gas prices suggested for regular transactions.
+	gasPriceStandardPercentile = 60
+
+	// gasPriceFastPercentile represents the percentile of sampled gas prices suggested for fast transactions.
+	gasPriceFastPercentile = 90
+)
+
+// gasPriceBlock represents gas prices of transactions of a single block.
+type gasPriceBlock struct {
+	number uint64
+	prices []*big.Int
+}
+
+// gasPriceOracle keeps gas prices of transactions in recent blocks
+// and derives the gas price suggestions from them.
+type gasPriceOracle struct {
+	mu     sync.Mutex
+	blocks []gasPriceBlock
+}
+
+// newGasPriceOracle creates a new empty gas price oracle.
+func newGasPriceOracle() *gasPriceOracle {
+	return &gasPriceOracle{blocks: make([]gasPriceBlock, 0, gasPriceOracleBlocks)}
+}
+
+// add records gas prices of transactions of the given block; blocks without transactions are ignored.
+// A block already recorded is not added again, its prices are replaced instead.
+// Blocks older than the window are ignored so they don't push newer blocks out.
+func (gpo *gasPriceOracle) add(num uint64, prices []*big.Int) {
+	// empty blocks don't tell us anything
+	if len(prices) == 0 {
+		return
+	}
+
+	gpo.mu.Lock()
+	defer gpo.mu.Unlock()
+
+	// the block may be replayed by the monitor
+	if 0 < len(gpo.blocks) && num < gpo.blocks[0].number {
+		return
+	}
+	for i := range gpo.blocks {
+		if gpo.blocks[i].number == num {
+			gpo.blocks[i].prices = prices
+			return
+		}
+	}
+
+	// drop the oldest block if we are full
+	if len(gpo.blocks) == gasPriceOracleBlocks {
+		gpo.blocks = gpo.blocks[1:]
+	}
+	gpo.blocks = append(gpo.blocks, gasPriceBlock{number: num, prices: prices})
+}
+
+// suggest derives the gas price suggestions from the recorded gas prices.
+// Suggested prices never go below the given node gas price.
+func (gpo *gasPriceOracle) suggest(floor *big.Int) *types.GasPriceSuggestion {
+	// collect the prices
+	gpo.mu.Lock()
+	prices := make([]*big.Int, 0)
+	for _, blk := range gpo.blocks {
+		prices = append(prices, blk.prices...)
+	}
+	blocks := len(gpo.blocks)
+	gpo.mu.Unlock()
+
+	// sort the prices from low to high
+	sort.Slice(prices, func(i, j int) bool {
+		return prices[i].Cmp(prices[j]) < 0
+	})
+
+	return &types.GasPriceSuggestion{
+		Slow:      gasPricePercentile(prices, gasPriceSlowPercentile, floor),
+		Standard:  gasPricePercentile(prices, gasPriceStandardPercentile, floor),
+		Fast:      gasPricePercentile(prices, gasPriceFastPercentile, floor),
+		NodePrice: hexutil.Big(*floor),
+		Blocks:    int32(blocks),
+		Samples:   int32(len(prices)),
+	}
+}
+
+// gasPricePercentile picks the given percentile of the sorted gas prices, but at least the floor price.
+func gasPricePercentile(prices []*big.Int, pct int, floor *big.Int) hexutil.Big {
+	// no samples, use the floor
+	if len(prices) == 0 {
+		return hexutil.Big(*floor)
+	}
+
+	// pick the price
+	val := prices[(len(prices)-1)*pct/100]
+	if val.Cmp(floor) < 0 {
+		val = floor
+	}
+	return hexutil.Big(*val)
+}
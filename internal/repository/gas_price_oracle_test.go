@@ -0,0 +1,56 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestGasPriceOracleAdd(t *testing.T) {
+	gpo := newGasPriceOracle()
+	floor := big.NewInt(1)
+
+	// add more blocks than the window holds, each of them twice
+	for i := 1; i <= gasPriceOracleBlocks+5; i++ {
+		gpo.add(uint64(i), []*big.Int{big.NewInt(int64(i))})
+		gpo.add(uint64(i), []*big.Int{big.NewInt(int64(i))})
+	}
+
+	sug := gpo.suggest(floor)
+	if sug.Blocks != gasPriceOracleBlocks || sug.Samples != gasPriceOracleBlocks {
+		t.Fatalf("expected %d blocks and samples, got %d and %d", gasPriceOracleBlocks, sug.Blocks, sug.Samples)
+	}
+
+	// replayed block replaces the recorded prices
+	gpo.add(gasPriceOracleBlocks+5, []*big.Int{big.NewInt(1000), big.NewInt(2000)})
+	sug = gpo.suggest(floor)
+	if sug.Blocks != gasPriceOracleBlocks || sug.Samples != gasPriceOracleBlocks+1 {
+		t.Fatalf("expected %d blocks and %d samples, got %d and %d", gasPriceOracleBlocks, gasPriceOracleBlocks+1, sug.Blocks, sug.Samples)
+	}
+	if last := gpo.blocks[len(gpo.blocks)-1]; last.prices[0].Cmp(big.NewInt(1000)) != 0 {
+		t.Errorf("expected replayed price 1000, got %s", last.prices[0].String())
+	}
+
+	// replayed block older than the window is ignored
+	gpo.add(1, []*big.Int{big.NewInt(5000)})
+	sug = gpo.suggest(floor)
+	if sug.Blocks != gasPriceOracleBlocks || sug.Samples != gasPriceOracleBlocks+1 {
+		t.Fatalf("expected %d blocks and %d samples, got %d and %d", gasPriceOracleBlocks, gasPriceOracleBlocks+1, sug.Blocks, sug.Samples)
+	}
+	if first := gpo.blocks[0].number; first != 6 {
+		t.Errorf("expected the oldest block #6, got #%d", first)
+	}
+
+	// empty blocks are ignored
+	gpo.add(gasPriceOracleBlocks+6, nil)
+	if sug = gpo.suggest(floor); sug.Blocks != gasPriceOracleBlocks {
+		t.Errorf("expected %d blocks, got %d", gasPriceOracleBlocks, sug.Blocks)
+	}
+}
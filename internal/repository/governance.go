@@ -0,0 +1,201 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eth "github.com/ethereum/go-ethereum/core/types"
+	"sort"
+	"time"
+)
+
+const (
+	// govProposalsMaxList is the max number of governance proposals resolved in a list.
+	govProposalsMaxList = 50
+
+	// govProposalsMaxScan is the max number of the latest governance proposals
+	// inspected to build a list, so filtering does not scan the whole proposals history.
+	govProposalsMaxScan = 200
+
+	// govProposalNotifyMaxAge is the max age of a proposal state change to be broadcast
+	// to subscribers; changes found by re-scanning old blocks are not broadcast.
+	govProposalNotifyMaxAge = 5 * time.Minute
+)
+
+// governanceContracts builds the list of governance contracts from the configured
+// map of names and addresses. Invalid addresses are skipped.
+func governanceContracts(cfg map[string]string, p *proxy) []types.GovernanceContract {
+	list := make([]types.GovernanceContract, 0, len(cfg))
+	for name, addr := range cfg {
+		// validate the address
+		if !common.IsHexAddress(addr) {
+			p.log.Errorf("invalid address %s of governance contract %s", addr, name)
+			continue
+		}
+
+		list = append(list, types.GovernanceContract{Name: name, Address: common.HexToAddress(addr)})
+	}
+
+	// keep the list in a stable order
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+
+	return list
+}
+
+// GovernanceContracts resolves the list of the configured governance contracts.
+func (p *proxy) GovernanceContracts() []types.GovernanceContract {
+	return p.governance
+}
+
+// GovernanceContract resolves the configured governance contract by its address.
+func (p *proxy) GovernanceContract(addr *common.Address) (*types.GovernanceContract, error) {
+	for i := range p.governance {
+		if p.governance[i].Address == *addr {
+			return &p.governance[i], nil
+		}
+	}
+	return nil, fmt.Errorf("governance contract %s not known", addr.String())
+}
+
+// GovernanceProposalsCount resolves the number of proposals of the governance contract.
+func (p *proxy) GovernanceProposalsCount(gov *common.Address) (hexutil.Uint64, error) {
+	return p.rpc.GovernanceProposalsCount(gov)
+}
+
+// GovernanceProposals resolves the list of the latest proposals of the governance
+// contract. Only proposals open for voting are resolved if active is requested;
+// active proposals older than the latest scanned proposals are not listed.
+func (p *proxy) GovernanceProposals(gov *common.Address, activeOnly bool) ([]*types.GovernanceProposal, error) {
+	// get the number of proposals
+	count, err := p.rpc.GovernanceProposalsCount(gov)
+	if err != nil {
+		return nil, err
+	}
+
+	// find the oldest proposal to be inspected
+	var floor hexutil.Uint64
+	if count > govProposalsMaxScan {
+		floor = count - govProposalsMaxScan
+	}
+
+	// load the proposals starting with the latest one
+	list := make([]*types.GovernanceProposal, 0)
+	for id := count; id > floor && len(list) < govProposalsMaxList; id-- {
+		prop, err := p.rpc.GovernanceProposal(gov, id)
+		if err != nil {
+			return nil, err
+		}
+
+		// skip closed proposals if not needed
+		if activeOnly && prop.Status != types.GovernanceStatusActive {
+			continue
+		}
+		list = append(list, prop)
+	}
+
+	return list, nil
+}
+
+// GovernanceProposal resolves the details and the state of the governance proposal.
+func (p *proxy) GovernanceProposal(gov *common.Address, id hexutil.Uint64) (*types.GovernanceProposal, error) {
+	return p.rpc.GovernanceProposal(gov, id)
+}
+
+// GovernanceVotes resolves the list of governance votes cast by the given account,
+// optionally filtered by the governance contract.
+func (p *proxy) GovernanceVotes(voter *common.Address, gov *common.Address) ([]types.GovernanceVote, error) {
+	return p.db.GovernanceVotes(voter, gov)
+}
+
+// GovernanceVotingPower resolves the voting power of the given account
+// derived from its stake and delegations.
+func (p *proxy) GovernanceVotingPower(addr *common.Address) (*types.GovernanceVotingPower, error) {
+	return p.rpc.GovernanceVotingPower(addr)
+}
+
+// addGovernanceEvents extracts governance votes and proposal state changes
+// from the given transaction event logs and stores them in the persistent storage.
+// Events are matched by the emitting contract, so calls through other contracts are found.
+func (p *proxy) addGovernanceEvents(block *types.Block, trx *types.Transaction, logs []*eth.Log) error {
+	for i := range p.governance {
+		if err := p.addGovernanceContractEvents(block, trx, logs, &p.governance[i].Address); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addGovernanceContractEvents stores the events of the given governance contract
+// emitted by the transaction.
+func (p *proxy) addGovernanceContractEvents(block *types.Block, trx *types.Transaction, logs []*eth.Log, gov *common.Address) error {
+	// any event of the contract?
+	if !hasLogSource(logs, gov) {
+		return nil
+	}
+
+	// get the events
+	votes, cancels, changes, err := p.rpc.GovernanceEvents(trx, logs, gov)
+	if err != nil {
+		p.log.Errorf("can not get governance events of %s; %s", trx.Hash.String(), err.Error())
+		return nil
+	}
+
+	// store the votes
+	for i := range votes {
+		votes[i].TimeStamp = block.TimeStamp
+		if err := p.db.AddGovernanceVote(&votes[i]); err != nil {
+			return err
+		}
+	}
+
+	// remove canceled votes
+	for i := range cancels {
+		if err := p.db.RemoveGovernanceVote(&cancels[i]); err != nil {
+			return err
+		}
+	}
+
+	// notify subscribers about fresh proposal state changes only
+	if time.Since(time.Unix(int64(block.TimeStamp), 0)) < govProposalNotifyMaxAge {
+		for i := range changes {
+			p.notifyProposalChange(&changes[i])
+		}
+	}
+
+	return nil
+}
+
+// hasLogSource checks if any of the given event logs has been emitted by the given contract.
+func hasLogSource(logs []*eth.Log, addr *common.Address) bool {
+	for _, l := range logs {
+		if l.Address == *addr {
+			return true
+		}
+	}
+	return false
+}
+
+// notifyProposalChange broadcasts the governance proposal state change to subscribers.
+func (p *proxy) notifyProposalChange(evt *types.GovernanceProposalEvent) {
+	// do we have a subscriber?
+	if p.onProposalChange == nil {
+		return
+	}
+
+	select {
+	case p.onProposalChange <- evt:
+	default:
+		p.log.Warning("governance proposal channel is full")
+	}
+}
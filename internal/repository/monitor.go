@@ -175,11 +175,19 @@ func (bm *blockMonitor) process() {
 			return
 		}
 
+		// keep the block header in the persistent storage
+		if err := bm.repo.AddBlock(&block); err != nil {
+			bm.log.Errorf("can not store block #%d; %s", uint64(block.Number), err.Error())
+		}
+
 		// any transactions in the block?
 		if block.Txs != nil && len(block.Txs) > 0 {
 			// log action
 			bm.log.Debugf("block #%d has %d transactions to process", uint64(block.Number), len(block.Txs))
 
+			// collect transactions for the gas price oracle
+			txs := make([]*types.Transaction, 0, len(block.Txs))
+
 			// loop transaction hashes
 			for i, hash := range block.Txs {
 				// log action
@@ -198,8 +206,12 @@ func (bm *blockMonitor) process() {
 
 				// notify new transaction
 				bm.onTransaction <- trx
+				txs = append(txs, trx)
 			}
 
+			// feed the gas price oracle
+			bm.repo.ObserveGasPrices(&block, txs)
+
 			// log action
 			bm.log.Debugf("block #%d processed", uint64(block.Number))
 		}
@@ -0,0 +1,216 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/repository/db"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	// networkStatsMaxPeriods represents the max number of periods loaded in a single query.
+	networkStatsMaxPeriods = 1000
+
+	// networkStatsMaxPeriodsPerRun represents the max number of past periods aggregated
+	// in a single aggregation run so the history is caught up gradually.
+	networkStatsMaxPeriodsPerRun = 48
+)
+
+// NetworkStats returns the aggregated network statistics of the given resolution
+// with the period start in the given time range.
+func (p *proxy) NetworkStats(res string, from uint64, to uint64) ([]*types.NetworkStats, error) {
+	// validate the resolution
+	period := types.NetworkStatsPeriod(res)
+	if period == 0 {
+		return nil, fmt.Errorf("unknown network stats resolution %s", res)
+	}
+
+	// validate the range
+	if from > to {
+		return nil, fmt.Errorf("invalid time range %d - %d", from, to)
+	}
+	if (to-from)/period >= networkStatsMaxPeriods {
+		return nil, fmt.Errorf("time range too wide, max %d periods allowed", networkStatsMaxPeriods)
+	}
+
+	return p.db.NetworkStats(res, from, to)
+}
+
+// AggregateNetworkStats updates the aggregated network statistics of the given resolution.
+// The recent periods are aggregated on each run and the subscribers are notified
+// about the period in progress. The past periods are aggregated separately,
+// once the historical data are backfilled.
+func (p *proxy) AggregateNetworkStats(res string) error {
+	// validate the resolution
+	period := types.NetworkStatsPeriod(res)
+	if period == 0 {
+		return fmt.Errorf("unknown network stats resolution %s", res)
+	}
+
+	// find the period in progress
+	now := uint64(time.Now().UTC().Unix())
+	current := now - now%period
+
+	// the stats of the past would be incomplete without the backfilled data
+	ready := p.isNetworkStatsDataReady()
+
+	// aggregate the recent periods
+	start, err := p.networkStatsStart(res, period, current, ready)
+	if err != nil {
+		return err
+	}
+	for ; start <= current; start += period {
+		ns, err := p.aggregateNetworkStatsPeriod(res, start, period, now)
+		if err != nil {
+			return err
+		}
+
+		// notify subscribers about the period in progress
+		if start == current {
+			p.notifyNetworkStats(ns)
+		}
+	}
+
+	// aggregate the past periods
+	if !ready {
+		p.log.Debugf("network stats %s history waits for the data backfill", res)
+		return nil
+	}
+	return p.aggregateNetworkStatsHistory(res, period, current)
+}
+
+// aggregateNetworkStatsPeriod calculates and stores the network stats of the period
+// starting at the given time. The period in progress is aggregated up to now.
+func (p *proxy) aggregateNetworkStatsPeriod(res string, start uint64, period uint64, now uint64) (*types.NetworkStats, error) {
+	end := start + period
+	if end > now {
+		end = now
+	}
+
+	// calculate the stats
+	ns, err := p.db.AggregateNetworkStats(res, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	// store the stats
+	return ns, p.db.StoreNetworkStats(ns)
+}
+
+// aggregateNetworkStatsHistory aggregates a batch of the past periods, starting
+// with the period of the oldest transaction known, up to the recent periods
+// aggregated on each run.
+func (p *proxy) aggregateNetworkStatsHistory(res string, period uint64, current uint64) error {
+	// where are we?
+	job := db.BackfillNetworkStats + res
+	pos, done, err := p.db.BackfillPosition(job)
+	if err != nil || done {
+		return err
+	}
+
+	// start with the oldest transaction known
+	start := backfillUint64(pos)
+	if start == 0 {
+		first, err := p.db.FirstTransactionTime()
+		if err != nil || first == 0 {
+			return err
+		}
+		start = first - first%period
+	}
+
+	// the previous period is already covered by the recent periods
+	top := current - period
+	for i := 0; start < top && i < networkStatsMaxPeriodsPerRun; i++ {
+		if _, err := p.aggregateNetworkStatsPeriod(res, start, period, current); err != nil {
+			return err
+		}
+		start += period
+	}
+
+	return p.db.SetBackfillPosition(job, strconv.FormatUint(start, 10), start >= top)
+}
+
+// isNetworkStatsDataReady checks if the data used by the network stats aggregation
+// are complete, i.e. the blocks, the transaction values and the first seen time of accounts.
+func (p *proxy) isNetworkStatsDataReady() bool {
+	for _, job := range []string{db.BackfillBlocks, db.BackfillTransactions, db.BackfillAccounts} {
+		if !p.db.IsBackfillDone(job) {
+			return false
+		}
+	}
+	return true
+}
+
+// networkStatsStart finds the start of the first recent period to be aggregated.
+// The last aggregated period is re-calculated since it may have been in progress.
+// Periods missed during a downtime are caught up only if the historical data are ready,
+// and not more than the max number of periods per run. Longer gaps are handed over
+// to the history aggregation.
+func (p *proxy) networkStatsStart(res string, period uint64, current uint64, ready bool) (uint64, error) {
+	// the previous period may have been in progress on the last run
+	start := current - period
+	if !ready {
+		return start, nil
+	}
+
+	// the oldest period we catch up in a single run
+	floor := current - (networkStatsMaxPeriodsPerRun-1)*period
+
+	// continue with the last aggregated period, if any
+	last, err := p.db.LastNetworkStats(res)
+	if err != nil {
+		return 0, err
+	}
+	if last != nil && uint64(last.TimeStamp) < start {
+		start = uint64(last.TimeStamp)
+		if start < floor {
+			if err := p.reopenNetworkStatsHistory(res, start); err != nil {
+				return 0, err
+			}
+			start = floor
+		}
+	}
+	return start, nil
+}
+
+// reopenNetworkStatsHistory restarts the finished history aggregation
+// from the given period so a gap left by a long downtime is filled.
+// The history aggregation in progress will reach the gap on its own.
+func (p *proxy) reopenNetworkStatsHistory(res string, start uint64) error {
+	job := db.BackfillNetworkStats + res
+	_, done, err := p.db.BackfillPosition(job)
+	if err != nil || !done {
+		return err
+	}
+
+	p.log.Noticef("network stats %s gap found, history aggregation restarted", res)
+	return p.db.SetBackfillPosition(job, strconv.FormatUint(start, 10), false)
+}
+
+// notifyNetworkStats broadcasts the updated network statistics to subscribers.
+func (p *proxy) notifyNetworkStats(ns *types.NetworkStats) {
+	// do we have a subscriber?
+	if p.onNetworkStats == nil {
+		return
+	}
+
+	select {
+	case p.onNetworkStats <- ns:
+	default:
+		p.log.Warning("network stats channel is full")
+	}
+}
+
+// SetNetworkStatsChannel registers a channel for notifying network statistics updates.
+func (p *proxy) SetNetworkStatsChannel(ch chan *types.NetworkStats) {
+	p.onNetworkStats = ch
+}
@@ -0,0 +1,76 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/logger"
+	"fantom-api-graphql/internal/types"
+	"sync"
+	"time"
+)
+
+// networkStatsInterval represents the interval of the network statistics aggregation.
+const networkStatsInterval = 5 * time.Minute
+
+// networkStatsAggregator implements a service rolling up the stored transactions,
+// blocks, accounts and contracts into hourly and daily network statistics.
+type networkStatsAggregator struct {
+	service
+}
+
+// newNetworkStatsAggregator creates a new network statistics aggregator service and starts it.
+func newNetworkStatsAggregator(repo Repository, log logger.Logger, wg *sync.WaitGroup) *networkStatsAggregator {
+	// create new aggregator
+	na := networkStatsAggregator{
+		service: newService("network stats aggregator", repo, log, wg),
+	}
+
+	// add self to the wait group and run the aggregation routine
+	wg.Add(1)
+	go na.run()
+
+	return &na
+}
+
+// run aggregates the network statistics periodically.
+func (na *networkStatsAggregator) run() {
+	// don't forget to sign off after we are done
+	defer func() {
+		// log finish
+		na.log.Notice("network stats aggregator done")
+		na.wg.Done()
+	}()
+
+	// inform about the action
+	na.log.Notice("network stats aggregator is running")
+
+	// aggregate on each tick
+	ticker := time.NewTicker(networkStatsInterval)
+	defer ticker.Stop()
+
+	for {
+		// aggregate what we have
+		na.aggregate()
+
+		select {
+		case <-na.sigStop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// aggregate updates the network statistics of all the resolutions.
+func (na *networkStatsAggregator) aggregate() {
+	for _, res := range []string{types.NetworkStatsResolutionHour, types.NetworkStatsResolutionDay} {
+		if err := na.repo.AggregateNetworkStats(res); err != nil {
+			na.log.Errorf("can not aggregate %s network stats; %s", res, err.Error())
+		}
+	}
+}
@@ -35,6 +35,11 @@ type orchestrator struct {
 	txd *trxDispatcher
 	sys *scanner
 	mon *blockMonitor
+	eps *epochSyncer
+	sps *stakerSampler
+	nsa *networkStatsAggregator
+	bfl *dataBackfill
+	ups *uniswapPairSyncer
 }
 
 // NewOrchestrator creates a new instance of repository orchestrator.
@@ -71,6 +76,21 @@ func (or *orchestrator) close() {
 	// signal tx dispatcher
 	or.txd.close()
 
+	// signal epoch syncer
+	or.eps.close()
+
+	// signal staker sampler
+	or.sps.close()
+
+	// signal network stats aggregator
+	or.nsa.close()
+
+	// signal data backfill
+	or.bfl.close()
+
+	// signal uniswap pair syncer
+	or.ups.close()
+
 	// kill re-scan scheduler
 	or.sigKillScheduler <- true
 
@@ -112,6 +132,21 @@ func (or *orchestrator) init() {
 	// create block monitor; it waits for sync scanner to finish
 	or.reScan = make(chan bool, 1)
 	or.mon = NewBlockMonitor(or.repo.FtmConnection(), or.trxBuffer, or.reScan, or.repo, or.log, or.wg)
+
+	// create epoch syncer; it starts syncing immediately
+	or.eps = newEpochSyncer(or.repo, or.log, or.wg)
+
+	// create staker sampler; it starts sampling immediately
+	or.sps = newStakerSampler(or.repo, or.log, or.wg)
+
+	// create network stats aggregator; it starts aggregating immediately
+	or.nsa = newNetworkStatsAggregator(or.repo, or.log, or.wg)
+
+	// create data backfill; it starts filling the missing data immediately
+	or.bfl = newDataBackfill(or.repo, or.log, or.wg)
+
+	// create uniswap pair syncer; it starts loading the factory pairs immediately
+	or.ups = newUniswapPairSyncer(or.repo, or.log, or.wg)
 }
 
 // orchestrate starts the service orchestration.
@@ -16,7 +16,6 @@ import (
 	"fantom-api-graphql/internal/repository/rpc"
 	"fantom-api-graphql/internal/types"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/common/compiler"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ftm "github.com/ethereum/go-ethereum/rpc"
 )
@@ -49,7 +48,11 @@ type Repository interface {
 	// of transactions newer than that.
 	//
 	// Transactions are always sorted from newer to older.
-	AccountTransactions(*types.Account, *string, int32) (*types.TransactionHashList, error)
+	// The list is limited to transactions matching the filter, if provided.
+	AccountTransactions(*types.Account, *string, int32, *types.AccountTransactionFilter) (*types.TransactionHashList, error)
+
+	// AccountTxSummary returns the aggregated transactions of the given account.
+	AccountTxSummary(*types.Account) (*types.AccountTxSummary, error)
 
 	// Returns total number of accounts known to repository.
 	AccountsActive() (hexutil.Uint64, error)
@@ -71,9 +74,33 @@ type Repository interface {
 	// CurrentSealedEpoch returns the data of the latest sealed epoch.
 	CurrentSealedEpoch() (*types.Epoch, error)
 
-	// Epoch returns the id of the current epoch.
+	// Epoch returns the details of the epoch of the given id.
 	Epoch(hexutil.Uint64) (types.Epoch, error)
 
+	// EpochAt returns the details of the epoch of the given id
+	// at the given block of the blockchain.
+	EpochAt(hexutil.Uint64, hexutil.Uint64) (types.Epoch, error)
+
+	// EstimateRewards calculates the expected staking rewards of the given amount
+	// staked for the given duration with optional stake lock.
+	EstimateRewards(hexutil.Big, hexutil.Uint64, bool, hexutil.Uint64) (*types.RewardsEstimate, error)
+
+	// Epochs returns the list of sealed epochs stored in the repository.
+	Epochs(*string, int32) (*types.EpochList, error)
+
+	// EpochValidators returns the list of validators of the given sealed epoch.
+	EpochValidators(hexutil.Uint64) ([]types.EpochValidator, error)
+
+	// LastKnownEpoch returns the id of the latest epoch known to the repository.
+	LastKnownEpoch() (uint64, error)
+
+	// FirstKnownEpoch returns the id of the lowest epoch known to the repository.
+	FirstKnownEpoch() (uint64, error)
+
+	// AddEpoch loads the sealed epoch with its validators from the SFC contract
+	// and stores it in the repository.
+	AddEpoch(hexutil.Uint64, bool) error
+
 	// Block returns a block at Opera blockchain represented by a hash.
 	// Top block is returned if the hash is not provided.
 	// If the block is not found, ErrBlockNotFound error is returned.
@@ -88,19 +115,79 @@ type Repository interface {
 	// TransactionsCount returns total number of transactions in the block chain.
 	TransactionsCount() (hexutil.Uint64, error)
 
-	// Transactions returns list of transaction hashes at Opera blockchain.
-	Transactions(*string, int32) (*types.TransactionHashList, error)
+	// TransactionsFilteredCount returns the number of transactions matching the given filter.
+	TransactionsFilteredCount(*types.TransactionFilter) (hexutil.Uint64, error)
+
+	// Transactions returns list of transaction hashes at Opera blockchain
+	// matching the optional filter.
+	Transactions(*string, int32, *types.TransactionFilter) (*types.TransactionHashList, error)
 
 	// Collection pulls list of blocks starting on the specified block number
 	// and going up, or down based on count number.
 	Blocks(*uint64, int32) (*types.BlockList, error)
 
+	// BlocksByTime pulls list of blocks created in the given time range
+	// starting on the specified block number and going up, or down based on count number.
+	BlocksByTime(*uint64, int32, *hexutil.Uint64, *hexutil.Uint64) (*types.BlockList, error)
+
+	// BlocksCount returns the number of blocks known to the API.
+	BlocksCount() (uint64, error)
+
+	// BlockByTime returns the block closest to the given time stamp in the direction specified.
+	BlockByTime(hexutil.Uint64, string) (*types.Block, error)
+
+	// AddBlock stores the block header in the persistent storage.
+	AddBlock(*types.Block) error
+
 	// LastStakerId returns the last staker id in Opera blockchain.
 	LastStakerId() (hexutil.Uint64, error)
 
 	// StakersNum returns the number of stakers in Opera blockchain.
 	StakersNum() (hexutil.Uint64, error)
 
+	// Staker extracts a staker information by numeric id.
+	Staker(hexutil.Uint64) (*types.Staker, error)
+
+	// StakerByAddress extracts a staker information by address.
+	StakerByAddress(common.Address) (*types.Staker, error)
+
+	// StakerAt extracts a staker information by numeric id
+	// at the given block of the blockchain.
+	StakerAt(hexutil.Uint64, hexutil.Uint64) (*types.Staker, error)
+
+	// StakerByAddressAt extracts a staker information by address
+	// at the given block of the blockchain.
+	StakerByAddressAt(common.Address, hexutil.Uint64) (*types.Staker, error)
+
+	// Stakers extracts list of all the stakers known to the SFC contract.
+	Stakers() ([]types.Staker, error)
+
+	// Delegations extracts the list of delegations of the given account
+	// at the given block. The latest state is used for nil block.
+	Delegations(common.Address, *hexutil.Uint64) ([]types.Delegation, error)
+
+	// StakerInfo resolves the extended staker information, if available.
+	StakerInfo(hexutil.Uint64) (*types.StakerInfo, error)
+
+	// LoadStakerInfo makes sure the extended staker information is loaded
+	// and fresh in the in-memory cache, downloading it if needed.
+	LoadStakerInfo(hexutil.Uint64) error
+
+	// RefreshStakerInfo drops the cached extended staker information
+	// and loads it again from the staker info contract.
+	RefreshStakerInfo(hexutil.Uint64) (*types.StakerInfo, error)
+
+	// StakerPerformance aggregates the performance of the staker
+	// in the given range of epochs.
+	StakerPerformance(hexutil.Uint64, hexutil.Uint64, hexutil.Uint64) (*types.StakerPerformance, error)
+
+	// AddStakerSample records the current state of the staker in the given epoch.
+	AddStakerSample(*types.Staker, hexutil.Uint64) error
+
+	// Search finds blocks, transactions, accounts, contracts, tokens
+	// and stakers matching the given text.
+	Search(string) (*types.SearchResults, error)
+
 	// SfcVersion returns current version of the SFC contract.
 	SfcVersion() (hexutil.Uint64, error)
 
@@ -113,6 +200,65 @@ type Repository interface {
 	// SetTrxChannel registers a channel for notifying new transaction events.
 	SetTrxChannel(chan *types.Transaction)
 
+	// SetSwapChannel registers a channel for notifying new Uniswap swap events.
+	SetSwapChannel(chan *types.UniswapAction)
+
+	// SetProposalChannel registers a channel for notifying governance proposal state changes.
+	SetProposalChannel(chan *types.GovernanceProposalEvent)
+
+	// SetStakerStatusChannel registers a channel for notifying staker status changes.
+	SetStakerStatusChannel(chan *types.StakerStatusEvent)
+
+	// SetNetworkStatsChannel registers a channel for notifying network statistics updates.
+	SetNetworkStatsChannel(chan *types.NetworkStats)
+
+	// NetworkStats returns the aggregated network statistics of the given resolution
+	// with the period start in the given time range.
+	NetworkStats(string, uint64, uint64) ([]*types.NetworkStats, error)
+
+	// AggregateNetworkStats updates the aggregated network statistics of the given resolution.
+	AggregateNetworkStats(string) error
+
+	// BackfillBlocks stores a batch of blocks below the lowest stored block going down
+	// to the very first block. It returns true if the backfill is complete.
+	BackfillBlocks() (bool, error)
+
+	// BackfillTransactions fills the value and status of a batch of transactions
+	// stored before these fields were introduced. It returns true if the backfill is complete.
+	BackfillTransactions() (bool, error)
+
+	// BackfillAccounts fills the counterparty and value of transactions embedded in a batch
+	// of accounts stored before these fields were introduced. It returns true if the backfill is complete.
+	BackfillAccounts() (bool, error)
+
+	// SyncUniswapPairs stores a batch of Uniswap pairs created by the factory and not known
+	// to the repository yet. It returns true if all the pairs of the factory are known.
+	SyncUniswapPairs() (bool, error)
+
+	// BackfillBallots detects official ballots among a batch of contracts stored
+	// before the ballots were indexed. It returns true if the backfill is complete.
+	BackfillBallots() (bool, error)
+
+	// GasPrice returns the current gas price suggested by the connected node.
+	GasPrice() (*hexutil.Big, error)
+
+	// EstimateGas returns the amount of gas needed to execute the given call.
+	EstimateGas(*types.CallInput) (*hexutil.Uint64, error)
+
+	// GasPriceSuggestion returns slow, standard and fast gas price suggestions
+	// derived from gas prices of transactions in recent blocks.
+	GasPriceSuggestion() (*types.GasPriceSuggestion, error)
+
+	// ObserveGasPrices records gas prices of transactions of the given block.
+	ObserveGasPrices(*types.Block, []*types.Transaction)
+
+	// Call executes a read only call of the contract at the given address.
+	Call(*common.Address, []byte, *common.Address, *hexutil.Uint64) ([]byte, error)
+
+	// CallContract executes a read only call of the given method of the contract
+	// using the verified contract ABI to encode the arguments and decode the output values.
+	CallContract(*common.Address, string, []string, *hexutil.Uint64) (string, error)
+
 	// Contract extract a smart contract information by address if available.
 	Contract(*common.Address) (*types.Contract, error)
 
@@ -121,8 +267,113 @@ type Repository interface {
 
 	// ValidateContract tries to validate contract byte code using
 	// provided source code. If successful, the contract information
-	// is updated the the repository.
-	ValidateContract(*types.Contract) error
+	// is updated the the repository. The compiler version is optional,
+	// it's detected from the deployed byte code if not provided.
+	ValidateContract(*types.Contract, *string) error
+
+	// Compilers returns the list of smart contract compilers available
+	// for the contract validation.
+	Compilers() []*types.Compiler
+
+	// ContractProxy detects if the contract is a proxy and resolves
+	// the address of its current implementation.
+	ContractProxy(*types.Contract) (*types.ContractProxy, error)
+
+	// ContractUpgrades returns the list of implementation upgrades
+	// of the proxy contract.
+	ContractUpgrades(*types.Contract) ([]types.ContractUpgrade, error)
+
+	// ContractAbi returns the ABI of the contract merged with the ABI
+	// of the implementation for proxy contracts.
+	ContractAbi(*types.Contract) (string, error)
+
+	// DefiConfiguration resolves the current configuration of the fMint DeFi module.
+	DefiConfiguration() (*types.DefiSettings, error)
+
+	// FMintAccount resolves the collateral and debt positions of the given account
+	// in the fMint DeFi module.
+	FMintAccount(*common.Address) (*types.FMintAccount, error)
+
+	// DefiTokens resolves the list of tokens registered in the DeFi tokens registry.
+	DefiTokens() ([]*types.DefiToken, error)
+
+	// DefiTokenPrice resolves the current price of the given token
+	// from the DeFi price oracle.
+	DefiTokenPrice(*common.Address) (hexutil.Big, error)
+
+	// FMintRewardPool resolves the state of the fMint reward distribution pool.
+	FMintRewardPool() (*types.FMintRewardPool, error)
+
+	// FMintRewards resolves the state of fMint rewards of the given account.
+	FMintRewards(*common.Address) (*types.FMintRewards, error)
+
+	// FMintRewardClaims resolves the list of fMint reward claims of the given account.
+	FMintRewardClaims(*common.Address) ([]types.FMintRewardClaim, error)
+
+	// UniswapPairs resolves the list of the pairs of the Uniswap exchange
+	// known to the repository.
+	UniswapPairs(*string, int32) (*types.UniswapPairList, error)
+
+	// UniswapPair resolves the state of the given Uniswap pair.
+	UniswapPair(*common.Address) (*types.UniswapPair, error)
+
+	// UniswapPairTvl resolves the total value locked in the given Uniswap pair expressed
+	// in the first and in the second token; nil if the value is not known.
+	UniswapPairTvl(*types.UniswapPair) (*hexutil.Big, *hexutil.Big, error)
+
+	// UniswapAmountsOut resolves the amounts of tokens received for the given
+	// input amount swapped along the given path of tokens.
+	UniswapAmountsOut(hexutil.Big, []common.Address) ([]hexutil.Big, error)
+
+	// UniswapPositions resolves the list of liquidity positions of the given account
+	// in the Uniswap pairs.
+	UniswapPositions(*common.Address) ([]types.UniswapPosition, error)
+
+	// UniswapActions resolves the list of swaps and liquidity changes on the Uniswap
+	// exchange, optionally filtered by the pair, the account and the type of the action.
+	UniswapActions(*common.Address, *common.Address, *string, int32) ([]types.UniswapAction, error)
+
+	// UniswapCandles resolves the list of OHLCV candles of the given Uniswap pair
+	// and resolution in the given time range.
+	UniswapCandles(*common.Address, string, uint64, uint64) ([]types.UniswapCandle, error)
+
+	// UniswapVolume24h resolves the trading volume of the given Uniswap pair
+	// over the last 24 hours.
+	UniswapVolume24h(*common.Address) (*types.UniswapVolume, error)
+
+	// Ballots resolves the list of official voting ballots. Only ballots
+	// open for voting are resolved if active is requested.
+	Ballots(bool) ([]*types.Ballot, error)
+
+	// Ballot resolves the details of the official voting ballot.
+	Ballot(*common.Address) (*types.Ballot, error)
+
+	// Votes resolves the list of votes cast by the given account in official ballots.
+	Votes(*common.Address) ([]types.Vote, error)
+
+	// GovernanceContracts resolves the list of the configured governance contracts.
+	GovernanceContracts() []types.GovernanceContract
+
+	// GovernanceContract resolves the configured governance contract by its address.
+	GovernanceContract(*common.Address) (*types.GovernanceContract, error)
+
+	// GovernanceProposalsCount resolves the number of proposals of the governance contract.
+	GovernanceProposalsCount(*common.Address) (hexutil.Uint64, error)
+
+	// GovernanceProposals resolves the list of the latest proposals of the governance
+	// contract. Only proposals open for voting are resolved if active is requested.
+	GovernanceProposals(*common.Address, bool) ([]*types.GovernanceProposal, error)
+
+	// GovernanceProposal resolves the details and the state of the governance proposal.
+	GovernanceProposal(*common.Address, hexutil.Uint64) (*types.GovernanceProposal, error)
+
+	// GovernanceVotes resolves the list of governance votes cast by the given account,
+	// optionally filtered by the governance contract.
+	GovernanceVotes(*common.Address, *common.Address) ([]types.GovernanceVote, error)
+
+	// GovernanceVotingPower resolves the voting power of the given account
+	// derived from its stake and delegations.
+	GovernanceVotingPower(*common.Address) (*types.GovernanceVotingPower, error)
 
 	// Close and cleanup the repository.
 	Close()
@@ -137,11 +388,39 @@ type proxy struct {
 	log   logger.Logger
 
 	// smart contract compilers
-	solCompiler string
+	compilers *compilerRegistry
 
 	// official ballot source addresses
 	ballotSources []string
 
+	// detect contracts deployed by other contracts; 1 = enabled, 0 = disabled
+	// the flag is accessed atomically since the scanner may turn it off
+	contractTracing int32
+
+	// load missing transaction status from receipts
+	statusBackfill bool
+
+	// configured governance contracts
+	governance []types.GovernanceContract
+
+	// new Uniswap swaps notification channel
+	onSwap chan *types.UniswapAction
+
+	// governance proposal state changes notification channel
+	onProposalChange chan *types.GovernanceProposalEvent
+
+	// staker status changes notification channel
+	onStakerStatus chan *types.StakerStatusEvent
+
+	// network statistics updates notification channel
+	onNetworkStats chan *types.NetworkStats
+
+	// gas price oracle
+	gpo *gasPriceOracle
+
+	// staker info refresh rate limiter
+	stiLimiter *stakerInfoRefreshLimiter
+
 	// service orchestrator reference
 	orc *orchestrator
 }
@@ -169,12 +448,6 @@ func New(cfg *config.Config, log logger.Logger) (Repository, error) {
 		return nil, err
 	}
 
-	// try to validate the solidity compiler by asking for it's version
-	if _, err := compiler.SolidityVersion(cfg.SolCompilerPath); err != nil {
-		log.Criticalf("can not invoke the Solidity compiler, %s", err.Error())
-		return nil, err
-	}
-
 	// construct the proxy instance
 	p := proxy{
 		cache: caBridge,
@@ -182,16 +455,32 @@ func New(cfg *config.Config, log logger.Logger) (Repository, error) {
 		rpc:   rpcBridge,
 		log:   log,
 
-		// keep reference to the SOL compiler
-		solCompiler: cfg.SolCompilerPath,
+		// make the registry of smart contract compilers
+		compilers: newCompilerRegistry(cfg, log),
 
 		// keep the ballot sources ref
 		ballotSources: cfg.VotingSources,
+
+		// trace transactions for factory deployed contracts
+		contractTracing: boolToFlag(cfg.ContractTracing),
+
+		// backfill transaction status from receipts, if enabled
+		statusBackfill: cfg.StatusBackfill,
+
+		// collect recent gas prices
+		gpo: newGasPriceOracle(),
+
+		// limit staker info refreshes
+		stiLimiter: newStakerInfoRefreshLimiter(),
 	}
 
 	// inform about voting sources
 	log.Infof("voting ballots accepted from %s", cfg.VotingSources)
 
+	// collect governance contracts
+	p.governance = governanceContracts(cfg.GovernanceContracts, &p)
+	log.Infof("%d governance contracts configured", len(p.governance))
+
 	// propagate callbacks
 	dbBridge.SetBalance(p.AccountBalance)
 
@@ -232,3 +521,13 @@ func (p *proxy) SetBlockChannel(ch chan *types.Block) {
 func (p *proxy) SetTrxChannel(ch chan *types.Transaction) {
 	p.orc.setTrxChannel(ch)
 }
+
+// SetSwapChannel registers a channel for notifying new Uniswap swap events.
+func (p *proxy) SetSwapChannel(ch chan *types.UniswapAction) {
+	p.onSwap = ch
+}
+
+// SetProposalChannel registers a channel for notifying governance proposal state changes.
+func (p *proxy) SetProposalChannel(ch chan *types.GovernanceProposalEvent) {
+	p.onProposalChange = ch
+}
@@ -0,0 +1,197 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"math/big"
+)
+
+// secondsPerYear represents the number of seconds in a year used for the APR calculation.
+const secondsPerYear = 365 * 24 * 60 * 60
+
+// rewardsRatioUnit represents the unit of ratios used by the SFC contract (1.000.000).
+var rewardsRatioUnit = big.NewInt(1000000)
+
+// EstimateRewards calculates the expected staking rewards of the given amount
+// staked for the given duration in seconds. The estimation uses the state
+// of the latest sealed epoch and the reward weights of the SFC contract,
+// see rewardsEstimate for details.
+func (p *proxy) EstimateRewards(amount hexutil.Big, duration hexutil.Uint64, isValidator bool, lock hexutil.Uint64) (*types.RewardsEstimate, error) {
+	// validate the input
+	if (*big.Int)(&amount).Sign() <= 0 {
+		return nil, fmt.Errorf("amount must be positive")
+	}
+	if duration == 0 {
+		return nil, fmt.Errorf("duration must be positive")
+	}
+
+	// get the latest sealed epoch
+	ep, err := p.CurrentSealedEpoch()
+	if err != nil {
+		return nil, err
+	}
+
+	// get the rewards distribution params
+	params, err := p.rpc.RewardParams()
+	if err != nil {
+		p.log.Errorf("can not get reward params; %s", err.Error())
+		return nil, err
+	}
+
+	// check the lockup rules
+	if err := checkLockDuration(params, lock); err != nil {
+		return nil, err
+	}
+
+	// we need some stake to get the share on
+	if new(big.Int).Add(ep.StakeTotalAmount.ToInt(), ep.DelegationsTotalAmount.ToInt()).Sign() == 0 {
+		return nil, fmt.Errorf("no stake found in epoch %d", uint64(ep.Id))
+	}
+
+	return rewardsEstimate(ep, params, amount, duration, isValidator, lock), nil
+}
+
+// rewardsEstimate calculates the expected staking rewards of the amount using
+// the same formula as the SFC contract. The amount joins the reward weights
+// of the epoch as if staked on a validator with full uptime.
+// Delegators pay the validator commission, unlocked stake receives
+// the unlocked reward ratio only.
+func rewardsEstimate(ep *types.Epoch, params *types.RewardParams, amount hexutil.Big, duration hexutil.Uint64, isValidator bool, lock hexutil.Uint64) *types.RewardsEstimate {
+	// get the total amount staked on the network
+	total := new(big.Int).Add(ep.StakeTotalAmount.ToInt(), ep.DelegationsTotalAmount.ToInt())
+
+	// calculate full base reward and share on the transaction fees
+	base := baseRewardEstimate(ep, amount.ToInt(), uint64(duration))
+	tx := txRewardEstimate(ep, params, amount.ToInt(), total, uint64(duration))
+
+	// delegators pay the commission to the validator
+	if !isValidator {
+		keep := new(big.Int).Sub(rewardsRatioUnit, params.ValidatorCommission.ToInt())
+		base = applyRatio(base, keep)
+		tx = applyRatio(tx, keep)
+	}
+
+	// apply the lockup rules
+	ratio := lockupRewardRatio(params, uint64(duration), uint64(lock))
+	base = applyRatio(base, ratio)
+	tx = applyRatio(tx, ratio)
+
+	// make the estimate
+	sum := new(big.Int).Add(base, tx)
+	return &types.RewardsEstimate{
+		Amount:       amount,
+		Duration:     duration,
+		IsValidator:  isValidator,
+		LockDuration: lock,
+		BaseReward:   hexutil.Big(*base),
+		TxReward:     hexutil.Big(*tx),
+		Total:        hexutil.Big(*sum),
+		Apr:          rewardsApr(sum, amount.ToInt(), uint64(duration)),
+	}
+}
+
+// checkLockDuration validates the requested lock duration against the SFC lockup rules.
+func checkLockDuration(params *types.RewardParams, lock hexutil.Uint64) error {
+	// no lock is always fine
+	if lock == 0 {
+		return nil
+	}
+
+	// the contract must support locking
+	if !params.HasLockup {
+		return fmt.Errorf("stake locking is not supported")
+	}
+
+	// check the limits
+	if lock < params.MinLockupDuration || lock > params.MaxLockupDuration {
+		return fmt.Errorf("lock duration must be between %d and %d seconds", uint64(params.MinLockupDuration), uint64(params.MaxLockupDuration))
+	}
+	return nil
+}
+
+// baseRewardEstimate calculates the full base reward of the amount for the duration.
+// The contract distributes the base reward by the base reward weight, which is
+// the stake of the validator scaled by the square of its uptime ratio,
+// so the weight of the amount with full uptime is the amount itself.
+func baseRewardEstimate(ep *types.Epoch, amount *big.Int, duration uint64) *big.Int {
+	// the amount joins the total weight
+	weight := new(big.Int).Add(ep.TotalBaseRewardWeight.ToInt(), amount)
+
+	// duration * baseRewardPerSecond * baseRewardWeight / totalBaseRewardWeight
+	val := new(big.Int).Mul(ep.BaseRewardPerSecond.ToInt(), new(big.Int).SetUint64(duration))
+	val.Mul(val, amount)
+	return val.Div(val, weight)
+}
+
+// txRewardEstimate calculates the full share of the amount on the transaction fees
+// for the duration using the fees rate of the epoch. The contract distributes the fee
+// by the transaction reward weight, which follows the fees originated by the validator;
+// the amount is expected to originate the fees in proportion to its share on the stake.
+func txRewardEstimate(ep *types.Epoch, params *types.RewardParams, amount *big.Int, total *big.Int, duration uint64) *big.Int {
+	// we need the epoch duration to get the fee rate
+	if ep.Duration.ToInt().Sign() == 0 || total.Sign() == 0 {
+		return new(big.Int)
+	}
+
+	// the tx reward weight of the amount joins the total weight
+	share := new(big.Int).Mul(ep.TotalTxRewardWeight.ToInt(), amount)
+	share.Div(share, total)
+	weight := new(big.Int).Add(ep.TotalTxRewardWeight.ToInt(), share)
+	if weight.Sign() == 0 {
+		return new(big.Int)
+	}
+
+	// epochFee * txRewardWeight / totalTxRewardWeight; the contract keeps its commission
+	val := new(big.Int).Mul(ep.EpochFee.ToInt(), share)
+	val = applyRatio(val.Div(val, weight), new(big.Int).Sub(rewardsRatioUnit, params.ContractCommission.ToInt()))
+
+	// scale the epoch fee share to the duration
+	val.Mul(val, new(big.Int).SetUint64(duration))
+	return val.Div(val, ep.Duration.ToInt())
+}
+
+// lockupRewardRatio calculates the average reward ratio for the duration.
+// As in the SFC contract, the locked stake receives the full reward
+// and the unlocked stake receives the unlocked reward ratio only.
+// The lock may end before the period does.
+func lockupRewardRatio(params *types.RewardParams, duration uint64, lock uint64) *big.Int {
+	unlocked := params.UnlockedRewardRatio.ToInt()
+	if lock == 0 {
+		return new(big.Int).Set(unlocked)
+	}
+
+	// the locked part of the period
+	lockedPart := lock
+	if lockedPart > duration {
+		lockedPart = duration
+	}
+
+	// weighted average of the ratios
+	val := new(big.Int).Mul(rewardsRatioUnit, new(big.Int).SetUint64(lockedPart))
+	val.Add(val, new(big.Int).Mul(unlocked, new(big.Int).SetUint64(duration-lockedPart)))
+	return val.Div(val, new(big.Int).SetUint64(duration))
+}
+
+// applyRatio applies the SFC ratio on the given value.
+func applyRatio(val *big.Int, ratio *big.Int) *big.Int {
+	res := new(big.Int).Mul(val, ratio)
+	return res.Div(res, rewardsRatioUnit)
+}
+
+// rewardsApr calculates the annual percentage rate of the reward.
+func rewardsApr(reward *big.Int, amount *big.Int, duration uint64) float64 {
+	apr := new(big.Float).Quo(new(big.Float).SetInt(reward), new(big.Float).SetInt(amount))
+	apr.Mul(apr, big.NewFloat(float64(secondsPerYear)/float64(duration)*100))
+
+	val, _ := apr.Float64()
+	return val
+}
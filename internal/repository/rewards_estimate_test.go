@@ -0,0 +1,89 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"math/big"
+	"testing"
+)
+
+// testEpoch represents a sealed epoch snapshot used to verify the rewards estimate.
+var testEpoch = types.Epoch{
+	Id:                     5000,
+	Duration:               hexutil.Big(*hexutil.MustDecodeBig("0x258")),                     // 600 s
+	EpochFee:               hexutil.Big(*hexutil.MustDecodeBig("0xa688906bd8b00000")),        // 12 FTM
+	TotalBaseRewardWeight:  hexutil.Big(*hexutil.MustDecodeBig("0x2e87669c308736a04000000")), // 900M FTM
+	TotalTxRewardWeight:    hexutil.Big(*hexutil.MustDecodeBig("0x270801d946c940000")),       // 45 FTM
+	BaseRewardPerSecond:    hexutil.Big(*hexutil.MustDecodeBig("0x6f05b59d3b200000")),        // 8 FTM
+	StakeTotalAmount:       hexutil.Big(*hexutil.MustDecodeBig("0xf8277896582678ac000000")),  // 300M FTM
+	DelegationsTotalAmount: hexutil.Big(*hexutil.MustDecodeBig("0x24306c4097859c43c000000")), // 700M FTM
+}
+
+// testRewardParams builds the rewards distribution params used by the tests.
+func testRewardParams(unlocked int64) *types.RewardParams {
+	return &types.RewardParams{
+		ValidatorCommission: hexutil.Big(*big.NewInt(150000)),
+		ContractCommission:  hexutil.Big(*big.NewInt(300000)),
+		UnlockedRewardRatio: hexutil.Big(*big.NewInt(unlocked)),
+		HasLockup:           unlocked < 1000000,
+		MinLockupDuration:   14 * 24 * 60 * 60,
+		MaxLockupDuration:   secondsPerYear,
+	}
+}
+
+func TestRewardsEstimate(t *testing.T) {
+	tests := []struct {
+		name        string
+		unlocked    int64
+		isValidator bool
+		lock        hexutil.Uint64
+		base        string
+		tx          string
+		total       string
+	}{
+		{name: "validator", unlocked: 300000, isValidator: true,
+			base: "84002663706992230854605", tx: "132318881118881109288", total: "84134982588111111963893"},
+		{name: "delegator", unlocked: 300000,
+			base: "71402264150943396226414", tx: "112471048951048942894", total: "71514735199894445169308"},
+		{name: "delegator locked", unlocked: 300000, lock: secondsPerYear,
+			base: "238007547169811320754716", tx: "374903496503496476316", total: "238382450666314817231032"},
+		{name: "delegator locked half", unlocked: 300000, lock: secondsPerYear / 2,
+			base: "154704905660377358490565", tx: "243687272727272709605", total: "154948592933104631200170"},
+		{name: "legacy delegator", unlocked: 1000000,
+			base: "238007547169811320754716", tx: "374903496503496476316", total: "238382450666314817231032"},
+	}
+
+	// one million FTM staked for a year
+	amount := hexutil.Big(*mustBigInt(t, "1000000000000000000000000"))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := rewardsEstimate(&testEpoch, testRewardParams(tt.unlocked), amount, secondsPerYear, tt.isValidator, tt.lock)
+			if got.BaseReward.ToInt().String() != tt.base {
+				t.Errorf("base reward = %s, want %s", got.BaseReward.ToInt().String(), tt.base)
+			}
+			if got.TxReward.ToInt().String() != tt.tx {
+				t.Errorf("tx reward = %s, want %s", got.TxReward.ToInt().String(), tt.tx)
+			}
+			if got.Total.ToInt().String() != tt.total {
+				t.Errorf("total reward = %s, want %s", got.Total.ToInt().String(), tt.total)
+			}
+		})
+	}
+}
+
+// mustBigInt parses the decimal big integer for testing.
+func mustBigInt(t *testing.T, val string) *big.Int {
+	v, ok := new(big.Int).SetString(val, 10)
+	if !ok {
+		t.Fatalf("invalid number %s", val)
+	}
+	return v
+}
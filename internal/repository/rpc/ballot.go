@@ -0,0 +1,231 @@
+/*
+Package rpc implements bridge to Lachesis full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Lachesis node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Lachesis RPC interface for a remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Lachesis RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Lachesis RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+//go:generate abigen --abi ./contracts/ballot.abi --pkg rpc --type BallotContract --out ./smc_ballot.go
+
+import (
+	"bytes"
+	"context"
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eth "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"math/big"
+)
+
+var (
+	// ballotVotedEventTopic represents the topic of the ballot Voted event.
+	ballotVotedEventTopic = crypto.Keccak256Hash([]byte("Voted(address,address,uint256)"))
+
+	// ballotFinalizedEventTopic represents the topic of the ballot Finalized event.
+	ballotFinalizedEventTopic = crypto.Keccak256Hash([]byte("Finalized(address,uint256)"))
+)
+
+// Ballot loads the details of the voting ballot deployed on the given address.
+func (ftm *FtmBridge) Ballot(addr *common.Address) (*types.Ballot, error) {
+	// instantiate the ballot contract
+	contract, err := NewBallotContract(*addr, ftm.eth)
+	if err != nil {
+		ftm.log.Criticalf("failed to instantiate ballot contract: %v", err)
+		return nil, err
+	}
+
+	// get the ballot details
+	det, err := contract.Ballot(nil)
+	if err != nil {
+		ftm.log.Errorf("ballot %s details not available; %v", addr.String(), err)
+		return nil, err
+	}
+
+	// prep the result
+	ballot := types.Ballot{
+		Address:     *addr,
+		Name:        bytes32ToString(det.Name),
+		DetailsUrl:  det.Url,
+		Start:       hexutil.Uint64(det.Start.Uint64()),
+		End:         hexutil.Uint64(det.End.Uint64()),
+		IsFinalized: det.Finalized,
+		TotalVotes:  hexutil.Uint64(det.Votes.Uint64()),
+	}
+
+	// load the proposals
+	ballot.Proposals, err = ftm.ballotProposals(contract, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	// the winner is known for finalized ballots only
+	if ballot.IsFinalized {
+		win, _, _, err := contract.Winner(nil)
+		if err != nil {
+			ftm.log.Errorf("ballot %s winner not available; %v", addr.String(), err)
+			return nil, err
+		}
+
+		ix := int32(win.Int64())
+		ballot.Winner = &ix
+	}
+
+	return &ballot, nil
+}
+
+// ballotProposals loads the list of proposals of the ballot with their vote tally.
+func (ftm *FtmBridge) ballotProposals(contract *BallotContract, addr *common.Address) ([]types.BallotProposal, error) {
+	// get the number of proposals
+	count, err := contract.ProposalsCount(nil)
+	if err != nil {
+		ftm.log.Errorf("ballot %s proposals count not available; %v", addr.String(), err)
+		return nil, err
+	}
+
+	// load the proposals
+	list := make([]types.BallotProposal, 0, count.Uint64())
+	for i := int64(0); i < count.Int64(); i++ {
+		pr, err := contract.Proposals(nil, big.NewInt(i))
+		if err != nil {
+			ftm.log.Errorf("ballot %s proposal #%d not available; %v", addr.String(), i, err)
+			return nil, err
+		}
+
+		list = append(list, types.BallotProposal{
+			Name:   bytes32ToString(pr.Name),
+			Weight: hexutil.Big(*pr.Weight),
+			Votes:  hexutil.Uint64(pr.Votes.Uint64()),
+		})
+	}
+
+	return list, nil
+}
+
+// bytes32ToString converts zero padded bytes32 value into a string.
+func bytes32ToString(val [32]byte) string {
+	return string(bytes.TrimRight(val[:], "\x00"))
+}
+
+// BallotEventSources returns the addresses of contracts emitting the events
+// of voting ballots in the given transaction event logs. The events are matched by topic
+// only, the caller is responsible for checking the sources are official ballots.
+func (ftm *FtmBridge) BallotEventSources(logs []*eth.Log) []common.Address {
+	list := make([]common.Address, 0)
+	seen := make(map[common.Address]bool)
+	for _, l := range logs {
+		// is this an event of a ballot?
+		if 0 == len(l.Topics) || seen[l.Address] {
+			continue
+		}
+
+		if l.Topics[0] == ballotVotedEventTopic || l.Topics[0] == ballotFinalizedEventTopic {
+			seen[l.Address] = true
+			list = append(list, l.Address)
+		}
+	}
+	return list
+}
+
+// BallotVotes extracts the list of votes cast in the given ballot from the transaction event logs.
+func (ftm *FtmBridge) BallotVotes(trx *types.Transaction, logs []*eth.Log, ballot *common.Address) ([]types.Vote, error) {
+	// the ballot filterer decodes the events
+	filter, err := NewBallotContractFilterer(*ballot, ftm.eth)
+	if err != nil {
+		ftm.log.Criticalf("failed to instantiate ballot filterer: %v", err)
+		return nil, err
+	}
+
+	// collect the votes
+	list := make([]types.Vote, 0)
+	for _, l := range logs {
+		// is this the vote event of the ballot?
+		if l.Address != *ballot || 0 == len(l.Topics) || l.Topics[0] != ballotVotedEventTopic {
+			continue
+		}
+
+		// decode the event
+		evt, err := filter.ParseVoted(*l)
+		if err != nil {
+			ftm.log.Errorf("can not decode ballot vote event of %s; %v", trx.Hash.String(), err)
+			return nil, err
+		}
+
+		list = append(list, types.Vote{
+			Ballot:          *ballot,
+			Voter:           evt.Voter,
+			Proposal:        int32(evt.Vote.Int64()),
+			TransactionHash: trx.Hash,
+		})
+	}
+
+	return list, nil
+}
+
+// BallotVoteHistory loads the list of all the votes cast in the given ballot
+// from the chain, sorted from the oldest.
+func (ftm *FtmBridge) BallotVoteHistory(ballot *common.Address) ([]types.Vote, error) {
+	// keep track of the operation
+	ftm.log.Debugf("loading votes of ballot %s", ballot.String())
+
+	// the ballot filterer decodes the events
+	filter, err := NewBallotContractFilterer(*ballot, ftm.eth)
+	if err != nil {
+		ftm.log.Criticalf("failed to instantiate ballot filterer: %v", err)
+		return nil, err
+	}
+
+	// get the list of Voted events
+	it, err := filter.FilterVoted(nil, nil, nil)
+	if err != nil {
+		ftm.log.Errorf("can not get votes of ballot %s; %v", ballot.String(), err)
+		return nil, err
+	}
+
+	// close the iterator as we leave
+	defer func() {
+		if err := it.Close(); err != nil {
+			ftm.log.Errorf("error closing ballot votes iterator; %v", err)
+		}
+	}()
+
+	// collect the votes; the time stamp is shared by the votes of the same block
+	list := make([]types.Vote, 0)
+	times := make(map[uint64]uint64)
+	for it.Next() {
+		ts, ok := times[it.Event.Raw.BlockNumber]
+		if !ok {
+			hdr, err := ftm.eth.HeaderByNumber(context.Background(), new(big.Int).SetUint64(it.Event.Raw.BlockNumber))
+			if err != nil {
+				ftm.log.Errorf("block #%d not available; %v", it.Event.Raw.BlockNumber, err)
+				return nil, err
+			}
+			ts = hdr.Time
+			times[it.Event.Raw.BlockNumber] = ts
+		}
+
+		list = append(list, types.Vote{
+			Ballot:          *ballot,
+			Voter:           it.Event.Voter,
+			Proposal:        int32(it.Event.Vote.Int64()),
+			TransactionHash: types.Hash(it.Event.Raw.TxHash),
+			TimeStamp:       hexutil.Uint64(ts),
+		})
+	}
+
+	// any error during the iteration?
+	if it.Error() != nil {
+		ftm.log.Errorf("can not iterate votes of ballot %s; %v", ballot.String(), it.Error())
+		return nil, it.Error()
+	}
+
+	return list, nil
+}
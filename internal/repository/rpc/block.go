@@ -17,6 +17,7 @@ import (
 	"fantom-api-graphql/internal/types"
 	"fmt"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ftm "github.com/ethereum/go-ethereum/rpc"
 	"math/big"
 )
 
@@ -69,6 +70,39 @@ func (ftm *FtmBridge) Block(numTag *string) (*types.Block, error) {
 	return &block, nil
 }
 
+// Blocks returns information about blockchain blocks of the given numbers loaded in a single batch.
+// The block is nil for numbers not found on the chain.
+func (ftm *FtmBridge) Blocks(nums []uint64) ([]*types.Block, error) {
+	// keep track of the operation
+	ftm.log.Debugf("loading details of %d blocks", len(nums))
+
+	// call for data
+	batch, blocks := blocksBatch(nums)
+	if err := ftm.rpc.BatchCall(batch); err != nil {
+		ftm.log.Errorf("blocks could not be extracted; %s", err.Error())
+		return nil, err
+	}
+
+	// check the individual calls
+	for i := range batch {
+		if batch[i].Error != nil {
+			ftm.log.Errorf("block #%d could not be extracted; %s", nums[i], batch[i].Error.Error())
+			return nil, batch[i].Error
+		}
+	}
+	return blocks, nil
+}
+
+// blocksBatch prepares the batch of block calls for the given block numbers.
+func blocksBatch(nums []uint64) ([]ftm.BatchElem, []*types.Block) {
+	blocks := make([]*types.Block, len(nums))
+	batch := make([]ftm.BatchElem, len(nums))
+	for i, num := range nums {
+		batch[i] = ftm.BatchElem{Method: "ftm_getBlockByNumber", Args: []interface{}{hexutil.EncodeUint64(num), false}, Result: &blocks[i]}
+	}
+	return batch, blocks
+}
+
 // BlockByHash returns information about a blockchain block by hash.
 func (ftm *FtmBridge) BlockByHash(hash *string) (*types.Block, error) {
 	// keep track of the operation
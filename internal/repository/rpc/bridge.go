@@ -16,8 +16,11 @@ package rpc
 import (
 	"fantom-api-graphql/internal/config"
 	"fantom-api-graphql/internal/logger"
+	"github.com/ethereum/go-ethereum/common"
 	eth "github.com/ethereum/go-ethereum/ethclient"
 	ftm "github.com/ethereum/go-ethereum/rpc"
+	"sync"
+	"time"
 )
 
 // FtmBridge represents Lachesis RPC abstraction layer.
@@ -25,6 +28,20 @@ type FtmBridge struct {
 	rpc *ftm.Client
 	eth *eth.Client
 	log logger.Logger
+
+	// fMintCfg represents the configuration of the fMint DeFi module
+	fMintCfg fMintConfig
+
+	// uniswapCfg represents the configuration of the Uniswap DEX
+	uniswapCfg uniswapConfig
+
+	// stiContract represents the address of the staker information contract
+	stiContract common.Address
+
+	// sfcLatest represents the SFC adapter of the latest contract version
+	sfcLatest  sfcAdapter
+	sfcChecked time.Time
+	sfcLock    sync.Mutex
 }
 
 // New creates new Lachesis RPC connection bridge.
@@ -54,6 +71,20 @@ func New(cfg *config.Config, log logger.Logger) (*FtmBridge, error) {
 		rpc: client,
 		eth: con,
 		log: log,
+
+		// keep the fMint configuration
+		fMintCfg: fMintConfig{
+			addressProvider: common.HexToAddress(cfg.DefiFMintAddressProvider),
+		},
+
+		// keep the Uniswap configuration
+		uniswapCfg: uniswapConfig{
+			core:   common.HexToAddress(cfg.DefiUniswapCore),
+			router: common.HexToAddress(cfg.DefiUniswapRouter),
+		},
+
+		// keep the staker information contract address
+		stiContract: common.HexToAddress(cfg.StakerInfoContract),
 	}
 
 	return br, nil
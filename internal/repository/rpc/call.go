@@ -0,0 +1,282 @@
+/*
+Package rpc implements bridge to Lachesis full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Lachesis node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Lachesis RPC interface for a remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Lachesis RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Lachesis RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Call executes a read only call of the contract at the given address
+// on the state of the given block, or the latest block if the block is not specified.
+func (ftm *FtmBridge) Call(to *common.Address, data []byte, from *common.Address, block *hexutil.Uint64) ([]byte, error) {
+	// keep track of the operation
+	ftm.log.Debugf("calling contract %s", to.String())
+
+	// prep the call message
+	msg := ethereum.CallMsg{To: to, Data: data}
+	if from != nil {
+		msg.From = *from
+	}
+
+	// the block to be used, if any
+	var num *big.Int
+	if block != nil {
+		num = new(big.Int).SetUint64(uint64(*block))
+	}
+
+	// do the call
+	res, err := ftm.eth.CallContract(context.Background(), msg, num)
+	if err != nil {
+		ftm.log.Errorf("contract %s call failed; %s", to.String(), err.Error())
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// CallAbi executes a read only call of the given method of the contract at the given address
+// using the contract ABI to encode the arguments and decode the output values.
+// Arguments are provided in their text form, array arguments as JSON arrays.
+// The output values are returned as a JSON array.
+func (ftm *FtmBridge) CallAbi(addr *common.Address, abiJson string, method string, args []string, block *hexutil.Uint64) (string, error) {
+	// parse the ABI
+	parsed, err := abi.JSON(strings.NewReader(abiJson))
+	if err != nil {
+		ftm.log.Errorf("invalid ABI of contract %s; %s", addr.String(), err.Error())
+		return "", err
+	}
+
+	// find the method
+	m, ok := parsed.Methods[method]
+	if !ok {
+		return "", fmt.Errorf("method %s not found in contract %s ABI", method, addr.String())
+	}
+
+	// check the arguments
+	if len(args) != len(m.Inputs) {
+		return "", fmt.Errorf("method %s expects %d arguments, %d given", method, len(m.Inputs), len(args))
+	}
+
+	// convert the arguments
+	values := make([]interface{}, len(args))
+	for i, in := range m.Inputs {
+		values[i], err = abiValue(in.Type, args[i])
+		if err != nil {
+			return "", fmt.Errorf("invalid argument #%d of %s; %s", i, method, err.Error())
+		}
+	}
+
+	// encode the call
+	data, err := parsed.Pack(method, values...)
+	if err != nil {
+		return "", err
+	}
+
+	// do the call
+	res, err := ftm.Call(addr, data, nil, block)
+	if err != nil {
+		return "", err
+	}
+
+	// decode the output
+	out, err := m.Outputs.UnpackValues(res)
+	if err != nil {
+		ftm.log.Errorf("can not decode %s output; %s", method, err.Error())
+		return "", err
+	}
+
+	// make the JSON
+	enc, err := json.Marshal(jsonValues(out))
+	if err != nil {
+		return "", err
+	}
+	return string(enc), nil
+}
+
+// abiValue converts the text form of an argument into the value expected by the ABI type.
+func abiValue(t abi.Type, val string) (interface{}, error) {
+	switch t.T {
+	case abi.AddressTy:
+		if !common.IsHexAddress(val) {
+			return nil, fmt.Errorf("invalid address %s", val)
+		}
+		return common.HexToAddress(val), nil
+
+	case abi.BoolTy:
+		return strconv.ParseBool(val)
+
+	case abi.StringTy:
+		return val, nil
+
+	case abi.IntTy, abi.UintTy:
+		return abiIntValue(t, val)
+
+	case abi.BytesTy:
+		return hexutil.Decode(val)
+
+	case abi.FixedBytesTy:
+		return abiFixedBytesValue(t, val)
+
+	case abi.SliceTy, abi.ArrayTy:
+		return abiListValue(t, val)
+	}
+	return nil, fmt.Errorf("unsupported argument type %s", t.String())
+}
+
+// abiIntValue converts the decimal, or hex number into the integer type expected by the ABI type.
+func abiIntValue(t abi.Type, val string) (interface{}, error) {
+	// parse the number
+	num, ok := new(big.Int).SetString(val, 0)
+	if !ok {
+		return nil, fmt.Errorf("invalid number %s", val)
+	}
+
+	// make sure the value fits the ABI type
+	if !isAbiIntInRange(t, num) {
+		return nil, fmt.Errorf("number %s out of range of %s", val, t.String())
+	}
+
+	// big numbers are used as they are
+	rt := t.GetType()
+	if rt == reflect.TypeOf(num) {
+		return num, nil
+	}
+
+	// native types are converted from the matching 64 bit value
+	if t.T == abi.IntTy {
+		return reflect.ValueOf(num.Int64()).Convert(rt).Interface(), nil
+	}
+	return reflect.ValueOf(num.Uint64()).Convert(rt).Interface(), nil
+}
+
+// isAbiIntInRange checks if the number fits the size of the ABI integer type.
+// Unsigned types can not be negative, signed types reserve the top bit for the sign.
+func isAbiIntInRange(t abi.Type, num *big.Int) bool {
+	if t.T == abi.UintTy {
+		return num.Sign() >= 0 && num.BitLen() <= t.Size
+	}
+
+	// the bit length of a negative number x is checked on -x-1, e.g. -128 fits int8
+	abs := num
+	if num.Sign() < 0 {
+		abs = new(big.Int).Not(num)
+	}
+	return abs.BitLen() < t.Size
+}
+
+// abiFixedBytesValue converts the hex encoded bytes into the fixed size byte array expected by the ABI type.
+func abiFixedBytesValue(t abi.Type, val string) (interface{}, error) {
+	// decode the bytes
+	b, err := hexutil.Decode(val)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) > t.Size {
+		return nil, fmt.Errorf("value %s exceeds %d bytes", val, t.Size)
+	}
+
+	// copy to the array
+	arr := reflect.New(t.GetType()).Elem()
+	reflect.Copy(arr, reflect.ValueOf(b))
+	return arr.Interface(), nil
+}
+
+// abiListValue converts the JSON array into the slice, or array expected by the ABI type.
+func abiListValue(t abi.Type, val string) (interface{}, error) {
+	// decode the elements
+	var raw []json.RawMessage
+	if err := json.Unmarshal([]byte(val), &raw); err != nil {
+		return nil, err
+	}
+
+	// prep the container
+	var list reflect.Value
+	if t.T == abi.SliceTy {
+		list = reflect.MakeSlice(t.GetType(), len(raw), len(raw))
+	} else {
+		if len(raw) != t.Size {
+			return nil, fmt.Errorf("expected %d elements, %d given", t.Size, len(raw))
+		}
+		list = reflect.New(t.GetType()).Elem()
+	}
+
+	// convert the elements; strings are unquoted, other JSON values are used as they are
+	for i, r := range raw {
+		var el string
+		if err := json.Unmarshal(r, &el); err != nil {
+			el = string(r)
+		}
+
+		v, err := abiValue(*t.Elem, el)
+		if err != nil {
+			return nil, err
+		}
+		list.Index(i).Set(reflect.ValueOf(v))
+	}
+
+	return list.Interface(), nil
+}
+
+// jsonValues converts the decoded output values into their JSON friendly form.
+// Big numbers are encoded as decimal strings, bytes and addresses as hex strings.
+func jsonValues(values []interface{}) []interface{} {
+	res := make([]interface{}, len(values))
+	for i, v := range values {
+		res[i] = jsonValue(reflect.ValueOf(v))
+	}
+	return res
+}
+
+// jsonValue converts a single decoded output value into its JSON friendly form.
+func jsonValue(v reflect.Value) interface{} {
+	// check the known types first
+	switch val := v.Interface().(type) {
+	case *big.Int:
+		return val.String()
+	case common.Address:
+		return val.String()
+	case []byte:
+		return hexutil.Encode(val)
+	}
+
+	// fixed size byte arrays and lists
+	switch v.Kind() {
+	case reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, v.Len())
+			for i := range b {
+				b[i] = byte(v.Index(i).Uint())
+			}
+			return hexutil.Encode(b)
+		}
+		fallthrough
+	case reflect.Slice:
+		list := make([]interface{}, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			list[i] = jsonValue(v.Index(i))
+		}
+		return list
+	}
+	return v.Interface()
+}
@@ -0,0 +1,131 @@
+/*
+Package rpc implements bridge to Lachesis full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Lachesis node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Lachesis RPC interface for a remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Lachesis RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Lachesis RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+// mustAbiType creates the ABI type of the given name for testing.
+func mustAbiType(t *testing.T, name string) abi.Type {
+	typ, err := abi.NewType(name, "", nil)
+	if err != nil {
+		t.Fatalf("invalid ABI type %s; %s", name, err.Error())
+	}
+	return typ
+}
+
+func TestAbiValue(t *testing.T) {
+	tests := []struct {
+		typ     string
+		val     string
+		want    interface{}
+		wantErr bool
+	}{
+		// native integers
+		{typ: "uint8", val: "255", want: uint8(255)},
+		{typ: "uint8", val: "0xff", want: uint8(255)},
+		{typ: "uint8", val: "256", wantErr: true},
+		{typ: "uint8", val: "300", wantErr: true},
+		{typ: "uint8", val: "-1", wantErr: true},
+		{typ: "uint32", val: "-1", wantErr: true},
+		{typ: "uint64", val: "18446744073709551615", want: uint64(18446744073709551615)},
+		{typ: "uint64", val: "18446744073709551616", wantErr: true},
+		{typ: "int8", val: "127", want: int8(127)},
+		{typ: "int8", val: "-128", want: int8(-128)},
+		{typ: "int8", val: "128", wantErr: true},
+		{typ: "int8", val: "-129", wantErr: true},
+		{typ: "int64", val: "-9223372036854775808", want: int64(-9223372036854775808)},
+		{typ: "int64", val: "9223372036854775808", wantErr: true},
+		{typ: "uint16", val: "abc", wantErr: true},
+
+		// big integers
+		{typ: "uint256", val: "115792089237316195423570985008687907853269984665640564039457584007913129639935", want: mustBig("115792089237316195423570985008687907853269984665640564039457584007913129639935")},
+		{typ: "uint256", val: "115792089237316195423570985008687907853269984665640564039457584007913129639936", wantErr: true},
+		{typ: "uint256", val: "-1", wantErr: true},
+		{typ: "int128", val: "-170141183460469231731687303715884105728", want: mustBig("-170141183460469231731687303715884105728")},
+		{typ: "int128", val: "170141183460469231731687303715884105728", wantErr: true},
+
+		// fixed size bytes
+		{typ: "bytes4", val: "0x01020304", want: [4]byte{1, 2, 3, 4}},
+		{typ: "bytes4", val: "0x0102", want: [4]byte{1, 2, 0, 0}},
+		{typ: "bytes4", val: "0x0102030405", wantErr: true},
+		{typ: "bytes4", val: "0102", wantErr: true},
+
+		// arrays and slices
+		{typ: "uint8[2]", val: `[1, "0x02"]`, want: [2]uint8{1, 2}},
+		{typ: "uint8[2]", val: `[1]`, wantErr: true},
+		{typ: "uint8[2]", val: `[1, 256]`, wantErr: true},
+		{typ: "uint16[]", val: `["1", 2, "0x03"]`, want: []uint16{1, 2, 3}},
+		{typ: "uint16[]", val: `[]`, want: []uint16{}},
+		{typ: "address[]", val: `["0x0000000000000000000000000000000000000001"]`, want: []common.Address{common.HexToAddress("0x1")}},
+		{typ: "bool[]", val: `not a list`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := abiValue(mustAbiType(t, tt.typ), tt.val)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("abiValue(%s, %s) = %v, expected error", tt.typ, tt.val, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("abiValue(%s, %s) failed; %s", tt.typ, tt.val, err.Error())
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("abiValue(%s, %s) = %#v, expected %#v", tt.typ, tt.val, got, tt.want)
+		}
+	}
+}
+
+func TestJsonValue(t *testing.T) {
+	tests := []struct {
+		name string
+		val  interface{}
+		want interface{}
+	}{
+		{name: "big int", val: mustBig("-123456789012345678901234567890"), want: "-123456789012345678901234567890"},
+		{name: "native int", val: uint8(7), want: uint8(7)},
+		{name: "bool", val: true, want: true},
+		{name: "string", val: "text", want: "text"},
+		{name: "address", val: common.HexToAddress("0x1"), want: "0x0000000000000000000000000000000000000001"},
+		{name: "bytes", val: []byte{0xde, 0xad}, want: "0xdead"},
+		{name: "fixed bytes", val: [4]byte{1, 2, 3, 4}, want: "0x01020304"},
+		{name: "array", val: [2]*big.Int{big.NewInt(1), big.NewInt(2)}, want: []interface{}{"1", "2"}},
+		{name: "slice", val: []common.Address{common.HexToAddress("0x2")}, want: []interface{}{"0x0000000000000000000000000000000000000002"}},
+		{name: "nested", val: [][2]byte{{0xab, 0xcd}}, want: []interface{}{"0xabcd"}},
+	}
+
+	for _, tt := range tests {
+		got := jsonValue(reflect.ValueOf(tt.val))
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("jsonValue(%s) = %#v, expected %#v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// mustBig parses the decimal number for testing.
+func mustBig(val string) *big.Int {
+	num, ok := new(big.Int).SetString(val, 10)
+	if !ok {
+		panic("invalid number " + val)
+	}
+	return num
+}
@@ -0,0 +1,91 @@
+/*
+Package rpc implements bridge to Lachesis full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Lachesis node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Lachesis RPC interface for a remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Lachesis RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Lachesis RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+import (
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ftm "github.com/ethereum/go-ethereum/rpc"
+)
+
+// ContractCode returns the runtime byte code deployed on the given address.
+func (ftm *FtmBridge) ContractCode(addr *common.Address) (hexutil.Bytes, error) {
+	// keep track of the operation
+	ftm.log.Debugf("loading byte code of contract %s", addr.Hex())
+
+	// use RPC to make the call
+	var code hexutil.Bytes
+	err := ftm.rpc.Call(&code, "eth_getCode", addr.Hex(), BlockTypeLatest)
+	if err != nil {
+		ftm.log.Errorf("can not get byte code of contract [%s]; %s", addr.Hex(), err.Error())
+		return nil, err
+	}
+
+	return code, nil
+}
+
+// traceCreateType represents the type of call trace deploying new contract.
+const traceCreateType = "create"
+
+// rpcMethodNotFoundCode is the JSON-RPC error code of a call to a method not available on the node.
+const rpcMethodNotFoundCode = -32601
+
+// IsMethodNotFound checks if the error signals the called RPC method is not available on the node.
+func IsMethodNotFound(err error) bool {
+	re, ok := err.(ftm.Error)
+	return ok && re.ErrorCode() == rpcMethodNotFoundCode
+}
+
+// ContractCreations returns the list of contracts deployed by other contracts
+// inside the given transaction. Transaction traces are used to detect the creation,
+// the full node must have the tracing API enabled.
+func (ftm *FtmBridge) ContractCreations(hash *types.Hash) ([]types.ContractCreation, error) {
+	// keep track of the operation
+	ftm.log.Debugf("tracing contract creations of transaction %s", hash.String())
+
+	// prep the trace container
+	var traces []struct {
+		Type   string `json:"type"`
+		Error  string `json:"error"`
+		Action struct {
+			From common.Address `json:"from"`
+		} `json:"action"`
+		Result *struct {
+			Address *common.Address `json:"address"`
+		} `json:"result"`
+		TraceAddress []uint64 `json:"traceAddress"`
+	}
+
+	// use RPC to make the call
+	err := ftm.rpc.Call(&traces, "trace_transaction", hash)
+	if err != nil {
+		ftm.log.Errorf("can not trace transaction %s; %s", hash.String(), err.Error())
+		return nil, err
+	}
+
+	// collect successful internal contract creations;
+	// the top level creation is covered by the transaction receipt
+	list := make([]types.ContractCreation, 0)
+	for _, tr := range traces {
+		if tr.Type == traceCreateType && 0 < len(tr.TraceAddress) && 0 == len(tr.Error) && tr.Result != nil && tr.Result.Address != nil {
+			list = append(list, types.ContractCreation{
+				Address: *tr.Result.Address,
+				Factory: tr.Action.From,
+			})
+		}
+	}
+
+	return list, nil
+}
@@ -0,0 +1,292 @@
+/*
+Package rpc implements bridge to Lachesis full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Lachesis node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Lachesis RPC interface for a remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Lachesis RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Lachesis RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+//go:generate abigen --abi ./contracts/defi-fmint-address-provider.abi --pkg rpc --type DefiFMintAddressProvider --out ./smc_fmint_address_provider.go
+//go:generate abigen --abi ./contracts/defi-fmint-minter.abi --pkg rpc --type DefiFMintMinter --out ./smc_fmint_minter.go
+//go:generate abigen --abi ./contracts/defi-token-storage.abi --pkg rpc --type DefiTokenStorage --out ./smc_defi_token_storage.go
+
+import (
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"math/big"
+)
+
+// defiRatioDecimals represents the number of decimals used by fMint
+// to express ratios and fees, i.e. 4 decimals represent 1.0 as 10000.
+const defiRatioDecimals = 4
+
+// fMintConfig represents the configuration of the fMint DeFi module.
+type fMintConfig struct {
+	// addressProvider is the address of the fMint address provider
+	// used to resolve all the other fMint contracts.
+	addressProvider common.Address
+}
+
+// fMintAddressProvider returns an instance of the fMint address provider contract.
+func (ftm *FtmBridge) fMintAddressProvider() (*DefiFMintAddressProvider, error) {
+	// instantiate the contract
+	contract, err := NewDefiFMintAddressProvider(ftm.fMintCfg.addressProvider, ftm.eth)
+	if err != nil {
+		ftm.log.Criticalf("failed to instantiate fMint address provider: %v", err)
+		return nil, err
+	}
+
+	return contract, nil
+}
+
+// fMintMinter returns an instance of the fMint minter contract
+// resolved through the address provider.
+func (ftm *FtmBridge) fMintMinter() (*DefiFMintMinter, error) {
+	// get the address provider
+	ap, err := ftm.fMintAddressProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	// resolve the minter address
+	addr, err := ap.GetFantomMint(nil)
+	if err != nil {
+		ftm.log.Errorf("fMint minter address not available; %v", err)
+		return nil, err
+	}
+
+	// instantiate the contract
+	contract, err := NewDefiFMintMinter(addr, ftm.eth)
+	if err != nil {
+		ftm.log.Criticalf("failed to instantiate fMint minter: %v", err)
+		return nil, err
+	}
+
+	return contract, nil
+}
+
+// DefiConfiguration resolves the current configuration of the fMint DeFi module.
+func (ftm *FtmBridge) DefiConfiguration() (*types.DefiSettings, error) {
+	// get the address provider
+	ap, err := ftm.fMintAddressProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	// prep the result
+	ds := types.DefiSettings{
+		Decimals:             defiRatioDecimals,
+		FMintAddressProvider: ftm.fMintCfg.addressProvider,
+	}
+
+	// resolve addresses of all the fMint contracts
+	if err := ftm.defiAddresses(ap, &ds); err != nil {
+		return nil, err
+	}
+
+	// get the minter so we can load the settings
+	minter, err := NewDefiFMintMinter(ds.FMintContract, ftm.eth)
+	if err != nil {
+		ftm.log.Criticalf("failed to instantiate fMint minter: %v", err)
+		return nil, err
+	}
+
+	// load the fee and ratio settings
+	if err := ftm.defiRatios(minter, &ds); err != nil {
+		return nil, err
+	}
+
+	return &ds, nil
+}
+
+// defiAddresses resolves addresses of the fMint contracts
+// using the fMint address provider.
+func (ftm *FtmBridge) defiAddresses(ap *DefiFMintAddressProvider, ds *types.DefiSettings) error {
+	var err error
+
+	// the list of addresses to be loaded
+	list := []struct {
+		target *common.Address
+		loader func() (common.Address, error)
+	}{
+		{&ds.FMintContract, func() (common.Address, error) { return ap.GetFantomMint(nil) }},
+		{&ds.FMintCollateralPool, func() (common.Address, error) { return ap.GetCollateralPool(nil) }},
+		{&ds.FMintDebtPool, func() (common.Address, error) { return ap.GetDebtPool(nil) }},
+		{&ds.PriceOracleAggregate, func() (common.Address, error) { return ap.GetPriceOracleProxy(nil) }},
+		{&ds.TokenRegistry, func() (common.Address, error) { return ap.GetTokenRegistry(nil) }},
+		{&ds.FMintRewardDistribution, func() (common.Address, error) { return ap.GetRewardDistribution(nil) }},
+	}
+
+	// load all of them
+	for _, item := range list {
+		*item.target, err = item.loader()
+		if err != nil {
+			ftm.log.Errorf("fMint contract address not available; %v", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// defiRatios loads the fee and ratio settings of the fMint minter.
+func (ftm *FtmBridge) defiRatios(minter *DefiFMintMinter, ds *types.DefiSettings) error {
+	// get the minting fee
+	val, err := minter.GetFMintFee4dec(nil)
+	if err != nil {
+		ftm.log.Errorf("fMint fee not available; %v", err)
+		return err
+	}
+	ds.MintFee4 = hexutil.Big(*val)
+
+	// get the lowest allowed collateral to debt ratio
+	val, err = minter.GetCollateralLowestDebtRatio4dec(nil)
+	if err != nil {
+		ftm.log.Errorf("fMint collateral ratio not available; %v", err)
+		return err
+	}
+	ds.MinCollateralRatio4 = hexutil.Big(*val)
+
+	// get the collateral ratio needed for rewards eligibility
+	val, err = minter.GetRewardEligibilityRatio4dec(nil)
+	if err != nil {
+		ftm.log.Errorf("fMint reward ratio not available; %v", err)
+		return err
+	}
+	ds.RewardCollateralRatio4 = hexutil.Big(*val)
+
+	return nil
+}
+
+// FMintAccount loads the collateral and debt positions of the given account
+// in the fMint DeFi module.
+func (ftm *FtmBridge) FMintAccount(owner *common.Address) (*types.FMintAccount, error) {
+	// get the minter
+	minter, err := ftm.fMintMinter()
+	if err != nil {
+		return nil, err
+	}
+
+	// prep the result
+	fa := types.FMintAccount{Address: *owner}
+
+	// get the collateral pool address
+	pool, err := minter.GetCollateralPool(nil)
+	if err != nil {
+		ftm.log.Errorf("fMint collateral pool not available; %v", err)
+		return nil, err
+	}
+
+	// load the collateral
+	fa.Collateral, fa.CollateralValue, err = ftm.defiPoolBalances(&pool, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	// get the debt pool address
+	pool, err = minter.GetDebtPool(nil)
+	if err != nil {
+		ftm.log.Errorf("fMint debt pool not available; %v", err)
+		return nil, err
+	}
+
+	// load the debt
+	fa.Debt, fa.DebtValue, err = ftm.defiPoolBalances(&pool, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	// calculate the collateral to debt ratio, if there is a debt
+	if 0 < fa.DebtValue.ToInt().Sign() {
+		ratio := new(big.Int).Mul(fa.CollateralValue.ToInt(), new(big.Int).Exp(big.NewInt(10), big.NewInt(defiRatioDecimals), nil))
+		ratio.Div(ratio, fa.DebtValue.ToInt())
+		fa.CollateralRatio4 = (*hexutil.Big)(ratio)
+	}
+
+	return &fa, nil
+}
+
+// defiPoolBalances loads non-zero token balances of the account in the given
+// fMint token storage pool and the total value of the account in the pool.
+func (ftm *FtmBridge) defiPoolBalances(pool *common.Address, owner *common.Address) ([]types.FMintTokenBalance, hexutil.Big, error) {
+	// instantiate the pool contract
+	contract, err := NewDefiTokenStorage(*pool, ftm.eth)
+	if err != nil {
+		ftm.log.Criticalf("failed to instantiate fMint token storage: %v", err)
+		return nil, hexutil.Big{}, err
+	}
+
+	// get the total value of the account
+	total, err := contract.TotalOf(nil, *owner)
+	if err != nil {
+		ftm.log.Errorf("fMint pool value of %s not available; %v", owner.String(), err)
+		return nil, hexutil.Big{}, err
+	}
+
+	// how many tokens are in the pool
+	count, err := contract.TokensCount(nil)
+	if err != nil {
+		ftm.log.Errorf("fMint pool tokens count not available; %v", err)
+		return nil, hexutil.Big{}, err
+	}
+
+	// collect balances of all the tokens
+	list := make([]types.FMintTokenBalance, 0)
+	for i := int64(0); i < count.Int64(); i++ {
+		tb, err := ftm.defiTokenBalance(contract, big.NewInt(i), owner)
+		if err != nil {
+			return nil, hexutil.Big{}, err
+		}
+
+		// skip empty balances
+		if tb != nil {
+			list = append(list, *tb)
+		}
+	}
+
+	return list, hexutil.Big(*total), nil
+}
+
+// defiTokenBalance loads the balance and value of a token in the fMint token
+// storage identified by its index. Nil is returned for zero balance.
+func (ftm *FtmBridge) defiTokenBalance(contract *DefiTokenStorage, ix *big.Int, owner *common.Address) (*types.FMintTokenBalance, error) {
+	// get the token address
+	token, err := contract.Tokens(nil, ix)
+	if err != nil {
+		ftm.log.Errorf("fMint pool token #%d not available; %v", ix.Int64(), err)
+		return nil, err
+	}
+
+	// get the balance
+	balance, err := contract.BalanceOf(nil, *owner, token)
+	if err != nil {
+		ftm.log.Errorf("fMint pool balance of %s not available; %v", token.String(), err)
+		return nil, err
+	}
+
+	// skip empty balance
+	if 0 == balance.Sign() {
+		return nil, nil
+	}
+
+	// get the value of the balance
+	value, err := contract.TokenValue(nil, token, balance)
+	if err != nil {
+		ftm.log.Errorf("fMint pool value of %s not available; %v", token.String(), err)
+		return nil, err
+	}
+
+	return &types.FMintTokenBalance{
+		Token:   token,
+		Balance: hexutil.Big(*balance),
+		Value:   hexutil.Big(*value),
+	}, nil
+}
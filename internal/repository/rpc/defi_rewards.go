@@ -0,0 +1,252 @@
+/*
+Package rpc implements bridge to Lachesis full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Lachesis node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Lachesis RPC interface for a remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Lachesis RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Lachesis RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+//go:generate abigen --abi ./contracts/defi-fmint-reward-distribution.abi --pkg rpc --type DefiFMintRewardDistribution --out ./smc_fmint_reward_distribution.go
+
+import (
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eth "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"math/big"
+	"time"
+)
+
+// rewardPaidEventTopic represents the topic of the RewardPaid event.
+var rewardPaidEventTopic = crypto.Keccak256Hash([]byte("RewardPaid(address,uint256)"))
+
+// fMintRewardDistribution returns an instance of the fMint reward distribution
+// contract resolved through the address provider, and its address.
+func (ftm *FtmBridge) fMintRewardDistribution() (*DefiFMintRewardDistribution, common.Address, error) {
+	// get the address provider
+	ap, err := ftm.fMintAddressProvider()
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	// resolve the reward distribution address
+	addr, err := ap.GetRewardDistribution(nil)
+	if err != nil {
+		ftm.log.Errorf("fMint reward distribution address not available; %v", err)
+		return nil, common.Address{}, err
+	}
+
+	// instantiate the contract
+	contract, err := NewDefiFMintRewardDistribution(addr, ftm.eth)
+	if err != nil {
+		ftm.log.Criticalf("failed to instantiate fMint reward distribution: %v", err)
+		return nil, common.Address{}, err
+	}
+
+	return contract, addr, nil
+}
+
+// FMintRewardPool loads the state of the fMint reward distribution pool.
+func (ftm *FtmBridge) FMintRewardPool() (*types.FMintRewardPool, error) {
+	// get the reward distribution contract
+	contract, addr, err := ftm.fMintRewardDistribution()
+	if err != nil {
+		return nil, err
+	}
+
+	// prep the result
+	rp := types.FMintRewardPool{Address: addr}
+
+	// get the reward token
+	rp.RewardToken, err = contract.RewardTokenAddress(nil)
+	if err != nil {
+		ftm.log.Errorf("fMint reward token not available; %v", err)
+		return nil, err
+	}
+
+	// the list of values to be loaded
+	list := []struct {
+		name   string
+		loader func() (*big.Int, error)
+		apply  func(*big.Int)
+	}{
+		{"reward rate", func() (*big.Int, error) { return contract.RewardRate(nil) }, func(v *big.Int) { rp.RewardRate = hexutil.Big(*v) }},
+		{"total principal", func() (*big.Int, error) { return contract.PrincipalBalance(nil) }, func(v *big.Int) { rp.TotalPrincipal = hexutil.Big(*v) }},
+		{"epoch length", func() (*big.Int, error) { return contract.RewardEpochLength(nil) }, func(v *big.Int) { rp.EpochLength = hexutil.Uint64(v.Uint64()) }},
+		{"epoch end", func() (*big.Int, error) { return contract.RewardEpochEnds(nil) }, func(v *big.Int) { rp.EpochEnds = hexutil.Uint64(v.Uint64()) }},
+		{"last push", func() (*big.Int, error) { return contract.LastRewardPush(nil) }, func(v *big.Int) { rp.LastRewardPush = hexutil.Uint64(v.Uint64()) }},
+		{"applicable until", func() (*big.Int, error) { return contract.RewardApplicableUntil(nil) }, func(v *big.Int) { rp.RewardApplicableUntil = hexutil.Uint64(v.Uint64()) }},
+	}
+
+	// load all of them
+	for _, item := range list {
+		val, err := item.loader()
+		if err != nil {
+			ftm.log.Errorf("fMint reward pool %s not available; %v", item.name, err)
+			return nil, err
+		}
+		item.apply(val)
+	}
+
+	return &rp, nil
+}
+
+// FMintRewards loads the state of fMint rewards of the given account.
+func (ftm *FtmBridge) FMintRewards(owner *common.Address) (*types.FMintRewards, error) {
+	// get the reward distribution contract
+	contract, _, err := ftm.fMintRewardDistribution()
+	if err != nil {
+		return nil, err
+	}
+
+	// prep the result
+	rw := types.FMintRewards{Address: *owner}
+
+	// get the principal
+	val, err := contract.PrincipalBalanceOf(nil, *owner)
+	if err != nil {
+		ftm.log.Errorf("fMint reward principal of %s not available; %v", owner.String(), err)
+		return nil, err
+	}
+	rw.Principal = hexutil.Big(*val)
+
+	// get the earned reward
+	val, err = contract.RewardEarned(nil, *owner)
+	if err != nil {
+		ftm.log.Errorf("fMint reward earned by %s not available; %v", owner.String(), err)
+		return nil, err
+	}
+	rw.Earned = hexutil.Big(*val)
+
+	// get the stashed reward
+	val, err = contract.RewardStash(nil, *owner)
+	if err != nil {
+		ftm.log.Errorf("fMint reward stash of %s not available; %v", owner.String(), err)
+		return nil, err
+	}
+	rw.Stashed = hexutil.Big(*val)
+
+	// can the account claim?
+	rw.CanClaim, err = contract.RewardCanClaim(nil, *owner)
+	if err != nil {
+		ftm.log.Errorf("fMint reward claim status of %s not available; %v", owner.String(), err)
+		return nil, err
+	}
+
+	// is the account eligible?
+	rw.IsEligible, err = contract.RewardIsEligible(nil, *owner)
+	if err != nil {
+		ftm.log.Errorf("fMint reward eligibility of %s not available; %v", owner.String(), err)
+		return nil, err
+	}
+
+	// the earned reward is what the claim pays; the pending reward is not accumulated yet
+	rw.Unlocked = rw.Earned
+	rw.Pending, err = ftm.fMintPendingReward(contract, &rw.Principal)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rw, nil
+}
+
+// fMintPendingReward calculates the reward the given principal accumulates
+// from now until the end of the pushed reward period at the current reward rate.
+// The distribution contract accumulates the reward the same way.
+func (ftm *FtmBridge) fMintPendingReward(contract *DefiFMintRewardDistribution, principal *hexutil.Big) (hexutil.Big, error) {
+	// no principal, no reward
+	if 0 == principal.ToInt().Sign() {
+		return hexutil.Big{}, nil
+	}
+
+	// how long the rewards are accumulated
+	until, err := contract.RewardApplicableUntil(nil)
+	if err != nil {
+		ftm.log.Errorf("fMint reward applicable time not available; %v", err)
+		return hexutil.Big{}, err
+	}
+	left := new(big.Int).Sub(until, big.NewInt(time.Now().UTC().Unix()))
+	if left.Sign() <= 0 {
+		return hexutil.Big{}, nil
+	}
+
+	// get the reward rate
+	rate, err := contract.RewardRate(nil)
+	if err != nil {
+		ftm.log.Errorf("fMint reward rate not available; %v", err)
+		return hexutil.Big{}, err
+	}
+
+	// get the total principal
+	total, err := contract.PrincipalBalance(nil)
+	if err != nil {
+		ftm.log.Errorf("fMint total principal not available; %v", err)
+		return hexutil.Big{}, err
+	}
+	if 0 == total.Sign() {
+		return hexutil.Big{}, nil
+	}
+
+	// the share of the principal on the reward distributed until the period end
+	val := new(big.Int).Mul(left, rate)
+	val.Mul(val, principal.ToInt())
+	return hexutil.Big(*val.Div(val, total)), nil
+}
+
+// FMintRewardClaims extracts the list of fMint rewards paid by the given transaction
+// from its event logs. Rewards claimed through other contracts are included as well.
+func (ftm *FtmBridge) FMintRewardClaims(trx *types.Transaction, logs []*eth.Log) ([]types.FMintRewardClaim, error) {
+	// check the topic first so we don't resolve the contract for every transaction
+	if !hasLogTopic(logs, rewardPaidEventTopic) {
+		return nil, nil
+	}
+
+	// get the reward distribution contract
+	contract, addr, err := ftm.fMintRewardDistribution()
+	if err != nil {
+		return nil, err
+	}
+
+	// collect the rewards paid
+	list := make([]types.FMintRewardClaim, 0)
+	for _, l := range logs {
+		// is this the reward paid event of the distribution contract?
+		if l.Address != addr || 0 == len(l.Topics) || l.Topics[0] != rewardPaidEventTopic {
+			continue
+		}
+
+		// decode the event
+		evt, err := contract.ParseRewardPaid(*l)
+		if err != nil {
+			ftm.log.Errorf("can not decode reward paid event of %s; %v", trx.Hash.String(), err)
+			return nil, err
+		}
+
+		list = append(list, types.FMintRewardClaim{
+			Account:         evt.User,
+			Amount:          hexutil.Big(*evt.Reward),
+			TransactionHash: trx.Hash,
+			LogIndex:        hexutil.Uint64(l.Index),
+		})
+	}
+
+	return list, nil
+}
+
+// hasLogTopic checks if any of the given event logs has the given event topic.
+func hasLogTopic(logs []*eth.Log, topic common.Hash) bool {
+	for _, l := range logs {
+		if 0 < len(l.Topics) && l.Topics[0] == topic {
+			return true
+		}
+	}
+	return false
+}
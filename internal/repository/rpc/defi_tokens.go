@@ -0,0 +1,143 @@
+/*
+Package rpc implements bridge to Lachesis full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Lachesis node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Lachesis RPC interface for a remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Lachesis RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Lachesis RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+//go:generate abigen --abi ./contracts/defi-tokens-registry.abi --pkg rpc --type DefiTokenRegistry --out ./smc_defi_token_registry.go
+//go:generate abigen --abi ./contracts/price-oracle-proxy-interface.abi --pkg rpc --type PriceOracleProxy --out ./smc_price_oracle.go
+
+import (
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"math/big"
+)
+
+// defiTokenRegistry returns an instance of the DeFi tokens registry contract
+// resolved through the fMint address provider.
+func (ftm *FtmBridge) defiTokenRegistry() (*DefiTokenRegistry, error) {
+	// get the address provider
+	ap, err := ftm.fMintAddressProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	// resolve the registry address
+	addr, err := ap.GetTokenRegistry(nil)
+	if err != nil {
+		ftm.log.Errorf("DeFi token registry address not available; %v", err)
+		return nil, err
+	}
+
+	// instantiate the contract
+	contract, err := NewDefiTokenRegistry(addr, ftm.eth)
+	if err != nil {
+		ftm.log.Criticalf("failed to instantiate DeFi token registry: %v", err)
+		return nil, err
+	}
+
+	return contract, nil
+}
+
+// DefiTokens loads the list of tokens registered in the DeFi tokens registry.
+func (ftm *FtmBridge) DefiTokens() ([]*types.DefiToken, error) {
+	// get the registry
+	reg, err := ftm.defiTokenRegistry()
+	if err != nil {
+		return nil, err
+	}
+
+	// how many tokens are registered
+	count, err := reg.TokensCount(nil)
+	if err != nil {
+		ftm.log.Errorf("DeFi tokens count not available; %v", err)
+		return nil, err
+	}
+
+	// load all the tokens
+	list := make([]*types.DefiToken, 0, count.Int64())
+	for i := int64(0); i < count.Int64(); i++ {
+		// get the token address
+		addr, err := reg.TokensList(nil, big.NewInt(i))
+		if err != nil {
+			ftm.log.Errorf("DeFi token #%d not available; %v", i, err)
+			return nil, err
+		}
+
+		// get the token details
+		token, err := ftm.defiToken(reg, &addr)
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, token)
+	}
+
+	return list, nil
+}
+
+// defiToken loads details of the given token from the DeFi tokens registry.
+func (ftm *FtmBridge) defiToken(reg *DefiTokenRegistry, addr *common.Address) (*types.DefiToken, error) {
+	// get the token details
+	ti, err := reg.Tokens(nil, *addr)
+	if err != nil {
+		ftm.log.Errorf("DeFi token %s details not available; %v", addr.String(), err)
+		return nil, err
+	}
+
+	return &types.DefiToken{
+		Address:       *addr,
+		Name:          ti.Name,
+		Symbol:        ti.Symbol,
+		Decimals:      int32(ti.Decimals),
+		LogoUrl:       ti.Logo,
+		PriceDecimals: int32(ti.PriceDecimals),
+		IsActive:      ti.IsActive,
+		CanDeposit:    ti.IsActive && ti.CanDeposit,
+		CanMint:       ti.IsActive && ti.CanMint,
+		CanTrade:      ti.IsActive && ti.Oracle != common.Address{},
+	}, nil
+}
+
+// DefiTokenPrice loads the current price of the given token
+// from the DeFi price oracle.
+func (ftm *FtmBridge) DefiTokenPrice(token *common.Address) (hexutil.Big, error) {
+	// get the address provider
+	ap, err := ftm.fMintAddressProvider()
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+
+	// resolve the price oracle address
+	addr, err := ap.GetPriceOracleProxy(nil)
+	if err != nil {
+		ftm.log.Errorf("DeFi price oracle address not available; %v", err)
+		return hexutil.Big{}, err
+	}
+
+	// instantiate the contract
+	contract, err := NewPriceOracleProxy(addr, ftm.eth)
+	if err != nil {
+		ftm.log.Criticalf("failed to instantiate DeFi price oracle: %v", err)
+		return hexutil.Big{}, err
+	}
+
+	// get the price
+	val, err := contract.GetPrice(nil, *token)
+	if err != nil {
+		ftm.log.Errorf("price of %s not available; %v", token.String(), err)
+		return hexutil.Big{}, err
+	}
+
+	return hexutil.Big(*val), nil
+}
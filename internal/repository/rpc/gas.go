@@ -0,0 +1,51 @@
+/*
+Package rpc implements bridge to Lachesis full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Lachesis node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Lachesis RPC interface for a remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Lachesis RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Lachesis RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+import (
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// GasPrice returns the current gas price suggested by the connected node.
+func (ftm *FtmBridge) GasPrice() (*hexutil.Big, error) {
+	// keep track of the operation
+	ftm.log.Debug("loading gas price")
+
+	// call for data
+	var price hexutil.Big
+	err := ftm.rpc.Call(&price, "eth_gasPrice")
+	if err != nil {
+		ftm.log.Errorf("gas price could not be obtained; %s", err.Error())
+		return nil, err
+	}
+
+	return &price, nil
+}
+
+// EstimateGas returns the amount of gas needed to execute the given call.
+func (ftm *FtmBridge) EstimateGas(call *types.CallInput) (*hexutil.Uint64, error) {
+	// keep track of the operation
+	ftm.log.Debug("estimating gas")
+
+	// call for data
+	var gas hexutil.Uint64
+	err := ftm.rpc.Call(&gas, "eth_estimateGas", call)
+	if err != nil {
+		ftm.log.Errorf("gas could not be estimated; %s", err.Error())
+		return nil, err
+	}
+
+	return &gas, nil
+}
@@ -0,0 +1,334 @@
+/*
+Package rpc implements bridge to Lachesis full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Lachesis node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Lachesis RPC interface for a remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Lachesis RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Lachesis RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+//go:generate abigen --abi ./contracts/governance.abi --pkg rpc --type GovernanceContract --out ./smc_governance.go
+//go:generate abigen --abi ./contracts/governance-proposal.abi --pkg rpc --type GovernanceProposal --out ./smc_governance_proposal.go
+
+import (
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eth "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"math/big"
+)
+
+const (
+	// govStatusResolved is the governance proposal status flag of a resolved proposal.
+	govStatusResolved = 1
+
+	// govStatusFailed is the governance proposal status flag of a failed proposal.
+	govStatusFailed = 1 << 1
+
+	// govStatusCanceled is the governance proposal status flag of a canceled proposal.
+	govStatusCanceled = 1 << 2
+
+	// govStatusExpired is the governance proposal status flag of an expired proposal.
+	govStatusExpired = 1 << 3
+)
+
+var (
+	// govVotedEventTopic represents the topic of the governance Voted event.
+	govVotedEventTopic = crypto.Keccak256Hash([]byte("Voted(address,address,uint256,uint256[],uint256)"))
+
+	// govVoteCanceledEventTopic represents the topic of the governance VoteCanceled event.
+	govVoteCanceledEventTopic = crypto.Keccak256Hash([]byte("VoteCanceled(address,address,uint256)"))
+
+	// govProposalEventTopics represents the topics of the governance proposal state change events.
+	govProposalEventTopics = map[common.Hash]bool{
+		crypto.Keccak256Hash([]byte("ProposalCreated(uint256)")):          true,
+		crypto.Keccak256Hash([]byte("ProposalResolved(uint256)")):         true,
+		crypto.Keccak256Hash([]byte("ProposalRejected(uint256)")):         true,
+		crypto.Keccak256Hash([]byte("ProposalCanceled(uint256)")):         true,
+		crypto.Keccak256Hash([]byte("ProposalExecutionExpired(uint256)")): true,
+	}
+)
+
+// governanceContract returns an instance of the governance contract on the given address.
+func (ftm *FtmBridge) governanceContract(gov *common.Address) (*GovernanceContract, error) {
+	contract, err := NewGovernanceContract(*gov, ftm.eth)
+	if err != nil {
+		ftm.log.Criticalf("failed to instantiate governance contract: %v", err)
+		return nil, err
+	}
+	return contract, nil
+}
+
+// GovernanceProposalsCount returns the number of proposals of the governance contract.
+func (ftm *FtmBridge) GovernanceProposalsCount(gov *common.Address) (hexutil.Uint64, error) {
+	// get the contract
+	contract, err := ftm.governanceContract(gov)
+	if err != nil {
+		return 0, err
+	}
+
+	// get the last proposal id; the ids start from 1
+	id, err := contract.LastProposalID(nil)
+	if err != nil {
+		ftm.log.Errorf("last proposal of governance %s not available; %v", gov.String(), err)
+		return 0, err
+	}
+
+	return hexutil.Uint64(id.Uint64()), nil
+}
+
+// GovernanceProposal loads the details and the state of the governance proposal.
+func (ftm *FtmBridge) GovernanceProposal(gov *common.Address, id hexutil.Uint64) (*types.GovernanceProposal, error) {
+	// get the contract
+	contract, err := ftm.governanceContract(gov)
+	if err != nil {
+		return nil, err
+	}
+
+	// get the proposal parameters
+	pid := new(big.Int).SetUint64(uint64(id))
+	par, err := contract.ProposalParams(nil, pid)
+	if err != nil {
+		ftm.log.Errorf("proposal #%d of governance %s not available; %v", id, gov.String(), err)
+		return nil, err
+	}
+
+	// prep the result
+	prop := types.GovernanceProposal{
+		Governance:    *gov,
+		Id:            id,
+		Contract:      par.ProposalContract,
+		ProposalType:  hexutil.Uint64(par.PType.Uint64()),
+		IsExecutable:  par.Executable,
+		MinVotes:      hexutil.Big(*par.MinVotes),
+		MinAgreement:  hexutil.Big(*par.MinAgreement),
+		OpinionScales: make([]hexutil.Big, len(par.OpinionScales)),
+		VotingStarts:  hexutil.Uint64(par.VotingStartTime.Uint64()),
+		VotingMayEnd:  hexutil.Uint64(par.VotingMinEndTime.Uint64()),
+		VotingMustEnd: hexutil.Uint64(par.VotingMaxEndTime.Uint64()),
+	}
+	for i, sc := range par.OpinionScales {
+		prop.OpinionScales[i] = hexutil.Big(*sc)
+	}
+
+	// get the proposal state
+	if err := ftm.governanceProposalState(contract, &prop); err != nil {
+		return nil, err
+	}
+
+	// get the options with their results
+	if prop.Options, err = ftm.governanceOptions(contract, &prop, par.Options); err != nil {
+		return nil, err
+	}
+
+	// get the proposal description; it's optional
+	ftm.governanceProposalDescription(&prop)
+	return &prop, nil
+}
+
+// governanceProposalState loads the status and the total votes of the proposal.
+func (ftm *FtmBridge) governanceProposalState(contract *GovernanceContract, prop *types.GovernanceProposal) error {
+	// get the state
+	st, err := contract.ProposalState(nil, new(big.Int).SetUint64(uint64(prop.Id)))
+	if err != nil {
+		ftm.log.Errorf("state of proposal #%d not available; %v", prop.Id, err)
+		return err
+	}
+
+	// decode the status flags
+	prop.Votes = hexutil.Big(*st.Votes)
+	switch status := st.Status.Uint64(); {
+	case status&govStatusExpired != 0:
+		prop.Status = types.GovernanceStatusExpired
+	case status&govStatusCanceled != 0:
+		prop.Status = types.GovernanceStatusCanceled
+	case status&govStatusFailed != 0:
+		prop.Status = types.GovernanceStatusFailed
+	case status&govStatusResolved != 0:
+		prop.Status = types.GovernanceStatusResolved
+	default:
+		prop.Status = types.GovernanceStatusActive
+	}
+
+	// the winner is known for resolved proposals only
+	if prop.Status == types.GovernanceStatusResolved || prop.Status == types.GovernanceStatusExpired {
+		win := hexutil.Uint64(st.WinnerOptionID.Uint64())
+		prop.WinnerId = &win
+	}
+
+	return nil
+}
+
+// governanceOptions loads the options of the proposal with their weighted results.
+func (ftm *FtmBridge) governanceOptions(contract *GovernanceContract, prop *types.GovernanceProposal, names [][32]byte) ([]types.GovernanceOption, error) {
+	list := make([]types.GovernanceOption, len(names))
+	for i, name := range names {
+		st, err := contract.ProposalOptionState(nil, new(big.Int).SetUint64(uint64(prop.Id)), big.NewInt(int64(i)))
+		if err != nil {
+			ftm.log.Errorf("option #%d of proposal #%d not available; %v", i, prop.Id, err)
+			return nil, err
+		}
+
+		list[i] = types.GovernanceOption{
+			Id:             hexutil.Uint64(i),
+			Name:           bytes32ToString(name),
+			Votes:          hexutil.Big(*st.Votes),
+			AgreementRatio: hexutil.Big(*st.AgreementRatio),
+			Agreement:      hexutil.Big(*st.Agreement),
+		}
+	}
+
+	return list, nil
+}
+
+// governanceProposalDescription loads the name and the description
+// of the proposal from its proposal contract, if available.
+func (ftm *FtmBridge) governanceProposalDescription(prop *types.GovernanceProposal) {
+	// instantiate the proposal contract
+	contract, err := NewGovernanceProposal(prop.Contract, ftm.eth)
+	if err != nil {
+		ftm.log.Criticalf("failed to instantiate governance proposal contract: %v", err)
+		return
+	}
+
+	// get the name
+	if prop.Name, err = contract.Name(nil); err != nil {
+		ftm.log.Debugf("name of proposal #%d not available; %v", prop.Id, err)
+	}
+
+	// get the description
+	if prop.Description, err = contract.Description(nil); err != nil {
+		ftm.log.Debugf("description of proposal #%d not available; %v", prop.Id, err)
+	}
+}
+
+// GovernanceVotingPower calculates the voting power of the given account
+// from its stake and delegations in the SFC contract.
+func (ftm *FtmBridge) GovernanceVotingPower(addr *common.Address) (*types.GovernanceVotingPower, error) {
+	// get the adapter of the deployed contract
+	sfc, err := ftm.sfcAt(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// prep the result
+	vp := types.GovernanceVotingPower{Address: *addr}
+
+	// is the account a staker?
+	sid, err := sfc.stakerID(nil, *addr)
+	if err != nil {
+		ftm.log.Errorf("staker id of %s not available; %v", addr.String(), err)
+		return nil, err
+	}
+
+	// get the stake of the staker
+	if 0 < sid.Sign() {
+		si, err := sfc.staker(nil, sid)
+		if err != nil {
+			ftm.log.Errorf("staker #%d not available; %v", sid.Uint64(), err)
+			return nil, err
+		}
+		vp.Stake = hexutil.Big(*si.StakeAmount)
+		vp.DelegatedMe = hexutil.Big(*si.DelegatedMe)
+	}
+
+	// get the amount delegated by the account
+	dls, err := ftm.Delegations(addr, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// sum active delegations
+	delegated := new(big.Int)
+	for _, dl := range dls {
+		if dl.IsActive() {
+			delegated.Add(delegated, dl.Amount.ToInt())
+		}
+	}
+	vp.Delegated = hexutil.Big(*delegated)
+
+	// calculate the total
+	total := new(big.Int).Add(vp.Stake.ToInt(), vp.DelegatedMe.ToInt())
+	vp.Total = hexutil.Big(*total.Add(total, delegated))
+	return &vp, nil
+}
+
+// GovernanceEvents extracts the votes cast, the votes canceled and the proposal
+// state changes emitted by the governance contract from the given transaction event logs.
+func (ftm *FtmBridge) GovernanceEvents(trx *types.Transaction, logs []*eth.Log, gov *common.Address) ([]types.GovernanceVote, []types.GovernanceVote, []types.GovernanceProposalEvent, error) {
+	// the filterer decodes the events
+	filter, err := NewGovernanceContractFilterer(*gov, ftm.eth)
+	if err != nil {
+		ftm.log.Criticalf("failed to instantiate governance filterer: %v", err)
+		return nil, nil, nil, err
+	}
+
+	// collect the events
+	votes := make([]types.GovernanceVote, 0)
+	cancels := make([]types.GovernanceVote, 0)
+	changes := make([]types.GovernanceProposalEvent, 0)
+	for _, l := range logs {
+		// is this an event of the governance contract?
+		if l.Address != *gov || 0 == len(l.Topics) {
+			continue
+		}
+
+		switch {
+		case l.Topics[0] == govVotedEventTopic:
+			evt, err := filter.ParseVoted(*l)
+			if err != nil {
+				ftm.log.Errorf("can not decode governance vote of %s; %v", trx.Hash.String(), err)
+				return nil, nil, nil, err
+			}
+
+			vote := types.GovernanceVote{
+				Governance:      *gov,
+				ProposalId:      hexutil.Uint64(evt.ProposalID.Uint64()),
+				Voter:           evt.Voter,
+				DelegatedTo:     evt.DelegatedTo,
+				Choices:         make([]hexutil.Uint64, len(evt.Choices)),
+				Weight:          hexutil.Big(*evt.Weight),
+				TransactionHash: trx.Hash,
+				LogIndex:        hexutil.Uint64(l.Index),
+			}
+			for i, ch := range evt.Choices {
+				vote.Choices[i] = hexutil.Uint64(ch.Uint64())
+			}
+			votes = append(votes, vote)
+
+		case l.Topics[0] == govVoteCanceledEventTopic:
+			evt, err := filter.ParseVoteCanceled(*l)
+			if err != nil {
+				ftm.log.Errorf("can not decode governance vote cancel of %s; %v", trx.Hash.String(), err)
+				return nil, nil, nil, err
+			}
+
+			cancels = append(cancels, types.GovernanceVote{
+				Governance:  *gov,
+				ProposalId:  hexutil.Uint64(evt.ProposalID.Uint64()),
+				Voter:       evt.Voter,
+				DelegatedTo: evt.DelegatedTo,
+			})
+
+		case govProposalEventTopics[l.Topics[0]]:
+			// all the proposal events carry just the proposal id
+			if len(l.Data) < 32 {
+				continue
+			}
+
+			changes = append(changes, types.GovernanceProposalEvent{
+				Governance: *gov,
+				ProposalId: hexutil.Uint64(new(big.Int).SetBytes(l.Data[:32]).Uint64()),
+			})
+		}
+	}
+
+	return votes, cancels, changes, nil
+}
@@ -0,0 +1,109 @@
+/*
+Package rpc implements bridge to Lachesis full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Lachesis node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Lachesis RPC interface for a remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Lachesis RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Lachesis RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+import (
+	"context"
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eth "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"math/big"
+)
+
+// upgradedEventTopic represents the topic of the Upgraded(address) event
+// emitted by upgradable proxy contracts on the implementation change.
+var upgradedEventTopic = crypto.Keccak256Hash([]byte("Upgraded(address)"))
+
+// StorageAt returns the value stored in the given storage slot of the contract.
+func (ftm *FtmBridge) StorageAt(addr *common.Address, slot *common.Hash) (common.Hash, error) {
+	// use RPC to make the call
+	var val hexutil.Bytes
+	err := ftm.rpc.Call(&val, "eth_getStorageAt", addr.Hex(), slot.Hex(), BlockTypeLatest)
+	if err != nil {
+		ftm.log.Errorf("can not get storage slot %s of contract [%s]; %s", slot.Hex(), addr.Hex(), err.Error())
+		return common.Hash{}, err
+	}
+
+	return common.BytesToHash(val), nil
+}
+
+// ContractUpgrades returns the list of implementation upgrades of a proxy contract
+// identified by the Upgraded events emitted since the given block.
+func (ftm *FtmBridge) ContractUpgrades(addr *common.Address, from uint64) ([]types.ContractUpgrade, error) {
+	// keep track of the operation
+	ftm.log.Debugf("loading upgrades of contract %s", addr.Hex())
+
+	// get the list of Upgraded events
+	logs, err := ftm.eth.FilterLogs(context.Background(), ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		Addresses: []common.Address{*addr},
+		Topics:    [][]common.Hash{{upgradedEventTopic}},
+	})
+	if err != nil {
+		ftm.log.Errorf("can not get upgrades of contract [%s]; %s", addr.Hex(), err.Error())
+		return nil, err
+	}
+
+	// collect the upgrades
+	list := make([]types.ContractUpgrade, 0, len(logs))
+	for i := range logs {
+		if up := contractUpgrade(&logs[i]); up != nil {
+			list = append(list, *up)
+		}
+	}
+
+	return list, nil
+}
+
+// ContractUpgradeEvents extracts the implementation upgrades of proxy contracts
+// from the given transaction event logs.
+func (ftm *FtmBridge) ContractUpgradeEvents(logs []*eth.Log) []types.ContractUpgrade {
+	list := make([]types.ContractUpgrade, 0)
+	for _, l := range logs {
+		if up := contractUpgrade(l); up != nil {
+			list = append(list, *up)
+		}
+	}
+	return list
+}
+
+// contractUpgrade decodes the implementation upgrade from the Upgraded event log.
+// Nil is returned if the log is not a valid Upgraded event.
+func contractUpgrade(l *eth.Log) *types.ContractUpgrade {
+	// is this the event we look for?
+	if 0 == len(l.Topics) || l.Topics[0] != upgradedEventTopic {
+		return nil
+	}
+
+	// the implementation is indexed by the standard, but some proxies don't do it
+	var impl common.Address
+	if 1 < len(l.Topics) {
+		impl = common.BytesToAddress(l.Topics[1].Bytes())
+	} else if 32 <= len(l.Data) {
+		impl = common.BytesToAddress(l.Data[:32])
+	} else {
+		return nil
+	}
+
+	return &types.ContractUpgrade{
+		Proxy:           l.Address,
+		Implementation:  impl,
+		BlockNumber:     hexutil.Uint64(l.BlockNumber),
+		TransactionHash: types.Hash(l.TxHash),
+		LogIndex:        hexutil.Uint64(l.Index),
+	}
+}
@@ -17,6 +17,8 @@ package rpc
 
 import (
 	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"math/big"
@@ -25,37 +27,47 @@ import (
 // sfcContractAddress represents the address on which the Sfc contract is deployed.
 var sfcContractAddress = common.HexToAddress("0xfc00face00000000000000000000000000000000")
 
-// SfcVersion returns current version of the SFC contract as a single number.
-func (ftm *FtmBridge) SfcVersion() (hexutil.Uint64, error) {
-	// instantiate the contract and display its name
-	contract, err := NewSfcContract(sfcContractAddress, ftm.eth)
-	if err != nil {
-		ftm.log.Criticalf("failed to instantiate SFC contract: %v", err)
-		return 0, err
+// sfcRatioUnit represents the unit of ratios used by the SFC contract (1.000.000).
+var sfcRatioUnit = hexutil.MustDecodeBig("0xF4240")
+
+const (
+	// sfcStatusForkBit represents the staker status bit signaling a detected fork.
+	sfcStatusForkBit = 1
+
+	// sfcStatusOfflineBit represents the staker status bit signaling an offline validator.
+	sfcStatusOfflineBit = 1 << 8
+)
+
+// sfcBlockNumber converts the optional block number into the block of the contract call.
+// The latest state is used for nil block.
+func sfcBlockNumber(block *hexutil.Uint64) *big.Int {
+	if block == nil {
+		return nil
 	}
+	return new(big.Int).SetUint64(uint64(*block))
+}
 
-	// get the version information from the contract
-	var ver [3]byte
-	ver, err = contract.Version(nil)
+// SfcVersion returns current version of the SFC contract as a single number.
+func (ftm *FtmBridge) SfcVersion() (hexutil.Uint64, error) {
+	// get the adapter of the deployed contract
+	sfc, err := ftm.sfcAt(nil)
 	if err != nil {
-		ftm.log.Criticalf("failed to get the SFC version; %v", err)
 		return 0, err
 	}
 
-	return hexutil.Uint64((uint64(ver[0]) << 16) | (uint64(ver[1]) << 8) | uint64(ver[2])), nil
+	return sfc.version(), nil
 }
 
 // CurrentEpoch extract the current epoch id from SFC smart contract.
 func (ftm *FtmBridge) CurrentEpoch() (hexutil.Uint64, error) {
-	// instantiate the contract and display its name
-	contract, err := NewSfcContract(sfcContractAddress, ftm.eth)
+	// get the adapter of the deployed contract
+	sfc, err := ftm.sfcAt(nil)
 	if err != nil {
-		ftm.log.Criticalf("failed to instantiate SFC contract: %v", err)
 		return 0, err
 	}
 
 	// get the value from the contract
-	epoch, err := contract.CurrentEpoch(nil)
+	epoch, err := sfc.currentEpoch(nil)
 	if err != nil {
 		ftm.log.Errorf("failed to get the current epoch: %v", err)
 		return 0, err
@@ -67,15 +79,14 @@ func (ftm *FtmBridge) CurrentEpoch() (hexutil.Uint64, error) {
 
 // CurrentSealedEpoch extract the current sealed epoch id from SFC smart contract.
 func (ftm *FtmBridge) CurrentSealedEpoch() (hexutil.Uint64, error) {
-	// instantiate the contract and display its name
-	contract, err := NewSfcContract(sfcContractAddress, ftm.eth)
+	// get the adapter of the deployed contract
+	sfc, err := ftm.sfcAt(nil)
 	if err != nil {
-		ftm.log.Criticalf("failed to instantiate SFC contract: %v", err)
 		return 0, err
 	}
 
 	// get the value from the contract
-	epoch, err := contract.CurrentSealedEpoch(nil)
+	epoch, err := sfc.currentSealedEpoch(nil)
 	if err != nil {
 		ftm.log.Errorf("failed to get the current sealed epoch: %v", err)
 		return 0, err
@@ -87,15 +98,14 @@ func (ftm *FtmBridge) CurrentSealedEpoch() (hexutil.Uint64, error) {
 
 // LastStakerId returns the last staker id in Opera blockchain.
 func (ftm *FtmBridge) LastStakerId() (hexutil.Uint64, error) {
-	// instantiate the contract and display its name
-	contract, err := NewSfcContract(sfcContractAddress, ftm.eth)
+	// get the adapter of the deployed contract
+	sfc, err := ftm.sfcAt(nil)
 	if err != nil {
-		ftm.log.Criticalf("failed to instantiate SFC contract: %v", err)
 		return 0, err
 	}
 
 	// get the value from the contract
-	sl, err := contract.StakersLastID(nil)
+	sl, err := sfc.stakersLastID(nil)
 	if err != nil {
 		ftm.log.Errorf("failed to get the last staker ID: %v", err)
 		return 0, err
@@ -107,15 +117,14 @@ func (ftm *FtmBridge) LastStakerId() (hexutil.Uint64, error) {
 
 // StakersNum returns the number of stakers in Opera blockchain.
 func (ftm *FtmBridge) StakersNum() (hexutil.Uint64, error) {
-	// instantiate the contract and display its name
-	contract, err := NewSfcContract(sfcContractAddress, ftm.eth)
+	// get the adapter of the deployed contract
+	sfc, err := ftm.sfcAt(nil)
 	if err != nil {
-		ftm.log.Criticalf("failed to instantiate SFC contract: %v", err)
 		return 0, err
 	}
 
 	// get the value from the contract
-	sn, err := contract.StakersNum(nil)
+	sn, err := sfc.stakersNum(nil)
 	if err != nil {
 		ftm.log.Errorf("failed to get the current number of stakers: %v", err)
 		return 0, err
@@ -126,12 +135,12 @@ func (ftm *FtmBridge) StakersNum() (hexutil.Uint64, error) {
 }
 
 // stakerStatusFromSfc updates staker information using SFC binding.
-func (ftm *FtmBridge) stakerStatusFromSfc(contract *SfcContract, staker *types.Staker) error {
+func (ftm *FtmBridge) stakerStatusFromSfc(sfc sfcAdapter, opts *bind.CallOpts, staker *types.Staker) error {
 	// log action
 	ftm.log.Debug("updating staker info from SFC")
 
 	// get the value from the contract
-	si, err := contract.Stakers(nil, big.NewInt(int64(staker.Id)))
+	si, err := sfc.staker(opts, big.NewInt(int64(staker.Id)))
 	if err != nil {
 		ftm.log.Errorf("failed to get the staker information from SFC: %v", err)
 		return err
@@ -149,7 +158,7 @@ func (ftm *FtmBridge) stakerStatusFromSfc(contract *SfcContract, staker *types.S
 			staker.TotalStake = (*hexutil.Big)(big.NewInt(0).Add(si.DelegatedMe, si.StakeAmount))
 
 			// calculate delegation limit
-			staker.TotalDelegatedLimit = ftm.maxDelegatedLimit(staker.Stake, contract)
+			staker.TotalDelegatedLimit = ftm.maxDelegatedLimit(staker.Stake, sfc, opts)
 
 			// calculate available limit for staking
 			val := new(big.Int).Sub((*big.Int)(&staker.TotalDelegatedLimit), (*big.Int)(staker.DelegatedMe))
@@ -165,17 +174,23 @@ func (ftm *FtmBridge) stakerStatusFromSfc(contract *SfcContract, staker *types.S
 }
 
 // stakerLockFromSfc updates staker lock details using SFC binding.
-func (ftm *FtmBridge) stakerLockFromSfc(contract *SfcContract, staker *types.Staker) error {
+func (ftm *FtmBridge) stakerLockFromSfc(sfc sfcAdapter, opts *bind.CallOpts, staker *types.Staker) error {
 	// log action
 	ftm.log.Debug("updating staker locking details from SFC")
 
 	// get staker locking detail
-	lock, err := contract.LockedStakes(nil, big.NewInt(int64(staker.Id)))
+	lock, err := sfc.stakeLock(opts, big.NewInt(int64(staker.Id)))
 	if err != nil {
 		ftm.log.Errorf("stake lock query failed; %v", err)
 		return nil
 	}
 
+	// is locking supported by the contract?
+	if lock == nil {
+		ftm.log.Debugf("stake locking not supported by SFC %s", sfc.version().String())
+		return nil
+	}
+
 	// are lock timers available?
 	if lock.FromEpoch == nil || lock.EndTime == nil {
 		ftm.log.Errorf("stake lock details not available")
@@ -191,18 +206,15 @@ func (ftm *FtmBridge) stakerLockFromSfc(contract *SfcContract, staker *types.Sta
 }
 
 // maxDelegatedLimit calculate maximum amount of tokens allowed to be delegated to a staker.
-func (ftm *FtmBridge) maxDelegatedLimit(staked *hexutil.Big, contract *SfcContract) hexutil.Big {
+func (ftm *FtmBridge) maxDelegatedLimit(staked *hexutil.Big, sfc sfcAdapter, opts *bind.CallOpts) hexutil.Big {
 	// if we don't know the staked amount, return zero
 	if staked == nil {
 		return (hexutil.Big)(*hexutil.MustDecodeBig("0x0"))
 	}
 
-	// ratio unit is used to calculate the value (1.000.000)
 	// please note this formula is taken from SFC contract and can change
-	ratioUnit := hexutil.MustDecodeBig("0xF4240")
-
 	// get delegation ration
-	ratio, err := contract.MaxDelegatedRatio(nil)
+	ratio, err := sfc.maxDelegatedRatio(opts)
 	if err != nil {
 		ftm.log.Errorf("can not get the delegation ratio; %s", err.Error())
 		return (hexutil.Big)(*hexutil.MustDecodeBig("0x0"))
@@ -212,27 +224,26 @@ func (ftm *FtmBridge) maxDelegatedLimit(staked *hexutil.Big, contract *SfcContra
 	temp := new(big.Int).Mul((*big.Int)(staked), ratio)
 
 	// adjust to percent
-	value := new(big.Int).Div(temp, ratioUnit)
+	value := new(big.Int).Div(temp, sfcRatioUnit)
 	return (hexutil.Big)(*value)
 }
 
 // extendStaker extends staker information using SFC contract binding.
 func (ftm *FtmBridge) extendStaker(staker *types.Staker) (*types.Staker, error) {
-	// instantiate the contract and display its name
-	contract, err := NewSfcContract(sfcContractAddress, ftm.eth)
+	// get the adapter of the deployed contract
+	sfc, err := ftm.sfcAt(nil)
 	if err != nil {
-		ftm.log.Criticalf("failed to instantiate SFC contract: %v", err)
 		return nil, err
 	}
 
 	// update status detail
-	err = ftm.stakerStatusFromSfc(contract, staker)
+	err = ftm.stakerStatusFromSfc(sfc, nil, staker)
 	if err != nil {
 		ftm.log.Critical("staker status could not be updated from SFC")
 	}
 
 	// update locking detail
-	err = ftm.stakerLockFromSfc(contract, staker)
+	err = ftm.stakerLockFromSfc(sfc, nil, staker)
 	if err != nil {
 		ftm.log.Critical("staker locking could not be updated from SFC")
 	}
@@ -276,17 +287,114 @@ func (ftm *FtmBridge) StakerByAddress(addr common.Address) (*types.Staker, error
 	return ftm.extendStaker(&st)
 }
 
+// StakerAt extracts a staker information by numeric id from the state
+// of the SFC contract at the given block.
+func (ftm *FtmBridge) StakerAt(id hexutil.Uint64, block hexutil.Uint64) (*types.Staker, error) {
+	// get the adapter of the contract at the block
+	num := sfcBlockNumber(&block)
+	sfc, err := ftm.sfcAt(num)
+	if err != nil {
+		return nil, err
+	}
+
+	return ftm.stakerAt(sfc, &bind.CallOpts{BlockNumber: num}, id)
+}
+
+// StakerByAddressAt extracts a staker information by address from the state
+// of the SFC contract at the given block.
+func (ftm *FtmBridge) StakerByAddressAt(addr common.Address, block hexutil.Uint64) (*types.Staker, error) {
+	// get the adapter of the contract at the block
+	num := sfcBlockNumber(&block)
+	sfc, err := ftm.sfcAt(num)
+	if err != nil {
+		return nil, err
+	}
+
+	// find the staker id of the address
+	opts := &bind.CallOpts{BlockNumber: num}
+	id, err := sfc.stakerID(opts, addr)
+	if err != nil {
+		ftm.log.Errorf("failed to get the staker ID of %s: %v", addr.String(), err)
+		return nil, err
+	}
+
+	return ftm.stakerAt(sfc, opts, hexutil.Uint64(id.Uint64()))
+}
+
+// stakerAt builds the staker information from the SFC contract records at the block
+// of the given call options. The values reported only by the node for the latest state,
+// i.e. the missed blocks, the downtime, the PoI and the scores, are not available.
+func (ftm *FtmBridge) stakerAt(sfc sfcAdapter, opts *bind.CallOpts, id hexutil.Uint64) (*types.Staker, error) {
+	// get the staker record
+	si, err := sfc.staker(opts, big.NewInt(int64(id)))
+	if err != nil {
+		ftm.log.Errorf("failed to get the staker #%d from SFC: %v", uint64(id), err)
+		return nil, err
+	}
+
+	// unknown staker has an empty record
+	if id == 0 || si.CreatedEpoch == nil || 0 == si.CreatedEpoch.Sign() {
+		return nil, fmt.Errorf("staker #%d not found at block #%d", uint64(id), opts.BlockNumber.Uint64())
+	}
+
+	status := si.Status.Uint64()
+	staker := types.Staker{
+		Id:               id,
+		StakerAddress:    si.SfcAddress,
+		IsActive:         status == 0 && 0 == si.DeactivatedEpoch.Sign(),
+		IsCheater:        status&sfcStatusForkBit != 0,
+		IsOffline:        status&sfcStatusOfflineBit != 0,
+		CreatedEpoch:     hexutil.Uint64(si.CreatedEpoch.Uint64()),
+		CreatedTime:      hexutil.Uint64(si.CreatedTime.Uint64()),
+		DeactivatedEpoch: hexutil.Uint64(si.DeactivatedEpoch.Uint64()),
+		DeactivatedTime:  hexutil.Uint64(si.DeactivatedTime.Uint64()),
+	}
+
+	// update the stake, the delegations and the lock
+	if err := ftm.stakerStatusFromSfc(sfc, opts, &staker); err != nil {
+		return nil, err
+	}
+	if err := ftm.stakerLockFromSfc(sfc, opts, &staker); err != nil {
+		return nil, err
+	}
+
+	// the validator record of the last sealed epoch provides the reward weights
+	epoch, err := sfc.currentSealedEpoch(opts)
+	if err != nil {
+		ftm.log.Errorf("failed to get the current sealed epoch: %v", err)
+		return nil, err
+	}
+	val, err := sfc.epochValidator(opts, epoch, big.NewInt(int64(id)))
+	if err != nil {
+		ftm.log.Errorf("failed to get validator #%d of epoch #%d: %v", uint64(id), epoch.Uint64(), err)
+		return nil, err
+	}
+	if val.StakeAmount != nil && 0 < val.StakeAmount.Sign() {
+		staker.IsValidator = true
+		staker.BaseRewardWeight = (*hexutil.Big)(val.BaseRewardWeight)
+		staker.TxRewardWeight = (*hexutil.Big)(val.TxRewardWeight)
+	}
+
+	return &staker, nil
+}
+
 // Epoch extract information about an epoch from SFC smart contract.
 func (ftm *FtmBridge) Epoch(id hexutil.Uint64) (types.Epoch, error) {
-	// instantiate the contract and display its name
-	contract, err := NewSfcContract(sfcContractAddress, ftm.eth)
+	return ftm.EpochAt(id, nil)
+}
+
+// EpochAt extracts information about an epoch from the state of the SFC contract
+// at the given block. The latest state is used for nil block.
+func (ftm *FtmBridge) EpochAt(id hexutil.Uint64, block *hexutil.Uint64) (types.Epoch, error) {
+	// get the adapter of the contract at the block
+	num := sfcBlockNumber(block)
+	sfc, err := ftm.sfcAt(num)
 	if err != nil {
-		ftm.log.Criticalf("failed to instantiate SFC contract: %v", err)
 		return types.Epoch{}, err
 	}
 
 	// extract epoch snapshot
-	epo, err := contract.EpochSnapshots(nil, big.NewInt(int64(id)))
+	epo, err := sfc.epochSnapshot(&bind.CallOpts{BlockNumber: num}, big.NewInt(int64(id)))
 	if err != nil {
 		ftm.log.Errorf("failed to extract epoch information: %v", err)
 		return types.Epoch{}, err
@@ -306,4 +414,140 @@ func (ftm *FtmBridge) Epoch(id hexutil.Uint64) (types.Epoch, error) {
 	}, nil
 }
 
+// Delegations extracts the list of delegations of the given account from the SFC
+// contract at the given block. The latest state is used for nil block.
+func (ftm *FtmBridge) Delegations(addr *common.Address, block *hexutil.Uint64) ([]types.Delegation, error) {
+	// get the adapter of the contract at the block
+	num := sfcBlockNumber(block)
+	sfc, err := ftm.sfcAt(num)
+	if err != nil {
+		return nil, err
+	}
+
+	// get the delegations
+	dls, err := sfc.delegations(&bind.CallOpts{BlockNumber: num}, *addr)
+	if err != nil {
+		ftm.log.Errorf("delegations of %s not available; %v", addr.String(), err)
+		return nil, err
+	}
 
+	// convert to the common structure
+	list := make([]types.Delegation, len(dls))
+	for i, dl := range dls {
+		list[i] = types.Delegation{
+			Address:          *addr,
+			ToStakerId:       hexutil.Uint64(dl.ToStakerID.Uint64()),
+			CreatedEpoch:     hexutil.Uint64(dl.CreatedEpoch.Uint64()),
+			CreatedTime:      hexutil.Uint64(dl.CreatedTime.Uint64()),
+			DeactivatedEpoch: hexutil.Uint64(dl.DeactivatedEpoch.Uint64()),
+			DeactivatedTime:  hexutil.Uint64(dl.DeactivatedTime.Uint64()),
+			Amount:           hexutil.Big(*dl.Amount),
+			PaidUntilEpoch:   hexutil.Uint64(dl.PaidUntilEpoch.Uint64()),
+		}
+	}
+
+	return list, nil
+}
+
+// EpochValidators extracts the list of validators of the given sealed epoch
+// with their stake and reward weights from the SFC contract.
+func (ftm *FtmBridge) EpochValidators(ep *types.Epoch) ([]types.EpochValidator, error) {
+	// get the adapter of the deployed contract
+	sfc, err := ftm.sfcAt(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// get the last staker id
+	last, err := sfc.stakersLastID(nil)
+	if err != nil {
+		ftm.log.Errorf("failed to get the last staker ID: %v", err)
+		return nil, err
+	}
+
+	// check all the stakers for being a validator in the epoch
+	id := big.NewInt(int64(ep.Id))
+	list := make([]types.EpochValidator, 0)
+	for sid := int64(1); sid <= last.Int64(); sid++ {
+		val, err := sfc.epochValidator(nil, id, big.NewInt(sid))
+		if err != nil {
+			ftm.log.Errorf("failed to get validator #%d of epoch #%d: %v", sid, uint64(ep.Id), err)
+			return nil, err
+		}
+
+		// skip stakers not validating in the epoch
+		if val.StakeAmount == nil || 0 == val.StakeAmount.Sign() {
+			continue
+		}
+
+		list = append(list, types.EpochValidator{
+			Epoch:            ep.Id,
+			StakerId:         hexutil.Uint64(sid),
+			StakeAmount:      hexutil.Big(*val.StakeAmount),
+			DelegatedMe:      hexutil.Big(*val.DelegatedMe),
+			BaseRewardWeight: hexutil.Big(*val.BaseRewardWeight),
+			TxRewardWeight:   hexutil.Big(*val.TxRewardWeight),
+			Reward:           hexutil.Big(*epochValidatorReward(ep, val)),
+		})
+	}
+
+	return list, nil
+}
+
+// epochValidatorReward calculates the raw reward of the validator in the epoch.
+// The formula follows the SFC contract; the base reward is distributed by the base
+// reward weight and the fee by the transaction reward weight.
+func epochValidatorReward(ep *types.Epoch, val *sfcEpochValidator) *big.Int {
+	reward := new(big.Int)
+
+	// base reward share
+	if 0 < ep.TotalBaseRewardWeight.ToInt().Sign() {
+		base := new(big.Int).Mul(ep.BaseRewardPerSecond.ToInt(), ep.Duration.ToInt())
+		base.Mul(base, val.BaseRewardWeight)
+		reward.Add(reward, base.Div(base, ep.TotalBaseRewardWeight.ToInt()))
+	}
+
+	// transaction fee share
+	if 0 < ep.TotalTxRewardWeight.ToInt().Sign() {
+		fee := new(big.Int).Mul(ep.EpochFee.ToInt(), val.TxRewardWeight)
+		reward.Add(reward, fee.Div(fee, ep.TotalTxRewardWeight.ToInt()))
+	}
+
+	return reward
+}
+
+// RewardParams extracts the parameters of the staking rewards distribution
+// from the SFC contract.
+func (ftm *FtmBridge) RewardParams() (*types.RewardParams, error) {
+	// get the adapter of the deployed contract
+	sfc, err := ftm.sfcAt(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// get the params from the contract
+	rp, err := sfc.rewardParams(nil)
+	if err != nil {
+		ftm.log.Errorf("reward params not available; %v", err)
+		return nil, err
+	}
+
+	// convert to the common structure
+	params := types.RewardParams{
+		ValidatorCommission: hexutil.Big(*rp.ValidatorCommission),
+		ContractCommission:  hexutil.Big(*rp.ContractCommission),
+	}
+
+	// legacy contract pays the full reward without any lockup
+	if rp.UnlockedRewardRatio == nil {
+		params.UnlockedRewardRatio = hexutil.Big(*sfcRatioUnit)
+		return &params, nil
+	}
+
+	// add the lockup rules
+	params.HasLockup = true
+	params.UnlockedRewardRatio = hexutil.Big(*rp.UnlockedRewardRatio)
+	params.MinLockupDuration = hexutil.Uint64(rp.MinLockupDuration.Uint64())
+	params.MaxLockupDuration = hexutil.Uint64(rp.MaxLockupDuration.Uint64())
+	return &params, nil
+}
@@ -0,0 +1,557 @@
+/*
+Package rpc implements bridge to Lachesis full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Lachesis node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Lachesis RPC interface for a remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Lachesis RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Lachesis RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+//go:generate abigen --abi ./contracts/sfc-1.1.abi --pkg rpc --type SfcV1Contract --out ./smc_sfc_v1.go
+
+import (
+	"bytes"
+	"context"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ftm "github.com/ethereum/go-ethereum/rpc"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// sfcV2Abi represents the parsed ABI of the SFC 2.x contract used to batch the contract calls.
+var sfcV2Abi, _ = abi.JSON(strings.NewReader(SfcContractABI))
+
+const (
+	// sfcVersionV1 represents the version of the legacy SFC 1.1 contract.
+	// The contract does not report its version, so we use a fixed value.
+	sfcVersionV1 = hexutil.Uint64(0x010100)
+
+	// sfcVersionV2 represents the lowest version of the SFC 2.x contract.
+	sfcVersionV2 = hexutil.Uint64(0x020000)
+
+	// sfcOpPush4 represents the EVM opcode used by the function dispatcher
+	// to push the 4 bytes selector of a contract call for comparison.
+	sfcOpPush4 = 0x63
+
+	// sfcVersionCheckInterval represents the time after which the version
+	// of the deployed SFC contract is checked again to pick up network upgrades.
+	sfcVersionCheckInterval = 1 * time.Minute
+)
+
+// sfcStaker represents the staker record of the SFC contract.
+type sfcStaker struct {
+	Status           *big.Int
+	CreatedEpoch     *big.Int
+	CreatedTime      *big.Int
+	DeactivatedEpoch *big.Int
+	DeactivatedTime  *big.Int
+	StakeAmount      *big.Int
+	PaidUntilEpoch   *big.Int
+	DelegatedMe      *big.Int
+	DagAddress       common.Address
+	SfcAddress       common.Address
+}
+
+// sfcStakeLock represents the stake lock record of the SFC contract.
+type sfcStakeLock struct {
+	FromEpoch *big.Int
+	EndTime   *big.Int
+	Duration  *big.Int
+}
+
+// sfcDelegation represents the delegation record of the SFC contract.
+type sfcDelegation struct {
+	CreatedEpoch     *big.Int
+	CreatedTime      *big.Int
+	DeactivatedEpoch *big.Int
+	DeactivatedTime  *big.Int
+	Amount           *big.Int
+	PaidUntilEpoch   *big.Int
+	ToStakerID       *big.Int
+}
+
+// sfcEpochSnapshot represents the epoch snapshot record of the SFC contract.
+type sfcEpochSnapshot struct {
+	EndTime                *big.Int
+	Duration               *big.Int
+	EpochFee               *big.Int
+	TotalBaseRewardWeight  *big.Int
+	TotalTxRewardWeight    *big.Int
+	BaseRewardPerSecond    *big.Int
+	StakeTotalAmount       *big.Int
+	DelegationsTotalAmount *big.Int
+	TotalSupply            *big.Int
+}
+
+// sfcEpochValidator represents the validator record of an epoch snapshot of the SFC contract.
+type sfcEpochValidator struct {
+	StakeAmount      *big.Int
+	DelegatedMe      *big.Int
+	BaseRewardWeight *big.Int
+	TxRewardWeight   *big.Int
+}
+
+// sfcRewardParams represents the parameters of the SFC contract
+// used to distribute staking rewards. Lockup related parameters are nil
+// if the contract does not support stake locking.
+type sfcRewardParams struct {
+	ValidatorCommission *big.Int
+	ContractCommission  *big.Int
+	UnlockedRewardRatio *big.Int
+	MinLockupDuration   *big.Int
+	MaxLockupDuration   *big.Int
+}
+
+// sfcAdapter represents a version independent access to the SFC contract.
+// Each supported version of the contract has its own implementation
+// on top of the binding generated from the version ABI.
+type sfcAdapter interface {
+	// version returns the version of the SFC contract as a single number.
+	version() hexutil.Uint64
+
+	// currentEpoch returns the id of the current epoch.
+	currentEpoch(opts *bind.CallOpts) (*big.Int, error)
+
+	// currentSealedEpoch returns the id of the latest sealed epoch.
+	currentSealedEpoch(opts *bind.CallOpts) (*big.Int, error)
+
+	// epochSnapshot returns the snapshot of the given epoch.
+	epochSnapshot(opts *bind.CallOpts, id *big.Int) (*sfcEpochSnapshot, error)
+
+	// epochValidator returns the record of the given validator in the given epoch.
+	epochValidator(opts *bind.CallOpts, epoch *big.Int, staker *big.Int) (*sfcEpochValidator, error)
+
+	// stakersLastID returns the last staker id.
+	stakersLastID(opts *bind.CallOpts) (*big.Int, error)
+
+	// stakersNum returns the number of stakers.
+	stakersNum(opts *bind.CallOpts) (*big.Int, error)
+
+	// maxDelegatedRatio returns the max ratio of delegations to the stake.
+	maxDelegatedRatio(opts *bind.CallOpts) (*big.Int, error)
+
+	// stakerID returns the staker id of the given address; zero for non stakers.
+	stakerID(opts *bind.CallOpts, addr common.Address) (*big.Int, error)
+
+	// staker returns the staker record of the given staker id.
+	staker(opts *bind.CallOpts, id *big.Int) (*sfcStaker, error)
+
+	// stakeLock returns the stake lock of the given staker; nil if locking is not supported.
+	stakeLock(opts *bind.CallOpts, id *big.Int) (*sfcStakeLock, error)
+
+	// delegations returns the list of delegations of the given address.
+	delegations(opts *bind.CallOpts, addr common.Address) ([]sfcDelegation, error)
+
+	// rewardParams returns the parameters of the rewards distribution.
+	rewardParams(opts *bind.CallOpts) (*sfcRewardParams, error)
+}
+
+// sfcAt provides the SFC adapter matching the contract version deployed
+// at the given block. The latest state is used for nil block.
+func (ftm *FtmBridge) sfcAt(block *big.Int) (sfcAdapter, error) {
+	// historical state always needs the version check
+	if block != nil {
+		return ftm.detectSfc(&bind.CallOpts{BlockNumber: block})
+	}
+
+	// use the latest known adapter if checked recently
+	ftm.sfcLock.Lock()
+	defer ftm.sfcLock.Unlock()
+	if ftm.sfcLatest != nil && time.Since(ftm.sfcChecked) < sfcVersionCheckInterval {
+		return ftm.sfcLatest, nil
+	}
+
+	// check the version again; failed detection is not remembered
+	sfc, err := ftm.detectSfc(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// inform about the version change
+	if ftm.sfcLatest == nil || ftm.sfcLatest.version() != sfc.version() {
+		ftm.log.Noticef("SFC contract version %s detected", sfc.version().String())
+	}
+
+	ftm.sfcLatest = sfc
+	ftm.sfcChecked = time.Now()
+	return sfc, nil
+}
+
+// detectSfc detects the version of the SFC contract and builds the adapter for it.
+// The legacy SFC 1.1 contract does not provide the version call at all.
+func (ftm *FtmBridge) detectSfc(opts *bind.CallOpts) (sfcAdapter, error) {
+	// try the current binding first
+	contract, err := NewSfcContract(sfcContractAddress, ftm.eth)
+	if err != nil {
+		ftm.log.Criticalf("failed to instantiate SFC contract: %v", err)
+		return nil, err
+	}
+
+	// check the deployed code for the version call, the legacy contract does not have it
+	hasVersion, err := ftm.sfcHasVersion(opts)
+	if err != nil {
+		ftm.log.Errorf("can not inspect SFC contract code; %v", err)
+		return nil, err
+	}
+
+	// get the version information from the contract
+	if hasVersion {
+		ver, err := contract.Version(opts)
+		if err != nil {
+			ftm.log.Errorf("can not detect SFC version; %v", err)
+			return nil, err
+		}
+
+		v := hexutil.Uint64((uint64(ver[0]) << 16) | (uint64(ver[1]) << 8) | uint64(ver[2]))
+		if sfcVersionV2 <= v {
+			return &sfcV2{contract: contract, rpc: ftm.rpc, ver: v}, nil
+		}
+	}
+
+	// fall back to the legacy contract
+	legacy, err := NewSfcV1Contract(sfcContractAddress, ftm.eth)
+	if err != nil {
+		ftm.log.Criticalf("failed to instantiate legacy SFC contract: %v", err)
+		return nil, err
+	}
+	return &sfcV1{contract: legacy}, nil
+}
+
+// sfcHasVersion checks if the SFC contract code deployed at the given block
+// dispatches the version call, i.e. if the version can be asked for at all.
+func (ftm *FtmBridge) sfcHasVersion(opts *bind.CallOpts) (bool, error) {
+	// pick the block of the state
+	var block *big.Int
+	if opts != nil {
+		block = opts.BlockNumber
+	}
+
+	// get the code of the contract
+	code, err := ftm.eth.CodeAt(context.Background(), sfcContractAddress, block)
+	if err != nil {
+		return false, err
+	}
+
+	// the function dispatcher pushes the selector of each call it implements
+	return bytes.Contains(code, append([]byte{sfcOpPush4}, sfcV2Abi.Methods["version"].ID...)), nil
+}
+
+// sfcV1 implements the SFC adapter for the legacy SFC 1.1 contract.
+type sfcV1 struct {
+	contract *SfcV1Contract
+}
+
+// version returns the version of the SFC contract as a single number.
+func (sfc *sfcV1) version() hexutil.Uint64 {
+	return sfcVersionV1
+}
+
+// currentEpoch returns the id of the current epoch.
+func (sfc *sfcV1) currentEpoch(opts *bind.CallOpts) (*big.Int, error) {
+	return sfc.contract.CurrentEpoch(opts)
+}
+
+// currentSealedEpoch returns the id of the latest sealed epoch.
+func (sfc *sfcV1) currentSealedEpoch(opts *bind.CallOpts) (*big.Int, error) {
+	return sfc.contract.CurrentSealedEpoch(opts)
+}
+
+// epochSnapshot returns the snapshot of the given epoch.
+func (sfc *sfcV1) epochSnapshot(opts *bind.CallOpts, id *big.Int) (*sfcEpochSnapshot, error) {
+	ep, err := sfc.contract.EpochSnapshots(opts, id)
+	if err != nil {
+		return nil, err
+	}
+
+	snap := sfcEpochSnapshot(ep)
+	return &snap, nil
+}
+
+// epochValidator returns the record of the given validator in the given epoch.
+func (sfc *sfcV1) epochValidator(opts *bind.CallOpts, epoch *big.Int, staker *big.Int) (*sfcEpochValidator, error) {
+	val, err := sfc.contract.EpochValidator(opts, epoch, staker)
+	if err != nil {
+		return nil, err
+	}
+
+	rec := sfcEpochValidator(val)
+	return &rec, nil
+}
+
+// stakersLastID returns the last staker id.
+func (sfc *sfcV1) stakersLastID(opts *bind.CallOpts) (*big.Int, error) {
+	return sfc.contract.StakersLastID(opts)
+}
+
+// stakersNum returns the number of stakers.
+func (sfc *sfcV1) stakersNum(opts *bind.CallOpts) (*big.Int, error) {
+	return sfc.contract.StakersNum(opts)
+}
+
+// maxDelegatedRatio returns the max ratio of delegations to the stake.
+func (sfc *sfcV1) maxDelegatedRatio(opts *bind.CallOpts) (*big.Int, error) {
+	return sfc.contract.MaxDelegatedRatio(opts)
+}
+
+// stakerID returns the staker id of the given address; zero for non stakers.
+func (sfc *sfcV1) stakerID(opts *bind.CallOpts, addr common.Address) (*big.Int, error) {
+	return sfc.contract.GetStakerID(opts, addr)
+}
+
+// staker returns the staker record of the given staker id.
+func (sfc *sfcV1) staker(opts *bind.CallOpts, id *big.Int) (*sfcStaker, error) {
+	st, err := sfc.contract.Stakers(opts, id)
+	if err != nil {
+		return nil, err
+	}
+
+	rec := sfcStaker(st)
+	return &rec, nil
+}
+
+// stakeLock returns nil since the legacy contract does not support stake locking.
+func (sfc *sfcV1) stakeLock(_ *bind.CallOpts, _ *big.Int) (*sfcStakeLock, error) {
+	return nil, nil
+}
+
+// delegations returns the list of delegations of the given address.
+// The legacy contract allows a single delegation per address.
+func (sfc *sfcV1) delegations(opts *bind.CallOpts, addr common.Address) ([]sfcDelegation, error) {
+	dl, err := sfc.contract.Delegations(opts, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	// empty delegation record?
+	if dl.Amount == nil || 0 == dl.Amount.Sign() {
+		return []sfcDelegation{}, nil
+	}
+	return []sfcDelegation{sfcDelegation(dl)}, nil
+}
+
+// sfcV2 implements the SFC adapter for the SFC 2.x contract.
+type sfcV2 struct {
+	contract *SfcContract
+	rpc      *ftm.Client
+	ver      hexutil.Uint64
+}
+
+// version returns the version of the SFC contract as a single number.
+func (sfc *sfcV2) version() hexutil.Uint64 {
+	return sfc.ver
+}
+
+// currentEpoch returns the id of the current epoch.
+func (sfc *sfcV2) currentEpoch(opts *bind.CallOpts) (*big.Int, error) {
+	return sfc.contract.CurrentEpoch(opts)
+}
+
+// currentSealedEpoch returns the id of the latest sealed epoch.
+func (sfc *sfcV2) currentSealedEpoch(opts *bind.CallOpts) (*big.Int, error) {
+	return sfc.contract.CurrentSealedEpoch(opts)
+}
+
+// epochSnapshot returns the snapshot of the given epoch.
+func (sfc *sfcV2) epochSnapshot(opts *bind.CallOpts, id *big.Int) (*sfcEpochSnapshot, error) {
+	ep, err := sfc.contract.EpochSnapshots(opts, id)
+	if err != nil {
+		return nil, err
+	}
+
+	snap := sfcEpochSnapshot(ep)
+	return &snap, nil
+}
+
+// epochValidator returns the record of the given validator in the given epoch.
+func (sfc *sfcV2) epochValidator(opts *bind.CallOpts, epoch *big.Int, staker *big.Int) (*sfcEpochValidator, error) {
+	val, err := sfc.contract.EpochValidator(opts, epoch, staker)
+	if err != nil {
+		return nil, err
+	}
+
+	rec := sfcEpochValidator(val)
+	return &rec, nil
+}
+
+// stakersLastID returns the last staker id.
+func (sfc *sfcV2) stakersLastID(opts *bind.CallOpts) (*big.Int, error) {
+	return sfc.contract.StakersLastID(opts)
+}
+
+// stakersNum returns the number of stakers.
+func (sfc *sfcV2) stakersNum(opts *bind.CallOpts) (*big.Int, error) {
+	return sfc.contract.StakersNum(opts)
+}
+
+// maxDelegatedRatio returns the max ratio of delegations to the stake.
+func (sfc *sfcV2) maxDelegatedRatio(opts *bind.CallOpts) (*big.Int, error) {
+	return sfc.contract.MaxDelegatedRatio(opts)
+}
+
+// stakerID returns the staker id of the given address; zero for non stakers.
+func (sfc *sfcV2) stakerID(opts *bind.CallOpts, addr common.Address) (*big.Int, error) {
+	return sfc.contract.GetStakerID(opts, addr)
+}
+
+// staker returns the staker record of the given staker id.
+func (sfc *sfcV2) staker(opts *bind.CallOpts, id *big.Int) (*sfcStaker, error) {
+	st, err := sfc.contract.Stakers(opts, id)
+	if err != nil {
+		return nil, err
+	}
+
+	rec := sfcStaker(st)
+	return &rec, nil
+}
+
+// stakeLock returns the stake lock of the given staker.
+func (sfc *sfcV2) stakeLock(opts *bind.CallOpts, id *big.Int) (*sfcStakeLock, error) {
+	lock, err := sfc.contract.LockedStakes(opts, id)
+	if err != nil {
+		return nil, err
+	}
+
+	rec := sfcStakeLock(lock)
+	return &rec, nil
+}
+
+// delegations returns the list of delegations of the given address.
+// Delegations migrated from the legacy contract are included.
+func (sfc *sfcV2) delegations(opts *bind.CallOpts, addr common.Address) ([]sfcDelegation, error) {
+	list := make([]sfcDelegation, 0)
+
+	// get the legacy delegation, if any
+	legacy, err := sfc.contract.LegacyDelegations(opts, addr)
+	if err != nil {
+		return nil, err
+	}
+	if legacy.Amount != nil && 0 < legacy.Amount.Sign() {
+		list = append(list, sfcDelegation(legacy))
+	}
+
+	// get the last staker id
+	last, err := sfc.contract.StakersLastID(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// collect delegations to each staker in a single batch of calls
+	batch, data, err := sfcDelegationsBatch(opts, addr, last.Int64())
+	if err != nil {
+		return nil, err
+	}
+	if err := sfc.rpc.BatchCall(batch); err != nil {
+		return nil, err
+	}
+
+	// decode the delegations
+	for i := range batch {
+		if batch[i].Error != nil {
+			return nil, batch[i].Error
+		}
+
+		var dl sfcDelegation
+		if err := sfcV2Abi.Unpack(&dl, "delegations", data[i]); err != nil {
+			return nil, err
+		}
+
+		// skip empty delegation records
+		if dl.Amount == nil || 0 == dl.Amount.Sign() {
+			continue
+		}
+		list = append(list, dl)
+	}
+
+	return list, nil
+}
+
+// sfcDelegationsBatch prepares the batch of calls loading the delegations
+// of the given account to each of the stakers up to the last staker id.
+func sfcDelegationsBatch(opts *bind.CallOpts, addr common.Address, last int64) ([]ftm.BatchElem, []hexutil.Bytes, error) {
+	// the block of the state; latest if not specified
+	block := "latest"
+	if opts != nil && opts.BlockNumber != nil {
+		block = hexutil.EncodeBig(opts.BlockNumber)
+	}
+
+	// prep the calls
+	data := make([]hexutil.Bytes, last)
+	batch := make([]ftm.BatchElem, last)
+	for id := int64(1); id <= last; id++ {
+		input, err := sfcV2Abi.Pack("delegations", addr, big.NewInt(id))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		batch[id-1] = ftm.BatchElem{
+			Method: "eth_call",
+			Args: []interface{}{map[string]interface{}{
+				"to":   sfcContractAddress,
+				"data": hexutil.Bytes(input),
+			}, block},
+			Result: &data[id-1],
+		}
+	}
+
+	return batch, data, nil
+}
+
+// rewardParams returns the parameters of the rewards distribution.
+// The legacy contract pays the full reward, there is no stake locking.
+func (sfc *sfcV1) rewardParams(opts *bind.CallOpts) (*sfcRewardParams, error) {
+	var params sfcRewardParams
+	var err error
+
+	// get the validator commission
+	if params.ValidatorCommission, err = sfc.contract.ValidatorCommission(opts); err != nil {
+		return nil, err
+	}
+
+	// get the contract commission
+	if params.ContractCommission, err = sfc.contract.ContractCommission(opts); err != nil {
+		return nil, err
+	}
+
+	return &params, nil
+}
+
+// rewardParams returns the parameters of the rewards distribution.
+func (sfc *sfcV2) rewardParams(opts *bind.CallOpts) (*sfcRewardParams, error) {
+	var params sfcRewardParams
+	var err error
+
+	// get the validator commission
+	if params.ValidatorCommission, err = sfc.contract.ValidatorCommission(opts); err != nil {
+		return nil, err
+	}
+
+	// get the contract commission
+	if params.ContractCommission, err = sfc.contract.ContractCommission(opts); err != nil {
+		return nil, err
+	}
+
+	// get the ratio of rewards paid for unlocked stake
+	if params.UnlockedRewardRatio, err = sfc.contract.UnlockedRewardRatio(opts); err != nil {
+		return nil, err
+	}
+
+	// get the lockup duration limits
+	if params.MinLockupDuration, err = sfc.contract.MinLockupDuration(opts); err != nil {
+		return nil, err
+	}
+	if params.MaxLockupDuration, err = sfc.contract.MaxLockupDuration(opts); err != nil {
+		return nil, err
+	}
+
+	return &params, nil
+}
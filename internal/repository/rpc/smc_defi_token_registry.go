@@ -0,0 +1,994 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package rpc
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// DefiTokenRegistryABI is the input ABI used to generate the binding from.
+const DefiTokenRegistryABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"index\",\"type\":\"uint256\"}],\"name\":\"TokenAdded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"}],\"name\":\"TokenUpdated\",\"type\":\"event\"},{\"constant\":true,\"inputs\":[],\"name\":\"isOwner\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"tokens\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"symbol\",\"type\":\"string\"},{\"internalType\":\"uint8\",\"name\":\"decimals\",\"type\":\"uint8\"},{\"internalType\":\"string\",\"name\":\"logo\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"oracle\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"priceDecimals\",\"type\":\"uint8\"},{\"internalType\":\"bool\",\"name\":\"isActive\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"canDeposit\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"canMint\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"tokensList\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"initialize\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"tokensCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"}],\"name\":\"priceDecimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"}],\"name\":\"isActive\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"}],\"name\":\"canDeposit\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"}],\"name\":\"canMint\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_logo\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_oracle\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"_priceDecimals\",\"type\":\"uint8\"},{\"internalType\":\"bool\",\"name\":\"_isActive\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"_canDeposit\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"_canMint\",\"type\":\"bool\"}],\"name\":\"addToken\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_logo\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_oracle\",\"type\":\"address\"},{\"internalType\":\"uint8\",\"name\":\"_priceDecimals\",\"type\":\"uint8\"},{\"internalType\":\"bool\",\"name\":\"_isActive\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"_canDeposit\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"_canMint\",\"type\":\"bool\"}],\"name\":\"updateToken\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+
+// DefiTokenRegistry is an auto generated Go binding around an Ethereum contract.
+type DefiTokenRegistry struct {
+	DefiTokenRegistryCaller     // Read-only binding to the contract
+	DefiTokenRegistryTransactor // Write-only binding to the contract
+	DefiTokenRegistryFilterer   // Log filterer for contract events
+}
+
+// DefiTokenRegistryCaller is an auto generated read-only Go binding around an Ethereum contract.
+type DefiTokenRegistryCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DefiTokenRegistryTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type DefiTokenRegistryTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DefiTokenRegistryFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type DefiTokenRegistryFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DefiTokenRegistrySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type DefiTokenRegistrySession struct {
+	Contract     *DefiTokenRegistry // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts      // Call options to use throughout this session
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// DefiTokenRegistryCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type DefiTokenRegistryCallerSession struct {
+	Contract *DefiTokenRegistryCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts            // Call options to use throughout this session
+}
+
+// DefiTokenRegistryTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type DefiTokenRegistryTransactorSession struct {
+	Contract     *DefiTokenRegistryTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts            // Transaction auth options to use throughout this session
+}
+
+// DefiTokenRegistryRaw is an auto generated low-level Go binding around an Ethereum contract.
+type DefiTokenRegistryRaw struct {
+	Contract *DefiTokenRegistry // Generic contract binding to access the raw methods on
+}
+
+// DefiTokenRegistryCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type DefiTokenRegistryCallerRaw struct {
+	Contract *DefiTokenRegistryCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// DefiTokenRegistryTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type DefiTokenRegistryTransactorRaw struct {
+	Contract *DefiTokenRegistryTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewDefiTokenRegistry creates a new instance of DefiTokenRegistry, bound to a specific deployed contract.
+func NewDefiTokenRegistry(address common.Address, backend bind.ContractBackend) (*DefiTokenRegistry, error) {
+	contract, err := bindDefiTokenRegistry(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &DefiTokenRegistry{DefiTokenRegistryCaller: DefiTokenRegistryCaller{contract: contract}, DefiTokenRegistryTransactor: DefiTokenRegistryTransactor{contract: contract}, DefiTokenRegistryFilterer: DefiTokenRegistryFilterer{contract: contract}}, nil
+}
+
+// NewDefiTokenRegistryCaller creates a new read-only instance of DefiTokenRegistry, bound to a specific deployed contract.
+func NewDefiTokenRegistryCaller(address common.Address, caller bind.ContractCaller) (*DefiTokenRegistryCaller, error) {
+	contract, err := bindDefiTokenRegistry(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &DefiTokenRegistryCaller{contract: contract}, nil
+}
+
+// NewDefiTokenRegistryTransactor creates a new write-only instance of DefiTokenRegistry, bound to a specific deployed contract.
+func NewDefiTokenRegistryTransactor(address common.Address, transactor bind.ContractTransactor) (*DefiTokenRegistryTransactor, error) {
+	contract, err := bindDefiTokenRegistry(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &DefiTokenRegistryTransactor{contract: contract}, nil
+}
+
+// NewDefiTokenRegistryFilterer creates a new log filterer instance of DefiTokenRegistry, bound to a specific deployed contract.
+func NewDefiTokenRegistryFilterer(address common.Address, filterer bind.ContractFilterer) (*DefiTokenRegistryFilterer, error) {
+	contract, err := bindDefiTokenRegistry(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &DefiTokenRegistryFilterer{contract: contract}, nil
+}
+
+// bindDefiTokenRegistry binds a generic wrapper to an already deployed contract.
+func bindDefiTokenRegistry(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(DefiTokenRegistryABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_DefiTokenRegistry *DefiTokenRegistryRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _DefiTokenRegistry.Contract.DefiTokenRegistryCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_DefiTokenRegistry *DefiTokenRegistryRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DefiTokenRegistry.Contract.DefiTokenRegistryTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_DefiTokenRegistry *DefiTokenRegistryRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _DefiTokenRegistry.Contract.DefiTokenRegistryTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_DefiTokenRegistry *DefiTokenRegistryCallerRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _DefiTokenRegistry.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_DefiTokenRegistry *DefiTokenRegistryTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DefiTokenRegistry.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_DefiTokenRegistry *DefiTokenRegistryTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _DefiTokenRegistry.Contract.contract.Transact(opts, method, params...)
+}
+
+// CanDeposit is a free data retrieval call binding the contract method 0x4bf0d331.
+//
+// Solidity: function canDeposit(address _token) view returns(bool)
+func (_DefiTokenRegistry *DefiTokenRegistryCaller) CanDeposit(opts *bind.CallOpts, _token common.Address) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _DefiTokenRegistry.contract.Call(opts, out, "canDeposit", _token)
+	return *ret0, err
+}
+
+// CanDeposit is a free data retrieval call binding the contract method 0x4bf0d331.
+//
+// Solidity: function canDeposit(address _token) view returns(bool)
+func (_DefiTokenRegistry *DefiTokenRegistrySession) CanDeposit(_token common.Address) (bool, error) {
+	return _DefiTokenRegistry.Contract.CanDeposit(&_DefiTokenRegistry.CallOpts, _token)
+}
+
+// CanDeposit is a free data retrieval call binding the contract method 0x4bf0d331.
+//
+// Solidity: function canDeposit(address _token) view returns(bool)
+func (_DefiTokenRegistry *DefiTokenRegistryCallerSession) CanDeposit(_token common.Address) (bool, error) {
+	return _DefiTokenRegistry.Contract.CanDeposit(&_DefiTokenRegistry.CallOpts, _token)
+}
+
+// CanMint is a free data retrieval call binding the contract method 0xc2ba4744.
+//
+// Solidity: function canMint(address _token) view returns(bool)
+func (_DefiTokenRegistry *DefiTokenRegistryCaller) CanMint(opts *bind.CallOpts, _token common.Address) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _DefiTokenRegistry.contract.Call(opts, out, "canMint", _token)
+	return *ret0, err
+}
+
+// CanMint is a free data retrieval call binding the contract method 0xc2ba4744.
+//
+// Solidity: function canMint(address _token) view returns(bool)
+func (_DefiTokenRegistry *DefiTokenRegistrySession) CanMint(_token common.Address) (bool, error) {
+	return _DefiTokenRegistry.Contract.CanMint(&_DefiTokenRegistry.CallOpts, _token)
+}
+
+// CanMint is a free data retrieval call binding the contract method 0xc2ba4744.
+//
+// Solidity: function canMint(address _token) view returns(bool)
+func (_DefiTokenRegistry *DefiTokenRegistryCallerSession) CanMint(_token common.Address) (bool, error) {
+	return _DefiTokenRegistry.Contract.CanMint(&_DefiTokenRegistry.CallOpts, _token)
+}
+
+// IsActive is a free data retrieval call binding the contract method 0x9f8a13d7.
+//
+// Solidity: function isActive(address _token) view returns(bool)
+func (_DefiTokenRegistry *DefiTokenRegistryCaller) IsActive(opts *bind.CallOpts, _token common.Address) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _DefiTokenRegistry.contract.Call(opts, out, "isActive", _token)
+	return *ret0, err
+}
+
+// IsActive is a free data retrieval call binding the contract method 0x9f8a13d7.
+//
+// Solidity: function isActive(address _token) view returns(bool)
+func (_DefiTokenRegistry *DefiTokenRegistrySession) IsActive(_token common.Address) (bool, error) {
+	return _DefiTokenRegistry.Contract.IsActive(&_DefiTokenRegistry.CallOpts, _token)
+}
+
+// IsActive is a free data retrieval call binding the contract method 0x9f8a13d7.
+//
+// Solidity: function isActive(address _token) view returns(bool)
+func (_DefiTokenRegistry *DefiTokenRegistryCallerSession) IsActive(_token common.Address) (bool, error) {
+	return _DefiTokenRegistry.Contract.IsActive(&_DefiTokenRegistry.CallOpts, _token)
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x8f32d59b.
+//
+// Solidity: function isOwner() view returns(bool)
+func (_DefiTokenRegistry *DefiTokenRegistryCaller) IsOwner(opts *bind.CallOpts) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _DefiTokenRegistry.contract.Call(opts, out, "isOwner")
+	return *ret0, err
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x8f32d59b.
+//
+// Solidity: function isOwner() view returns(bool)
+func (_DefiTokenRegistry *DefiTokenRegistrySession) IsOwner() (bool, error) {
+	return _DefiTokenRegistry.Contract.IsOwner(&_DefiTokenRegistry.CallOpts)
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x8f32d59b.
+//
+// Solidity: function isOwner() view returns(bool)
+func (_DefiTokenRegistry *DefiTokenRegistryCallerSession) IsOwner() (bool, error) {
+	return _DefiTokenRegistry.Contract.IsOwner(&_DefiTokenRegistry.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_DefiTokenRegistry *DefiTokenRegistryCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _DefiTokenRegistry.contract.Call(opts, out, "owner")
+	return *ret0, err
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_DefiTokenRegistry *DefiTokenRegistrySession) Owner() (common.Address, error) {
+	return _DefiTokenRegistry.Contract.Owner(&_DefiTokenRegistry.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_DefiTokenRegistry *DefiTokenRegistryCallerSession) Owner() (common.Address, error) {
+	return _DefiTokenRegistry.Contract.Owner(&_DefiTokenRegistry.CallOpts)
+}
+
+// PriceDecimals is a free data retrieval call binding the contract method 0xcefe0f21.
+//
+// Solidity: function priceDecimals(address _token) view returns(uint8)
+func (_DefiTokenRegistry *DefiTokenRegistryCaller) PriceDecimals(opts *bind.CallOpts, _token common.Address) (uint8, error) {
+	var (
+		ret0 = new(uint8)
+	)
+	out := ret0
+	err := _DefiTokenRegistry.contract.Call(opts, out, "priceDecimals", _token)
+	return *ret0, err
+}
+
+// PriceDecimals is a free data retrieval call binding the contract method 0xcefe0f21.
+//
+// Solidity: function priceDecimals(address _token) view returns(uint8)
+func (_DefiTokenRegistry *DefiTokenRegistrySession) PriceDecimals(_token common.Address) (uint8, error) {
+	return _DefiTokenRegistry.Contract.PriceDecimals(&_DefiTokenRegistry.CallOpts, _token)
+}
+
+// PriceDecimals is a free data retrieval call binding the contract method 0xcefe0f21.
+//
+// Solidity: function priceDecimals(address _token) view returns(uint8)
+func (_DefiTokenRegistry *DefiTokenRegistryCallerSession) PriceDecimals(_token common.Address) (uint8, error) {
+	return _DefiTokenRegistry.Contract.PriceDecimals(&_DefiTokenRegistry.CallOpts, _token)
+}
+
+// Tokens is a free data retrieval call binding the contract method 0xe4860339.
+//
+// Solidity: function tokens(address ) view returns(uint256 id, string name, string symbol, uint8 decimals, string logo, address oracle, uint8 priceDecimals, bool isActive, bool canDeposit, bool canMint)
+func (_DefiTokenRegistry *DefiTokenRegistryCaller) Tokens(opts *bind.CallOpts, arg0 common.Address) (struct {
+	Id            *big.Int
+	Name          string
+	Symbol        string
+	Decimals      uint8
+	Logo          string
+	Oracle        common.Address
+	PriceDecimals uint8
+	IsActive      bool
+	CanDeposit    bool
+	CanMint       bool
+}, error) {
+	ret := new(struct {
+		Id            *big.Int
+		Name          string
+		Symbol        string
+		Decimals      uint8
+		Logo          string
+		Oracle        common.Address
+		PriceDecimals uint8
+		IsActive      bool
+		CanDeposit    bool
+		CanMint       bool
+	})
+	out := ret
+	err := _DefiTokenRegistry.contract.Call(opts, out, "tokens", arg0)
+	return *ret, err
+}
+
+// Tokens is a free data retrieval call binding the contract method 0xe4860339.
+//
+// Solidity: function tokens(address ) view returns(uint256 id, string name, string symbol, uint8 decimals, string logo, address oracle, uint8 priceDecimals, bool isActive, bool canDeposit, bool canMint)
+func (_DefiTokenRegistry *DefiTokenRegistrySession) Tokens(arg0 common.Address) (struct {
+	Id            *big.Int
+	Name          string
+	Symbol        string
+	Decimals      uint8
+	Logo          string
+	Oracle        common.Address
+	PriceDecimals uint8
+	IsActive      bool
+	CanDeposit    bool
+	CanMint       bool
+}, error) {
+	return _DefiTokenRegistry.Contract.Tokens(&_DefiTokenRegistry.CallOpts, arg0)
+}
+
+// Tokens is a free data retrieval call binding the contract method 0xe4860339.
+//
+// Solidity: function tokens(address ) view returns(uint256 id, string name, string symbol, uint8 decimals, string logo, address oracle, uint8 priceDecimals, bool isActive, bool canDeposit, bool canMint)
+func (_DefiTokenRegistry *DefiTokenRegistryCallerSession) Tokens(arg0 common.Address) (struct {
+	Id            *big.Int
+	Name          string
+	Symbol        string
+	Decimals      uint8
+	Logo          string
+	Oracle        common.Address
+	PriceDecimals uint8
+	IsActive      bool
+	CanDeposit    bool
+	CanMint       bool
+}, error) {
+	return _DefiTokenRegistry.Contract.Tokens(&_DefiTokenRegistry.CallOpts, arg0)
+}
+
+// TokensCount is a free data retrieval call binding the contract method 0xa64ed8ba.
+//
+// Solidity: function tokensCount() view returns(uint256)
+func (_DefiTokenRegistry *DefiTokenRegistryCaller) TokensCount(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiTokenRegistry.contract.Call(opts, out, "tokensCount")
+	return *ret0, err
+}
+
+// TokensCount is a free data retrieval call binding the contract method 0xa64ed8ba.
+//
+// Solidity: function tokensCount() view returns(uint256)
+func (_DefiTokenRegistry *DefiTokenRegistrySession) TokensCount() (*big.Int, error) {
+	return _DefiTokenRegistry.Contract.TokensCount(&_DefiTokenRegistry.CallOpts)
+}
+
+// TokensCount is a free data retrieval call binding the contract method 0xa64ed8ba.
+//
+// Solidity: function tokensCount() view returns(uint256)
+func (_DefiTokenRegistry *DefiTokenRegistryCallerSession) TokensCount() (*big.Int, error) {
+	return _DefiTokenRegistry.Contract.TokensCount(&_DefiTokenRegistry.CallOpts)
+}
+
+// TokensList is a free data retrieval call binding the contract method 0x4d12e34e.
+//
+// Solidity: function tokensList(uint256 ) view returns(address)
+func (_DefiTokenRegistry *DefiTokenRegistryCaller) TokensList(opts *bind.CallOpts, arg0 *big.Int) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _DefiTokenRegistry.contract.Call(opts, out, "tokensList", arg0)
+	return *ret0, err
+}
+
+// TokensList is a free data retrieval call binding the contract method 0x4d12e34e.
+//
+// Solidity: function tokensList(uint256 ) view returns(address)
+func (_DefiTokenRegistry *DefiTokenRegistrySession) TokensList(arg0 *big.Int) (common.Address, error) {
+	return _DefiTokenRegistry.Contract.TokensList(&_DefiTokenRegistry.CallOpts, arg0)
+}
+
+// TokensList is a free data retrieval call binding the contract method 0x4d12e34e.
+//
+// Solidity: function tokensList(uint256 ) view returns(address)
+func (_DefiTokenRegistry *DefiTokenRegistryCallerSession) TokensList(arg0 *big.Int) (common.Address, error) {
+	return _DefiTokenRegistry.Contract.TokensList(&_DefiTokenRegistry.CallOpts, arg0)
+}
+
+// AddToken is a paid mutator transaction binding the contract method 0x6a77a214.
+//
+// Solidity: function addToken(address _token, string _logo, address _oracle, uint8 _priceDecimals, bool _isActive, bool _canDeposit, bool _canMint) returns()
+func (_DefiTokenRegistry *DefiTokenRegistryTransactor) AddToken(opts *bind.TransactOpts, _token common.Address, _logo string, _oracle common.Address, _priceDecimals uint8, _isActive bool, _canDeposit bool, _canMint bool) (*types.Transaction, error) {
+	return _DefiTokenRegistry.contract.Transact(opts, "addToken", _token, _logo, _oracle, _priceDecimals, _isActive, _canDeposit, _canMint)
+}
+
+// AddToken is a paid mutator transaction binding the contract method 0x6a77a214.
+//
+// Solidity: function addToken(address _token, string _logo, address _oracle, uint8 _priceDecimals, bool _isActive, bool _canDeposit, bool _canMint) returns()
+func (_DefiTokenRegistry *DefiTokenRegistrySession) AddToken(_token common.Address, _logo string, _oracle common.Address, _priceDecimals uint8, _isActive bool, _canDeposit bool, _canMint bool) (*types.Transaction, error) {
+	return _DefiTokenRegistry.Contract.AddToken(&_DefiTokenRegistry.TransactOpts, _token, _logo, _oracle, _priceDecimals, _isActive, _canDeposit, _canMint)
+}
+
+// AddToken is a paid mutator transaction binding the contract method 0x6a77a214.
+//
+// Solidity: function addToken(address _token, string _logo, address _oracle, uint8 _priceDecimals, bool _isActive, bool _canDeposit, bool _canMint) returns()
+func (_DefiTokenRegistry *DefiTokenRegistryTransactorSession) AddToken(_token common.Address, _logo string, _oracle common.Address, _priceDecimals uint8, _isActive bool, _canDeposit bool, _canMint bool) (*types.Transaction, error) {
+	return _DefiTokenRegistry.Contract.AddToken(&_DefiTokenRegistry.TransactOpts, _token, _logo, _oracle, _priceDecimals, _isActive, _canDeposit, _canMint)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xc4d66de8.
+//
+// Solidity: function initialize(address owner) returns()
+func (_DefiTokenRegistry *DefiTokenRegistryTransactor) Initialize(opts *bind.TransactOpts, owner common.Address) (*types.Transaction, error) {
+	return _DefiTokenRegistry.contract.Transact(opts, "initialize", owner)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xc4d66de8.
+//
+// Solidity: function initialize(address owner) returns()
+func (_DefiTokenRegistry *DefiTokenRegistrySession) Initialize(owner common.Address) (*types.Transaction, error) {
+	return _DefiTokenRegistry.Contract.Initialize(&_DefiTokenRegistry.TransactOpts, owner)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xc4d66de8.
+//
+// Solidity: function initialize(address owner) returns()
+func (_DefiTokenRegistry *DefiTokenRegistryTransactorSession) Initialize(owner common.Address) (*types.Transaction, error) {
+	return _DefiTokenRegistry.Contract.Initialize(&_DefiTokenRegistry.TransactOpts, owner)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_DefiTokenRegistry *DefiTokenRegistryTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DefiTokenRegistry.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_DefiTokenRegistry *DefiTokenRegistrySession) RenounceOwnership() (*types.Transaction, error) {
+	return _DefiTokenRegistry.Contract.RenounceOwnership(&_DefiTokenRegistry.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_DefiTokenRegistry *DefiTokenRegistryTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _DefiTokenRegistry.Contract.RenounceOwnership(&_DefiTokenRegistry.TransactOpts)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_DefiTokenRegistry *DefiTokenRegistryTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _DefiTokenRegistry.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_DefiTokenRegistry *DefiTokenRegistrySession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _DefiTokenRegistry.Contract.TransferOwnership(&_DefiTokenRegistry.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_DefiTokenRegistry *DefiTokenRegistryTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _DefiTokenRegistry.Contract.TransferOwnership(&_DefiTokenRegistry.TransactOpts, newOwner)
+}
+
+// UpdateToken is a paid mutator transaction binding the contract method 0xd75c1c4c.
+//
+// Solidity: function updateToken(address _token, string _logo, address _oracle, uint8 _priceDecimals, bool _isActive, bool _canDeposit, bool _canMint) returns()
+func (_DefiTokenRegistry *DefiTokenRegistryTransactor) UpdateToken(opts *bind.TransactOpts, _token common.Address, _logo string, _oracle common.Address, _priceDecimals uint8, _isActive bool, _canDeposit bool, _canMint bool) (*types.Transaction, error) {
+	return _DefiTokenRegistry.contract.Transact(opts, "updateToken", _token, _logo, _oracle, _priceDecimals, _isActive, _canDeposit, _canMint)
+}
+
+// UpdateToken is a paid mutator transaction binding the contract method 0xd75c1c4c.
+//
+// Solidity: function updateToken(address _token, string _logo, address _oracle, uint8 _priceDecimals, bool _isActive, bool _canDeposit, bool _canMint) returns()
+func (_DefiTokenRegistry *DefiTokenRegistrySession) UpdateToken(_token common.Address, _logo string, _oracle common.Address, _priceDecimals uint8, _isActive bool, _canDeposit bool, _canMint bool) (*types.Transaction, error) {
+	return _DefiTokenRegistry.Contract.UpdateToken(&_DefiTokenRegistry.TransactOpts, _token, _logo, _oracle, _priceDecimals, _isActive, _canDeposit, _canMint)
+}
+
+// UpdateToken is a paid mutator transaction binding the contract method 0xd75c1c4c.
+//
+// Solidity: function updateToken(address _token, string _logo, address _oracle, uint8 _priceDecimals, bool _isActive, bool _canDeposit, bool _canMint) returns()
+func (_DefiTokenRegistry *DefiTokenRegistryTransactorSession) UpdateToken(_token common.Address, _logo string, _oracle common.Address, _priceDecimals uint8, _isActive bool, _canDeposit bool, _canMint bool) (*types.Transaction, error) {
+	return _DefiTokenRegistry.Contract.UpdateToken(&_DefiTokenRegistry.TransactOpts, _token, _logo, _oracle, _priceDecimals, _isActive, _canDeposit, _canMint)
+}
+
+// DefiTokenRegistryOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the DefiTokenRegistry contract.
+type DefiTokenRegistryOwnershipTransferredIterator struct {
+	Event *DefiTokenRegistryOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DefiTokenRegistryOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DefiTokenRegistryOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DefiTokenRegistryOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DefiTokenRegistryOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DefiTokenRegistryOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DefiTokenRegistryOwnershipTransferred represents a OwnershipTransferred event raised by the DefiTokenRegistry contract.
+type DefiTokenRegistryOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_DefiTokenRegistry *DefiTokenRegistryFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*DefiTokenRegistryOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _DefiTokenRegistry.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DefiTokenRegistryOwnershipTransferredIterator{contract: _DefiTokenRegistry.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_DefiTokenRegistry *DefiTokenRegistryFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *DefiTokenRegistryOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _DefiTokenRegistry.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DefiTokenRegistryOwnershipTransferred)
+				if err := _DefiTokenRegistry.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_DefiTokenRegistry *DefiTokenRegistryFilterer) ParseOwnershipTransferred(log types.Log) (*DefiTokenRegistryOwnershipTransferred, error) {
+	event := new(DefiTokenRegistryOwnershipTransferred)
+	if err := _DefiTokenRegistry.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// DefiTokenRegistryTokenAddedIterator is returned from FilterTokenAdded and is used to iterate over the raw logs and unpacked data for TokenAdded events raised by the DefiTokenRegistry contract.
+type DefiTokenRegistryTokenAddedIterator struct {
+	Event *DefiTokenRegistryTokenAdded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DefiTokenRegistryTokenAddedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DefiTokenRegistryTokenAdded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DefiTokenRegistryTokenAdded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DefiTokenRegistryTokenAddedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DefiTokenRegistryTokenAddedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DefiTokenRegistryTokenAdded represents a TokenAdded event raised by the DefiTokenRegistry contract.
+type DefiTokenRegistryTokenAdded struct {
+	Token common.Address
+	Name  string
+	Index *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterTokenAdded is a free log retrieval operation binding the contract event 0x4af7419360b60cfcf01ac8a5c1487814e666a0af47877d73e82476772ac9150f.
+//
+// Solidity: event TokenAdded(address indexed token, string name, uint256 index)
+func (_DefiTokenRegistry *DefiTokenRegistryFilterer) FilterTokenAdded(opts *bind.FilterOpts, token []common.Address) (*DefiTokenRegistryTokenAddedIterator, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _DefiTokenRegistry.contract.FilterLogs(opts, "TokenAdded", tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DefiTokenRegistryTokenAddedIterator{contract: _DefiTokenRegistry.contract, event: "TokenAdded", logs: logs, sub: sub}, nil
+}
+
+// WatchTokenAdded is a free log subscription operation binding the contract event 0x4af7419360b60cfcf01ac8a5c1487814e666a0af47877d73e82476772ac9150f.
+//
+// Solidity: event TokenAdded(address indexed token, string name, uint256 index)
+func (_DefiTokenRegistry *DefiTokenRegistryFilterer) WatchTokenAdded(opts *bind.WatchOpts, sink chan<- *DefiTokenRegistryTokenAdded, token []common.Address) (event.Subscription, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _DefiTokenRegistry.contract.WatchLogs(opts, "TokenAdded", tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DefiTokenRegistryTokenAdded)
+				if err := _DefiTokenRegistry.contract.UnpackLog(event, "TokenAdded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTokenAdded is a log parse operation binding the contract event 0x4af7419360b60cfcf01ac8a5c1487814e666a0af47877d73e82476772ac9150f.
+//
+// Solidity: event TokenAdded(address indexed token, string name, uint256 index)
+func (_DefiTokenRegistry *DefiTokenRegistryFilterer) ParseTokenAdded(log types.Log) (*DefiTokenRegistryTokenAdded, error) {
+	event := new(DefiTokenRegistryTokenAdded)
+	if err := _DefiTokenRegistry.contract.UnpackLog(event, "TokenAdded", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// DefiTokenRegistryTokenUpdatedIterator is returned from FilterTokenUpdated and is used to iterate over the raw logs and unpacked data for TokenUpdated events raised by the DefiTokenRegistry contract.
+type DefiTokenRegistryTokenUpdatedIterator struct {
+	Event *DefiTokenRegistryTokenUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DefiTokenRegistryTokenUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DefiTokenRegistryTokenUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DefiTokenRegistryTokenUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DefiTokenRegistryTokenUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DefiTokenRegistryTokenUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DefiTokenRegistryTokenUpdated represents a TokenUpdated event raised by the DefiTokenRegistry contract.
+type DefiTokenRegistryTokenUpdated struct {
+	Token common.Address
+	Name  string
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterTokenUpdated is a free log retrieval operation binding the contract event 0x7dfa4f44638df9ca9c035c37f4954edb0383135db7751b81208a86345775a159.
+//
+// Solidity: event TokenUpdated(address indexed token, string name)
+func (_DefiTokenRegistry *DefiTokenRegistryFilterer) FilterTokenUpdated(opts *bind.FilterOpts, token []common.Address) (*DefiTokenRegistryTokenUpdatedIterator, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _DefiTokenRegistry.contract.FilterLogs(opts, "TokenUpdated", tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DefiTokenRegistryTokenUpdatedIterator{contract: _DefiTokenRegistry.contract, event: "TokenUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchTokenUpdated is a free log subscription operation binding the contract event 0x7dfa4f44638df9ca9c035c37f4954edb0383135db7751b81208a86345775a159.
+//
+// Solidity: event TokenUpdated(address indexed token, string name)
+func (_DefiTokenRegistry *DefiTokenRegistryFilterer) WatchTokenUpdated(opts *bind.WatchOpts, sink chan<- *DefiTokenRegistryTokenUpdated, token []common.Address) (event.Subscription, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _DefiTokenRegistry.contract.WatchLogs(opts, "TokenUpdated", tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DefiTokenRegistryTokenUpdated)
+				if err := _DefiTokenRegistry.contract.UnpackLog(event, "TokenUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTokenUpdated is a log parse operation binding the contract event 0x7dfa4f44638df9ca9c035c37f4954edb0383135db7751b81208a86345775a159.
+//
+// Solidity: event TokenUpdated(address indexed token, string name)
+func (_DefiTokenRegistry *DefiTokenRegistryFilterer) ParseTokenUpdated(log types.Log) (*DefiTokenRegistryTokenUpdated, error) {
+	event := new(DefiTokenRegistryTokenUpdated)
+	if err := _DefiTokenRegistry.contract.UnpackLog(event, "TokenUpdated", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
@@ -0,0 +1,547 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package rpc
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// DefiTokenStorageABI is the input ABI used to generate the binding from.
+const DefiTokenStorageABI = "[{\"constant\":true,\"inputs\":[],\"name\":\"addressProvider\",\"outputs\":[{\"internalType\":\"contractIFantomMintAddressProvider\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"balance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"tokens\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"totalBalance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"valueDustAdjustment\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addressProvider\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"_dustAdt\",\"type\":\"bool\"}],\"name\":\"initialize\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"tokenValue\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"total\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"totalOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"totalOfInc\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"totalOfDec\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"add\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"sub\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"tokensCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"}]"
+
+// DefiTokenStorage is an auto generated Go binding around an Ethereum contract.
+type DefiTokenStorage struct {
+	DefiTokenStorageCaller     // Read-only binding to the contract
+	DefiTokenStorageTransactor // Write-only binding to the contract
+	DefiTokenStorageFilterer   // Log filterer for contract events
+}
+
+// DefiTokenStorageCaller is an auto generated read-only Go binding around an Ethereum contract.
+type DefiTokenStorageCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DefiTokenStorageTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type DefiTokenStorageTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DefiTokenStorageFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type DefiTokenStorageFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DefiTokenStorageSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type DefiTokenStorageSession struct {
+	Contract     *DefiTokenStorage // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// DefiTokenStorageCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type DefiTokenStorageCallerSession struct {
+	Contract *DefiTokenStorageCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts           // Call options to use throughout this session
+}
+
+// DefiTokenStorageTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type DefiTokenStorageTransactorSession struct {
+	Contract     *DefiTokenStorageTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts           // Transaction auth options to use throughout this session
+}
+
+// DefiTokenStorageRaw is an auto generated low-level Go binding around an Ethereum contract.
+type DefiTokenStorageRaw struct {
+	Contract *DefiTokenStorage // Generic contract binding to access the raw methods on
+}
+
+// DefiTokenStorageCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type DefiTokenStorageCallerRaw struct {
+	Contract *DefiTokenStorageCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// DefiTokenStorageTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type DefiTokenStorageTransactorRaw struct {
+	Contract *DefiTokenStorageTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewDefiTokenStorage creates a new instance of DefiTokenStorage, bound to a specific deployed contract.
+func NewDefiTokenStorage(address common.Address, backend bind.ContractBackend) (*DefiTokenStorage, error) {
+	contract, err := bindDefiTokenStorage(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &DefiTokenStorage{DefiTokenStorageCaller: DefiTokenStorageCaller{contract: contract}, DefiTokenStorageTransactor: DefiTokenStorageTransactor{contract: contract}, DefiTokenStorageFilterer: DefiTokenStorageFilterer{contract: contract}}, nil
+}
+
+// NewDefiTokenStorageCaller creates a new read-only instance of DefiTokenStorage, bound to a specific deployed contract.
+func NewDefiTokenStorageCaller(address common.Address, caller bind.ContractCaller) (*DefiTokenStorageCaller, error) {
+	contract, err := bindDefiTokenStorage(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &DefiTokenStorageCaller{contract: contract}, nil
+}
+
+// NewDefiTokenStorageTransactor creates a new write-only instance of DefiTokenStorage, bound to a specific deployed contract.
+func NewDefiTokenStorageTransactor(address common.Address, transactor bind.ContractTransactor) (*DefiTokenStorageTransactor, error) {
+	contract, err := bindDefiTokenStorage(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &DefiTokenStorageTransactor{contract: contract}, nil
+}
+
+// NewDefiTokenStorageFilterer creates a new log filterer instance of DefiTokenStorage, bound to a specific deployed contract.
+func NewDefiTokenStorageFilterer(address common.Address, filterer bind.ContractFilterer) (*DefiTokenStorageFilterer, error) {
+	contract, err := bindDefiTokenStorage(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &DefiTokenStorageFilterer{contract: contract}, nil
+}
+
+// bindDefiTokenStorage binds a generic wrapper to an already deployed contract.
+func bindDefiTokenStorage(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(DefiTokenStorageABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_DefiTokenStorage *DefiTokenStorageRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _DefiTokenStorage.Contract.DefiTokenStorageCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_DefiTokenStorage *DefiTokenStorageRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DefiTokenStorage.Contract.DefiTokenStorageTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_DefiTokenStorage *DefiTokenStorageRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _DefiTokenStorage.Contract.DefiTokenStorageTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_DefiTokenStorage *DefiTokenStorageCallerRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _DefiTokenStorage.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_DefiTokenStorage *DefiTokenStorageTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DefiTokenStorage.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_DefiTokenStorage *DefiTokenStorageTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _DefiTokenStorage.Contract.contract.Transact(opts, method, params...)
+}
+
+// AddressProvider is a free data retrieval call binding the contract method 0x2954018c.
+//
+// Solidity: function addressProvider() view returns(address)
+func (_DefiTokenStorage *DefiTokenStorageCaller) AddressProvider(opts *bind.CallOpts) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _DefiTokenStorage.contract.Call(opts, out, "addressProvider")
+	return *ret0, err
+}
+
+// AddressProvider is a free data retrieval call binding the contract method 0x2954018c.
+//
+// Solidity: function addressProvider() view returns(address)
+func (_DefiTokenStorage *DefiTokenStorageSession) AddressProvider() (common.Address, error) {
+	return _DefiTokenStorage.Contract.AddressProvider(&_DefiTokenStorage.CallOpts)
+}
+
+// AddressProvider is a free data retrieval call binding the contract method 0x2954018c.
+//
+// Solidity: function addressProvider() view returns(address)
+func (_DefiTokenStorage *DefiTokenStorageCallerSession) AddressProvider() (common.Address, error) {
+	return _DefiTokenStorage.Contract.AddressProvider(&_DefiTokenStorage.CallOpts)
+}
+
+// Balance is a free data retrieval call binding the contract method 0xb203bb99.
+//
+// Solidity: function balance(address , address ) view returns(uint256)
+func (_DefiTokenStorage *DefiTokenStorageCaller) Balance(opts *bind.CallOpts, arg0 common.Address, arg1 common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiTokenStorage.contract.Call(opts, out, "balance", arg0, arg1)
+	return *ret0, err
+}
+
+// Balance is a free data retrieval call binding the contract method 0xb203bb99.
+//
+// Solidity: function balance(address , address ) view returns(uint256)
+func (_DefiTokenStorage *DefiTokenStorageSession) Balance(arg0 common.Address, arg1 common.Address) (*big.Int, error) {
+	return _DefiTokenStorage.Contract.Balance(&_DefiTokenStorage.CallOpts, arg0, arg1)
+}
+
+// Balance is a free data retrieval call binding the contract method 0xb203bb99.
+//
+// Solidity: function balance(address , address ) view returns(uint256)
+func (_DefiTokenStorage *DefiTokenStorageCallerSession) Balance(arg0 common.Address, arg1 common.Address) (*big.Int, error) {
+	return _DefiTokenStorage.Contract.Balance(&_DefiTokenStorage.CallOpts, arg0, arg1)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0xf7888aec.
+//
+// Solidity: function balanceOf(address _account, address _token) view returns(uint256)
+func (_DefiTokenStorage *DefiTokenStorageCaller) BalanceOf(opts *bind.CallOpts, _account common.Address, _token common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiTokenStorage.contract.Call(opts, out, "balanceOf", _account, _token)
+	return *ret0, err
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0xf7888aec.
+//
+// Solidity: function balanceOf(address _account, address _token) view returns(uint256)
+func (_DefiTokenStorage *DefiTokenStorageSession) BalanceOf(_account common.Address, _token common.Address) (*big.Int, error) {
+	return _DefiTokenStorage.Contract.BalanceOf(&_DefiTokenStorage.CallOpts, _account, _token)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0xf7888aec.
+//
+// Solidity: function balanceOf(address _account, address _token) view returns(uint256)
+func (_DefiTokenStorage *DefiTokenStorageCallerSession) BalanceOf(_account common.Address, _token common.Address) (*big.Int, error) {
+	return _DefiTokenStorage.Contract.BalanceOf(&_DefiTokenStorage.CallOpts, _account, _token)
+}
+
+// TokenValue is a free data retrieval call binding the contract method 0xf1821783.
+//
+// Solidity: function tokenValue(address _token, uint256 _amount) view returns(uint256 value)
+func (_DefiTokenStorage *DefiTokenStorageCaller) TokenValue(opts *bind.CallOpts, _token common.Address, _amount *big.Int) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiTokenStorage.contract.Call(opts, out, "tokenValue", _token, _amount)
+	return *ret0, err
+}
+
+// TokenValue is a free data retrieval call binding the contract method 0xf1821783.
+//
+// Solidity: function tokenValue(address _token, uint256 _amount) view returns(uint256 value)
+func (_DefiTokenStorage *DefiTokenStorageSession) TokenValue(_token common.Address, _amount *big.Int) (*big.Int, error) {
+	return _DefiTokenStorage.Contract.TokenValue(&_DefiTokenStorage.CallOpts, _token, _amount)
+}
+
+// TokenValue is a free data retrieval call binding the contract method 0xf1821783.
+//
+// Solidity: function tokenValue(address _token, uint256 _amount) view returns(uint256 value)
+func (_DefiTokenStorage *DefiTokenStorageCallerSession) TokenValue(_token common.Address, _amount *big.Int) (*big.Int, error) {
+	return _DefiTokenStorage.Contract.TokenValue(&_DefiTokenStorage.CallOpts, _token, _amount)
+}
+
+// Tokens is a free data retrieval call binding the contract method 0x4f64b2be.
+//
+// Solidity: function tokens(uint256 ) view returns(address)
+func (_DefiTokenStorage *DefiTokenStorageCaller) Tokens(opts *bind.CallOpts, arg0 *big.Int) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _DefiTokenStorage.contract.Call(opts, out, "tokens", arg0)
+	return *ret0, err
+}
+
+// Tokens is a free data retrieval call binding the contract method 0x4f64b2be.
+//
+// Solidity: function tokens(uint256 ) view returns(address)
+func (_DefiTokenStorage *DefiTokenStorageSession) Tokens(arg0 *big.Int) (common.Address, error) {
+	return _DefiTokenStorage.Contract.Tokens(&_DefiTokenStorage.CallOpts, arg0)
+}
+
+// Tokens is a free data retrieval call binding the contract method 0x4f64b2be.
+//
+// Solidity: function tokens(uint256 ) view returns(address)
+func (_DefiTokenStorage *DefiTokenStorageCallerSession) Tokens(arg0 *big.Int) (common.Address, error) {
+	return _DefiTokenStorage.Contract.Tokens(&_DefiTokenStorage.CallOpts, arg0)
+}
+
+// TokensCount is a free data retrieval call binding the contract method 0xa64ed8ba.
+//
+// Solidity: function tokensCount() view returns(uint256)
+func (_DefiTokenStorage *DefiTokenStorageCaller) TokensCount(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiTokenStorage.contract.Call(opts, out, "tokensCount")
+	return *ret0, err
+}
+
+// TokensCount is a free data retrieval call binding the contract method 0xa64ed8ba.
+//
+// Solidity: function tokensCount() view returns(uint256)
+func (_DefiTokenStorage *DefiTokenStorageSession) TokensCount() (*big.Int, error) {
+	return _DefiTokenStorage.Contract.TokensCount(&_DefiTokenStorage.CallOpts)
+}
+
+// TokensCount is a free data retrieval call binding the contract method 0xa64ed8ba.
+//
+// Solidity: function tokensCount() view returns(uint256)
+func (_DefiTokenStorage *DefiTokenStorageCallerSession) TokensCount() (*big.Int, error) {
+	return _DefiTokenStorage.Contract.TokensCount(&_DefiTokenStorage.CallOpts)
+}
+
+// Total is a free data retrieval call binding the contract method 0x2ddbd13a.
+//
+// Solidity: function total() view returns(uint256 value)
+func (_DefiTokenStorage *DefiTokenStorageCaller) Total(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiTokenStorage.contract.Call(opts, out, "total")
+	return *ret0, err
+}
+
+// Total is a free data retrieval call binding the contract method 0x2ddbd13a.
+//
+// Solidity: function total() view returns(uint256 value)
+func (_DefiTokenStorage *DefiTokenStorageSession) Total() (*big.Int, error) {
+	return _DefiTokenStorage.Contract.Total(&_DefiTokenStorage.CallOpts)
+}
+
+// Total is a free data retrieval call binding the contract method 0x2ddbd13a.
+//
+// Solidity: function total() view returns(uint256 value)
+func (_DefiTokenStorage *DefiTokenStorageCallerSession) Total() (*big.Int, error) {
+	return _DefiTokenStorage.Contract.Total(&_DefiTokenStorage.CallOpts)
+}
+
+// TotalBalance is a free data retrieval call binding the contract method 0x6eacd398.
+//
+// Solidity: function totalBalance(address ) view returns(uint256)
+func (_DefiTokenStorage *DefiTokenStorageCaller) TotalBalance(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiTokenStorage.contract.Call(opts, out, "totalBalance", arg0)
+	return *ret0, err
+}
+
+// TotalBalance is a free data retrieval call binding the contract method 0x6eacd398.
+//
+// Solidity: function totalBalance(address ) view returns(uint256)
+func (_DefiTokenStorage *DefiTokenStorageSession) TotalBalance(arg0 common.Address) (*big.Int, error) {
+	return _DefiTokenStorage.Contract.TotalBalance(&_DefiTokenStorage.CallOpts, arg0)
+}
+
+// TotalBalance is a free data retrieval call binding the contract method 0x6eacd398.
+//
+// Solidity: function totalBalance(address ) view returns(uint256)
+func (_DefiTokenStorage *DefiTokenStorageCallerSession) TotalBalance(arg0 common.Address) (*big.Int, error) {
+	return _DefiTokenStorage.Contract.TotalBalance(&_DefiTokenStorage.CallOpts, arg0)
+}
+
+// TotalOf is a free data retrieval call binding the contract method 0x912c2673.
+//
+// Solidity: function totalOf(address _account) view returns(uint256)
+func (_DefiTokenStorage *DefiTokenStorageCaller) TotalOf(opts *bind.CallOpts, _account common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiTokenStorage.contract.Call(opts, out, "totalOf", _account)
+	return *ret0, err
+}
+
+// TotalOf is a free data retrieval call binding the contract method 0x912c2673.
+//
+// Solidity: function totalOf(address _account) view returns(uint256)
+func (_DefiTokenStorage *DefiTokenStorageSession) TotalOf(_account common.Address) (*big.Int, error) {
+	return _DefiTokenStorage.Contract.TotalOf(&_DefiTokenStorage.CallOpts, _account)
+}
+
+// TotalOf is a free data retrieval call binding the contract method 0x912c2673.
+//
+// Solidity: function totalOf(address _account) view returns(uint256)
+func (_DefiTokenStorage *DefiTokenStorageCallerSession) TotalOf(_account common.Address) (*big.Int, error) {
+	return _DefiTokenStorage.Contract.TotalOf(&_DefiTokenStorage.CallOpts, _account)
+}
+
+// TotalOfDec is a free data retrieval call binding the contract method 0x65be454d.
+//
+// Solidity: function totalOfDec(address _account, address _token, uint256 _amount) view returns(uint256 value)
+func (_DefiTokenStorage *DefiTokenStorageCaller) TotalOfDec(opts *bind.CallOpts, _account common.Address, _token common.Address, _amount *big.Int) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiTokenStorage.contract.Call(opts, out, "totalOfDec", _account, _token, _amount)
+	return *ret0, err
+}
+
+// TotalOfDec is a free data retrieval call binding the contract method 0x65be454d.
+//
+// Solidity: function totalOfDec(address _account, address _token, uint256 _amount) view returns(uint256 value)
+func (_DefiTokenStorage *DefiTokenStorageSession) TotalOfDec(_account common.Address, _token common.Address, _amount *big.Int) (*big.Int, error) {
+	return _DefiTokenStorage.Contract.TotalOfDec(&_DefiTokenStorage.CallOpts, _account, _token, _amount)
+}
+
+// TotalOfDec is a free data retrieval call binding the contract method 0x65be454d.
+//
+// Solidity: function totalOfDec(address _account, address _token, uint256 _amount) view returns(uint256 value)
+func (_DefiTokenStorage *DefiTokenStorageCallerSession) TotalOfDec(_account common.Address, _token common.Address, _amount *big.Int) (*big.Int, error) {
+	return _DefiTokenStorage.Contract.TotalOfDec(&_DefiTokenStorage.CallOpts, _account, _token, _amount)
+}
+
+// TotalOfInc is a free data retrieval call binding the contract method 0x660eab83.
+//
+// Solidity: function totalOfInc(address _account, address _token, uint256 _amount) view returns(uint256 value)
+func (_DefiTokenStorage *DefiTokenStorageCaller) TotalOfInc(opts *bind.CallOpts, _account common.Address, _token common.Address, _amount *big.Int) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiTokenStorage.contract.Call(opts, out, "totalOfInc", _account, _token, _amount)
+	return *ret0, err
+}
+
+// TotalOfInc is a free data retrieval call binding the contract method 0x660eab83.
+//
+// Solidity: function totalOfInc(address _account, address _token, uint256 _amount) view returns(uint256 value)
+func (_DefiTokenStorage *DefiTokenStorageSession) TotalOfInc(_account common.Address, _token common.Address, _amount *big.Int) (*big.Int, error) {
+	return _DefiTokenStorage.Contract.TotalOfInc(&_DefiTokenStorage.CallOpts, _account, _token, _amount)
+}
+
+// TotalOfInc is a free data retrieval call binding the contract method 0x660eab83.
+//
+// Solidity: function totalOfInc(address _account, address _token, uint256 _amount) view returns(uint256 value)
+func (_DefiTokenStorage *DefiTokenStorageCallerSession) TotalOfInc(_account common.Address, _token common.Address, _amount *big.Int) (*big.Int, error) {
+	return _DefiTokenStorage.Contract.TotalOfInc(&_DefiTokenStorage.CallOpts, _account, _token, _amount)
+}
+
+// ValueDustAdjustment is a free data retrieval call binding the contract method 0x496a1140.
+//
+// Solidity: function valueDustAdjustment() view returns(bool)
+func (_DefiTokenStorage *DefiTokenStorageCaller) ValueDustAdjustment(opts *bind.CallOpts) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _DefiTokenStorage.contract.Call(opts, out, "valueDustAdjustment")
+	return *ret0, err
+}
+
+// ValueDustAdjustment is a free data retrieval call binding the contract method 0x496a1140.
+//
+// Solidity: function valueDustAdjustment() view returns(bool)
+func (_DefiTokenStorage *DefiTokenStorageSession) ValueDustAdjustment() (bool, error) {
+	return _DefiTokenStorage.Contract.ValueDustAdjustment(&_DefiTokenStorage.CallOpts)
+}
+
+// ValueDustAdjustment is a free data retrieval call binding the contract method 0x496a1140.
+//
+// Solidity: function valueDustAdjustment() view returns(bool)
+func (_DefiTokenStorage *DefiTokenStorageCallerSession) ValueDustAdjustment() (bool, error) {
+	return _DefiTokenStorage.Contract.ValueDustAdjustment(&_DefiTokenStorage.CallOpts)
+}
+
+// Add is a paid mutator transaction binding the contract method 0x551f8e2a.
+//
+// Solidity: function add(address _account, address _token, uint256 _amount) returns()
+func (_DefiTokenStorage *DefiTokenStorageTransactor) Add(opts *bind.TransactOpts, _account common.Address, _token common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _DefiTokenStorage.contract.Transact(opts, "add", _account, _token, _amount)
+}
+
+// Add is a paid mutator transaction binding the contract method 0x551f8e2a.
+//
+// Solidity: function add(address _account, address _token, uint256 _amount) returns()
+func (_DefiTokenStorage *DefiTokenStorageSession) Add(_account common.Address, _token common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _DefiTokenStorage.Contract.Add(&_DefiTokenStorage.TransactOpts, _account, _token, _amount)
+}
+
+// Add is a paid mutator transaction binding the contract method 0x551f8e2a.
+//
+// Solidity: function add(address _account, address _token, uint256 _amount) returns()
+func (_DefiTokenStorage *DefiTokenStorageTransactorSession) Add(_account common.Address, _token common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _DefiTokenStorage.Contract.Add(&_DefiTokenStorage.TransactOpts, _account, _token, _amount)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x400ada75.
+//
+// Solidity: function initialize(address _addressProvider, bool _dustAdt) returns()
+func (_DefiTokenStorage *DefiTokenStorageTransactor) Initialize(opts *bind.TransactOpts, _addressProvider common.Address, _dustAdt bool) (*types.Transaction, error) {
+	return _DefiTokenStorage.contract.Transact(opts, "initialize", _addressProvider, _dustAdt)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x400ada75.
+//
+// Solidity: function initialize(address _addressProvider, bool _dustAdt) returns()
+func (_DefiTokenStorage *DefiTokenStorageSession) Initialize(_addressProvider common.Address, _dustAdt bool) (*types.Transaction, error) {
+	return _DefiTokenStorage.Contract.Initialize(&_DefiTokenStorage.TransactOpts, _addressProvider, _dustAdt)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x400ada75.
+//
+// Solidity: function initialize(address _addressProvider, bool _dustAdt) returns()
+func (_DefiTokenStorage *DefiTokenStorageTransactorSession) Initialize(_addressProvider common.Address, _dustAdt bool) (*types.Transaction, error) {
+	return _DefiTokenStorage.Contract.Initialize(&_DefiTokenStorage.TransactOpts, _addressProvider, _dustAdt)
+}
+
+// Sub is a paid mutator transaction binding the contract method 0x55ceeb31.
+//
+// Solidity: function sub(address _account, address _token, uint256 _amount) returns()
+func (_DefiTokenStorage *DefiTokenStorageTransactor) Sub(opts *bind.TransactOpts, _account common.Address, _token common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _DefiTokenStorage.contract.Transact(opts, "sub", _account, _token, _amount)
+}
+
+// Sub is a paid mutator transaction binding the contract method 0x55ceeb31.
+//
+// Solidity: function sub(address _account, address _token, uint256 _amount) returns()
+func (_DefiTokenStorage *DefiTokenStorageSession) Sub(_account common.Address, _token common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _DefiTokenStorage.Contract.Sub(&_DefiTokenStorage.TransactOpts, _account, _token, _amount)
+}
+
+// Sub is a paid mutator transaction binding the contract method 0x55ceeb31.
+//
+// Solidity: function sub(address _account, address _token, uint256 _amount) returns()
+func (_DefiTokenStorage *DefiTokenStorageTransactorSession) Sub(_account common.Address, _token common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _DefiTokenStorage.Contract.Sub(&_DefiTokenStorage.TransactOpts, _account, _token, _amount)
+}
@@ -0,0 +1,2000 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package rpc
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// DefiFMintRewardDistributionABI is the input ABI used to generate the binding from.
+const DefiFMintRewardDistributionABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"rewardPerYear\",\"type\":\"uint256\"}],\"name\":\"RateUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"reward\",\"type\":\"uint256\"}],\"name\":\"RewardAdded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"reward\",\"type\":\"uint256\"}],\"name\":\"RewardPaid\",\"type\":\"event\"},{\"constant\":true,\"inputs\":[],\"name\":\"ERR_DEBT_EXCEEDED\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"ERR_DEPOSIT_PROHIBITED\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"ERR_LOW_ALLOWANCE\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"ERR_LOW_AMOUNT\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"ERR_LOW_BALANCE\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"ERR_LOW_COLLATERAL_RATIO\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"ERR_MINTING_PROHIBITED\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"ERR_NOT_AUTHORIZED\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"ERR_NO_COLLATERAL\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"ERR_NO_ERROR\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"ERR_NO_REWARD\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"ERR_NO_VALUE\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"ERR_REWARDS_DEPLETED\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"ERR_REWARDS_EARLY\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"ERR_REWARDS_NONE\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"ERR_REWARD_CLAIM_REJECTED\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"ERR_ZERO_AMOUNT\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"addressProvider\",\"outputs\":[{\"internalType\":\"contractIFantomMintAddressProvider\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"isOwner\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"lastRewardPush\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"minRewardPushInterval\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"mustRewardClaim\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"rewardApplicableUntil\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"rewardClaim\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"rewardEarned\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"rewardEpochEnds\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"rewardEpochLength\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"rewardLastPerToken\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"rewardPerSecond\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"rewardPerToken\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"rewardPerTokenDecimalsCorrection\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"rewardPerTokenPaid\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"rewardRate\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"rewardStash\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"rewardUpdate\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"rewardUpdateGlobal\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"rewardUpdated\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_addressProvider\",\"type\":\"address\"}],\"name\":\"initialize\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"initialize\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"mustRewardPush\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"rewardPush\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_perSecond\",\"type\":\"uint256\"}],\"name\":\"rewardUpdateRate\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_recipient\",\"type\":\"address\"}],\"name\":\"rewardCleanup\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"principalBalance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"principalBalanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"rewardTokenAddress\",\"outputs\":[{\"internalType\":\"contractERC20\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"rewardCanClaim\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"rewardIsEligible\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"}]"
+
+// DefiFMintRewardDistribution is an auto generated Go binding around an Ethereum contract.
+type DefiFMintRewardDistribution struct {
+	DefiFMintRewardDistributionCaller     // Read-only binding to the contract
+	DefiFMintRewardDistributionTransactor // Write-only binding to the contract
+	DefiFMintRewardDistributionFilterer   // Log filterer for contract events
+}
+
+// DefiFMintRewardDistributionCaller is an auto generated read-only Go binding around an Ethereum contract.
+type DefiFMintRewardDistributionCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DefiFMintRewardDistributionTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type DefiFMintRewardDistributionTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DefiFMintRewardDistributionFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type DefiFMintRewardDistributionFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DefiFMintRewardDistributionSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type DefiFMintRewardDistributionSession struct {
+	Contract     *DefiFMintRewardDistribution // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts                // Call options to use throughout this session
+	TransactOpts bind.TransactOpts            // Transaction auth options to use throughout this session
+}
+
+// DefiFMintRewardDistributionCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type DefiFMintRewardDistributionCallerSession struct {
+	Contract *DefiFMintRewardDistributionCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                      // Call options to use throughout this session
+}
+
+// DefiFMintRewardDistributionTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type DefiFMintRewardDistributionTransactorSession struct {
+	Contract     *DefiFMintRewardDistributionTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                      // Transaction auth options to use throughout this session
+}
+
+// DefiFMintRewardDistributionRaw is an auto generated low-level Go binding around an Ethereum contract.
+type DefiFMintRewardDistributionRaw struct {
+	Contract *DefiFMintRewardDistribution // Generic contract binding to access the raw methods on
+}
+
+// DefiFMintRewardDistributionCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type DefiFMintRewardDistributionCallerRaw struct {
+	Contract *DefiFMintRewardDistributionCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// DefiFMintRewardDistributionTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type DefiFMintRewardDistributionTransactorRaw struct {
+	Contract *DefiFMintRewardDistributionTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewDefiFMintRewardDistribution creates a new instance of DefiFMintRewardDistribution, bound to a specific deployed contract.
+func NewDefiFMintRewardDistribution(address common.Address, backend bind.ContractBackend) (*DefiFMintRewardDistribution, error) {
+	contract, err := bindDefiFMintRewardDistribution(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &DefiFMintRewardDistribution{DefiFMintRewardDistributionCaller: DefiFMintRewardDistributionCaller{contract: contract}, DefiFMintRewardDistributionTransactor: DefiFMintRewardDistributionTransactor{contract: contract}, DefiFMintRewardDistributionFilterer: DefiFMintRewardDistributionFilterer{contract: contract}}, nil
+}
+
+// NewDefiFMintRewardDistributionCaller creates a new read-only instance of DefiFMintRewardDistribution, bound to a specific deployed contract.
+func NewDefiFMintRewardDistributionCaller(address common.Address, caller bind.ContractCaller) (*DefiFMintRewardDistributionCaller, error) {
+	contract, err := bindDefiFMintRewardDistribution(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &DefiFMintRewardDistributionCaller{contract: contract}, nil
+}
+
+// NewDefiFMintRewardDistributionTransactor creates a new write-only instance of DefiFMintRewardDistribution, bound to a specific deployed contract.
+func NewDefiFMintRewardDistributionTransactor(address common.Address, transactor bind.ContractTransactor) (*DefiFMintRewardDistributionTransactor, error) {
+	contract, err := bindDefiFMintRewardDistribution(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &DefiFMintRewardDistributionTransactor{contract: contract}, nil
+}
+
+// NewDefiFMintRewardDistributionFilterer creates a new log filterer instance of DefiFMintRewardDistribution, bound to a specific deployed contract.
+func NewDefiFMintRewardDistributionFilterer(address common.Address, filterer bind.ContractFilterer) (*DefiFMintRewardDistributionFilterer, error) {
+	contract, err := bindDefiFMintRewardDistribution(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &DefiFMintRewardDistributionFilterer{contract: contract}, nil
+}
+
+// bindDefiFMintRewardDistribution binds a generic wrapper to an already deployed contract.
+func bindDefiFMintRewardDistribution(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(DefiFMintRewardDistributionABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _DefiFMintRewardDistribution.Contract.DefiFMintRewardDistributionCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.Contract.DefiFMintRewardDistributionTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.Contract.DefiFMintRewardDistributionTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _DefiFMintRewardDistribution.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.Contract.contract.Transact(opts, method, params...)
+}
+
+// ERRDEBTEXCEEDED is a free data retrieval call binding the contract method 0x372ce3df.
+//
+// Solidity: function ERR_DEBT_EXCEEDED() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) ERRDEBTEXCEEDED(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "ERR_DEBT_EXCEEDED")
+	return *ret0, err
+}
+
+// ERRDEBTEXCEEDED is a free data retrieval call binding the contract method 0x372ce3df.
+//
+// Solidity: function ERR_DEBT_EXCEEDED() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) ERRDEBTEXCEEDED() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRDEBTEXCEEDED(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRDEBTEXCEEDED is a free data retrieval call binding the contract method 0x372ce3df.
+//
+// Solidity: function ERR_DEBT_EXCEEDED() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) ERRDEBTEXCEEDED() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRDEBTEXCEEDED(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRDEPOSITPROHIBITED is a free data retrieval call binding the contract method 0x2bfcc373.
+//
+// Solidity: function ERR_DEPOSIT_PROHIBITED() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) ERRDEPOSITPROHIBITED(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "ERR_DEPOSIT_PROHIBITED")
+	return *ret0, err
+}
+
+// ERRDEPOSITPROHIBITED is a free data retrieval call binding the contract method 0x2bfcc373.
+//
+// Solidity: function ERR_DEPOSIT_PROHIBITED() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) ERRDEPOSITPROHIBITED() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRDEPOSITPROHIBITED(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRDEPOSITPROHIBITED is a free data retrieval call binding the contract method 0x2bfcc373.
+//
+// Solidity: function ERR_DEPOSIT_PROHIBITED() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) ERRDEPOSITPROHIBITED() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRDEPOSITPROHIBITED(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRLOWALLOWANCE is a free data retrieval call binding the contract method 0x911fc3f1.
+//
+// Solidity: function ERR_LOW_ALLOWANCE() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) ERRLOWALLOWANCE(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "ERR_LOW_ALLOWANCE")
+	return *ret0, err
+}
+
+// ERRLOWALLOWANCE is a free data retrieval call binding the contract method 0x911fc3f1.
+//
+// Solidity: function ERR_LOW_ALLOWANCE() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) ERRLOWALLOWANCE() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRLOWALLOWANCE(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRLOWALLOWANCE is a free data retrieval call binding the contract method 0x911fc3f1.
+//
+// Solidity: function ERR_LOW_ALLOWANCE() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) ERRLOWALLOWANCE() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRLOWALLOWANCE(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRLOWAMOUNT is a free data retrieval call binding the contract method 0xc7ea4889.
+//
+// Solidity: function ERR_LOW_AMOUNT() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) ERRLOWAMOUNT(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "ERR_LOW_AMOUNT")
+	return *ret0, err
+}
+
+// ERRLOWAMOUNT is a free data retrieval call binding the contract method 0xc7ea4889.
+//
+// Solidity: function ERR_LOW_AMOUNT() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) ERRLOWAMOUNT() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRLOWAMOUNT(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRLOWAMOUNT is a free data retrieval call binding the contract method 0xc7ea4889.
+//
+// Solidity: function ERR_LOW_AMOUNT() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) ERRLOWAMOUNT() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRLOWAMOUNT(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRLOWBALANCE is a free data retrieval call binding the contract method 0x1ac919b0.
+//
+// Solidity: function ERR_LOW_BALANCE() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) ERRLOWBALANCE(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "ERR_LOW_BALANCE")
+	return *ret0, err
+}
+
+// ERRLOWBALANCE is a free data retrieval call binding the contract method 0x1ac919b0.
+//
+// Solidity: function ERR_LOW_BALANCE() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) ERRLOWBALANCE() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRLOWBALANCE(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRLOWBALANCE is a free data retrieval call binding the contract method 0x1ac919b0.
+//
+// Solidity: function ERR_LOW_BALANCE() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) ERRLOWBALANCE() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRLOWBALANCE(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRLOWCOLLATERALRATIO is a free data retrieval call binding the contract method 0x04b62f29.
+//
+// Solidity: function ERR_LOW_COLLATERAL_RATIO() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) ERRLOWCOLLATERALRATIO(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "ERR_LOW_COLLATERAL_RATIO")
+	return *ret0, err
+}
+
+// ERRLOWCOLLATERALRATIO is a free data retrieval call binding the contract method 0x04b62f29.
+//
+// Solidity: function ERR_LOW_COLLATERAL_RATIO() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) ERRLOWCOLLATERALRATIO() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRLOWCOLLATERALRATIO(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRLOWCOLLATERALRATIO is a free data retrieval call binding the contract method 0x04b62f29.
+//
+// Solidity: function ERR_LOW_COLLATERAL_RATIO() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) ERRLOWCOLLATERALRATIO() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRLOWCOLLATERALRATIO(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRMINTINGPROHIBITED is a free data retrieval call binding the contract method 0x8c7b9980.
+//
+// Solidity: function ERR_MINTING_PROHIBITED() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) ERRMINTINGPROHIBITED(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "ERR_MINTING_PROHIBITED")
+	return *ret0, err
+}
+
+// ERRMINTINGPROHIBITED is a free data retrieval call binding the contract method 0x8c7b9980.
+//
+// Solidity: function ERR_MINTING_PROHIBITED() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) ERRMINTINGPROHIBITED() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRMINTINGPROHIBITED(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRMINTINGPROHIBITED is a free data retrieval call binding the contract method 0x8c7b9980.
+//
+// Solidity: function ERR_MINTING_PROHIBITED() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) ERRMINTINGPROHIBITED() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRMINTINGPROHIBITED(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRNOTAUTHORIZED is a free data retrieval call binding the contract method 0xbc99d6ae.
+//
+// Solidity: function ERR_NOT_AUTHORIZED() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) ERRNOTAUTHORIZED(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "ERR_NOT_AUTHORIZED")
+	return *ret0, err
+}
+
+// ERRNOTAUTHORIZED is a free data retrieval call binding the contract method 0xbc99d6ae.
+//
+// Solidity: function ERR_NOT_AUTHORIZED() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) ERRNOTAUTHORIZED() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRNOTAUTHORIZED(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRNOTAUTHORIZED is a free data retrieval call binding the contract method 0xbc99d6ae.
+//
+// Solidity: function ERR_NOT_AUTHORIZED() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) ERRNOTAUTHORIZED() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRNOTAUTHORIZED(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRNOCOLLATERAL is a free data retrieval call binding the contract method 0xb76361c2.
+//
+// Solidity: function ERR_NO_COLLATERAL() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) ERRNOCOLLATERAL(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "ERR_NO_COLLATERAL")
+	return *ret0, err
+}
+
+// ERRNOCOLLATERAL is a free data retrieval call binding the contract method 0xb76361c2.
+//
+// Solidity: function ERR_NO_COLLATERAL() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) ERRNOCOLLATERAL() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRNOCOLLATERAL(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRNOCOLLATERAL is a free data retrieval call binding the contract method 0xb76361c2.
+//
+// Solidity: function ERR_NO_COLLATERAL() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) ERRNOCOLLATERAL() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRNOCOLLATERAL(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRNOERROR is a free data retrieval call binding the contract method 0x35052d6e.
+//
+// Solidity: function ERR_NO_ERROR() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) ERRNOERROR(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "ERR_NO_ERROR")
+	return *ret0, err
+}
+
+// ERRNOERROR is a free data retrieval call binding the contract method 0x35052d6e.
+//
+// Solidity: function ERR_NO_ERROR() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) ERRNOERROR() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRNOERROR(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRNOERROR is a free data retrieval call binding the contract method 0x35052d6e.
+//
+// Solidity: function ERR_NO_ERROR() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) ERRNOERROR() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRNOERROR(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRNOREWARD is a free data retrieval call binding the contract method 0x73a93af6.
+//
+// Solidity: function ERR_NO_REWARD() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) ERRNOREWARD(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "ERR_NO_REWARD")
+	return *ret0, err
+}
+
+// ERRNOREWARD is a free data retrieval call binding the contract method 0x73a93af6.
+//
+// Solidity: function ERR_NO_REWARD() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) ERRNOREWARD() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRNOREWARD(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRNOREWARD is a free data retrieval call binding the contract method 0x73a93af6.
+//
+// Solidity: function ERR_NO_REWARD() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) ERRNOREWARD() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRNOREWARD(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRNOVALUE is a free data retrieval call binding the contract method 0x69d1cb27.
+//
+// Solidity: function ERR_NO_VALUE() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) ERRNOVALUE(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "ERR_NO_VALUE")
+	return *ret0, err
+}
+
+// ERRNOVALUE is a free data retrieval call binding the contract method 0x69d1cb27.
+//
+// Solidity: function ERR_NO_VALUE() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) ERRNOVALUE() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRNOVALUE(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRNOVALUE is a free data retrieval call binding the contract method 0x69d1cb27.
+//
+// Solidity: function ERR_NO_VALUE() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) ERRNOVALUE() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRNOVALUE(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRREWARDSDEPLETED is a free data retrieval call binding the contract method 0x0a19dd33.
+//
+// Solidity: function ERR_REWARDS_DEPLETED() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) ERRREWARDSDEPLETED(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "ERR_REWARDS_DEPLETED")
+	return *ret0, err
+}
+
+// ERRREWARDSDEPLETED is a free data retrieval call binding the contract method 0x0a19dd33.
+//
+// Solidity: function ERR_REWARDS_DEPLETED() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) ERRREWARDSDEPLETED() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRREWARDSDEPLETED(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRREWARDSDEPLETED is a free data retrieval call binding the contract method 0x0a19dd33.
+//
+// Solidity: function ERR_REWARDS_DEPLETED() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) ERRREWARDSDEPLETED() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRREWARDSDEPLETED(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRREWARDSEARLY is a free data retrieval call binding the contract method 0x67fc176b.
+//
+// Solidity: function ERR_REWARDS_EARLY() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) ERRREWARDSEARLY(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "ERR_REWARDS_EARLY")
+	return *ret0, err
+}
+
+// ERRREWARDSEARLY is a free data retrieval call binding the contract method 0x67fc176b.
+//
+// Solidity: function ERR_REWARDS_EARLY() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) ERRREWARDSEARLY() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRREWARDSEARLY(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRREWARDSEARLY is a free data retrieval call binding the contract method 0x67fc176b.
+//
+// Solidity: function ERR_REWARDS_EARLY() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) ERRREWARDSEARLY() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRREWARDSEARLY(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRREWARDSNONE is a free data retrieval call binding the contract method 0xc7222c72.
+//
+// Solidity: function ERR_REWARDS_NONE() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) ERRREWARDSNONE(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "ERR_REWARDS_NONE")
+	return *ret0, err
+}
+
+// ERRREWARDSNONE is a free data retrieval call binding the contract method 0xc7222c72.
+//
+// Solidity: function ERR_REWARDS_NONE() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) ERRREWARDSNONE() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRREWARDSNONE(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRREWARDSNONE is a free data retrieval call binding the contract method 0xc7222c72.
+//
+// Solidity: function ERR_REWARDS_NONE() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) ERRREWARDSNONE() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRREWARDSNONE(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRREWARDCLAIMREJECTED is a free data retrieval call binding the contract method 0x4846e345.
+//
+// Solidity: function ERR_REWARD_CLAIM_REJECTED() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) ERRREWARDCLAIMREJECTED(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "ERR_REWARD_CLAIM_REJECTED")
+	return *ret0, err
+}
+
+// ERRREWARDCLAIMREJECTED is a free data retrieval call binding the contract method 0x4846e345.
+//
+// Solidity: function ERR_REWARD_CLAIM_REJECTED() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) ERRREWARDCLAIMREJECTED() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRREWARDCLAIMREJECTED(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRREWARDCLAIMREJECTED is a free data retrieval call binding the contract method 0x4846e345.
+//
+// Solidity: function ERR_REWARD_CLAIM_REJECTED() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) ERRREWARDCLAIMREJECTED() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRREWARDCLAIMREJECTED(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRZEROAMOUNT is a free data retrieval call binding the contract method 0x0aff90bb.
+//
+// Solidity: function ERR_ZERO_AMOUNT() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) ERRZEROAMOUNT(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "ERR_ZERO_AMOUNT")
+	return *ret0, err
+}
+
+// ERRZEROAMOUNT is a free data retrieval call binding the contract method 0x0aff90bb.
+//
+// Solidity: function ERR_ZERO_AMOUNT() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) ERRZEROAMOUNT() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRZEROAMOUNT(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// ERRZEROAMOUNT is a free data retrieval call binding the contract method 0x0aff90bb.
+//
+// Solidity: function ERR_ZERO_AMOUNT() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) ERRZEROAMOUNT() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.ERRZEROAMOUNT(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// AddressProvider is a free data retrieval call binding the contract method 0x2954018c.
+//
+// Solidity: function addressProvider() view returns(address)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) AddressProvider(opts *bind.CallOpts) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "addressProvider")
+	return *ret0, err
+}
+
+// AddressProvider is a free data retrieval call binding the contract method 0x2954018c.
+//
+// Solidity: function addressProvider() view returns(address)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) AddressProvider() (common.Address, error) {
+	return _DefiFMintRewardDistribution.Contract.AddressProvider(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// AddressProvider is a free data retrieval call binding the contract method 0x2954018c.
+//
+// Solidity: function addressProvider() view returns(address)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) AddressProvider() (common.Address, error) {
+	return _DefiFMintRewardDistribution.Contract.AddressProvider(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x8f32d59b.
+//
+// Solidity: function isOwner() view returns(bool)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) IsOwner(opts *bind.CallOpts) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "isOwner")
+	return *ret0, err
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x8f32d59b.
+//
+// Solidity: function isOwner() view returns(bool)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) IsOwner() (bool, error) {
+	return _DefiFMintRewardDistribution.Contract.IsOwner(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x8f32d59b.
+//
+// Solidity: function isOwner() view returns(bool)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) IsOwner() (bool, error) {
+	return _DefiFMintRewardDistribution.Contract.IsOwner(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// LastRewardPush is a free data retrieval call binding the contract method 0xa664150a.
+//
+// Solidity: function lastRewardPush() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) LastRewardPush(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "lastRewardPush")
+	return *ret0, err
+}
+
+// LastRewardPush is a free data retrieval call binding the contract method 0xa664150a.
+//
+// Solidity: function lastRewardPush() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) LastRewardPush() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.LastRewardPush(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// LastRewardPush is a free data retrieval call binding the contract method 0xa664150a.
+//
+// Solidity: function lastRewardPush() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) LastRewardPush() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.LastRewardPush(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// MinRewardPushInterval is a free data retrieval call binding the contract method 0xc0464d45.
+//
+// Solidity: function minRewardPushInterval() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) MinRewardPushInterval(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "minRewardPushInterval")
+	return *ret0, err
+}
+
+// MinRewardPushInterval is a free data retrieval call binding the contract method 0xc0464d45.
+//
+// Solidity: function minRewardPushInterval() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) MinRewardPushInterval() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.MinRewardPushInterval(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// MinRewardPushInterval is a free data retrieval call binding the contract method 0xc0464d45.
+//
+// Solidity: function minRewardPushInterval() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) MinRewardPushInterval() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.MinRewardPushInterval(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "owner")
+	return *ret0, err
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) Owner() (common.Address, error) {
+	return _DefiFMintRewardDistribution.Contract.Owner(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) Owner() (common.Address, error) {
+	return _DefiFMintRewardDistribution.Contract.Owner(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// PrincipalBalance is a free data retrieval call binding the contract method 0xa83e53ac.
+//
+// Solidity: function principalBalance() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) PrincipalBalance(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "principalBalance")
+	return *ret0, err
+}
+
+// PrincipalBalance is a free data retrieval call binding the contract method 0xa83e53ac.
+//
+// Solidity: function principalBalance() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) PrincipalBalance() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.PrincipalBalance(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// PrincipalBalance is a free data retrieval call binding the contract method 0xa83e53ac.
+//
+// Solidity: function principalBalance() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) PrincipalBalance() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.PrincipalBalance(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// PrincipalBalanceOf is a free data retrieval call binding the contract method 0xc634dfaa.
+//
+// Solidity: function principalBalanceOf(address _account) view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) PrincipalBalanceOf(opts *bind.CallOpts, _account common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "principalBalanceOf", _account)
+	return *ret0, err
+}
+
+// PrincipalBalanceOf is a free data retrieval call binding the contract method 0xc634dfaa.
+//
+// Solidity: function principalBalanceOf(address _account) view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) PrincipalBalanceOf(_account common.Address) (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.PrincipalBalanceOf(&_DefiFMintRewardDistribution.CallOpts, _account)
+}
+
+// PrincipalBalanceOf is a free data retrieval call binding the contract method 0xc634dfaa.
+//
+// Solidity: function principalBalanceOf(address _account) view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) PrincipalBalanceOf(_account common.Address) (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.PrincipalBalanceOf(&_DefiFMintRewardDistribution.CallOpts, _account)
+}
+
+// RewardApplicableUntil is a free data retrieval call binding the contract method 0xdb16e0b5.
+//
+// Solidity: function rewardApplicableUntil() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) RewardApplicableUntil(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "rewardApplicableUntil")
+	return *ret0, err
+}
+
+// RewardApplicableUntil is a free data retrieval call binding the contract method 0xdb16e0b5.
+//
+// Solidity: function rewardApplicableUntil() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) RewardApplicableUntil() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardApplicableUntil(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// RewardApplicableUntil is a free data retrieval call binding the contract method 0xdb16e0b5.
+//
+// Solidity: function rewardApplicableUntil() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) RewardApplicableUntil() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardApplicableUntil(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// RewardCanClaim is a free data retrieval call binding the contract method 0xda0a0432.
+//
+// Solidity: function rewardCanClaim(address _account) view returns(bool)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) RewardCanClaim(opts *bind.CallOpts, _account common.Address) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "rewardCanClaim", _account)
+	return *ret0, err
+}
+
+// RewardCanClaim is a free data retrieval call binding the contract method 0xda0a0432.
+//
+// Solidity: function rewardCanClaim(address _account) view returns(bool)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) RewardCanClaim(_account common.Address) (bool, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardCanClaim(&_DefiFMintRewardDistribution.CallOpts, _account)
+}
+
+// RewardCanClaim is a free data retrieval call binding the contract method 0xda0a0432.
+//
+// Solidity: function rewardCanClaim(address _account) view returns(bool)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) RewardCanClaim(_account common.Address) (bool, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardCanClaim(&_DefiFMintRewardDistribution.CallOpts, _account)
+}
+
+// RewardEarned is a free data retrieval call binding the contract method 0x16ba6bf3.
+//
+// Solidity: function rewardEarned(address _account) view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) RewardEarned(opts *bind.CallOpts, _account common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "rewardEarned", _account)
+	return *ret0, err
+}
+
+// RewardEarned is a free data retrieval call binding the contract method 0x16ba6bf3.
+//
+// Solidity: function rewardEarned(address _account) view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) RewardEarned(_account common.Address) (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardEarned(&_DefiFMintRewardDistribution.CallOpts, _account)
+}
+
+// RewardEarned is a free data retrieval call binding the contract method 0x16ba6bf3.
+//
+// Solidity: function rewardEarned(address _account) view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) RewardEarned(_account common.Address) (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardEarned(&_DefiFMintRewardDistribution.CallOpts, _account)
+}
+
+// RewardEpochEnds is a free data retrieval call binding the contract method 0x3ce9b316.
+//
+// Solidity: function rewardEpochEnds() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) RewardEpochEnds(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "rewardEpochEnds")
+	return *ret0, err
+}
+
+// RewardEpochEnds is a free data retrieval call binding the contract method 0x3ce9b316.
+//
+// Solidity: function rewardEpochEnds() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) RewardEpochEnds() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardEpochEnds(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// RewardEpochEnds is a free data retrieval call binding the contract method 0x3ce9b316.
+//
+// Solidity: function rewardEpochEnds() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) RewardEpochEnds() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardEpochEnds(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// RewardEpochLength is a free data retrieval call binding the contract method 0x20a0a0e9.
+//
+// Solidity: function rewardEpochLength() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) RewardEpochLength(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "rewardEpochLength")
+	return *ret0, err
+}
+
+// RewardEpochLength is a free data retrieval call binding the contract method 0x20a0a0e9.
+//
+// Solidity: function rewardEpochLength() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) RewardEpochLength() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardEpochLength(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// RewardEpochLength is a free data retrieval call binding the contract method 0x20a0a0e9.
+//
+// Solidity: function rewardEpochLength() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) RewardEpochLength() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardEpochLength(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// RewardIsEligible is a free data retrieval call binding the contract method 0x6aee9c13.
+//
+// Solidity: function rewardIsEligible(address _account) view returns(bool)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) RewardIsEligible(opts *bind.CallOpts, _account common.Address) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "rewardIsEligible", _account)
+	return *ret0, err
+}
+
+// RewardIsEligible is a free data retrieval call binding the contract method 0x6aee9c13.
+//
+// Solidity: function rewardIsEligible(address _account) view returns(bool)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) RewardIsEligible(_account common.Address) (bool, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardIsEligible(&_DefiFMintRewardDistribution.CallOpts, _account)
+}
+
+// RewardIsEligible is a free data retrieval call binding the contract method 0x6aee9c13.
+//
+// Solidity: function rewardIsEligible(address _account) view returns(bool)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) RewardIsEligible(_account common.Address) (bool, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardIsEligible(&_DefiFMintRewardDistribution.CallOpts, _account)
+}
+
+// RewardLastPerToken is a free data retrieval call binding the contract method 0x544bb473.
+//
+// Solidity: function rewardLastPerToken() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) RewardLastPerToken(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "rewardLastPerToken")
+	return *ret0, err
+}
+
+// RewardLastPerToken is a free data retrieval call binding the contract method 0x544bb473.
+//
+// Solidity: function rewardLastPerToken() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) RewardLastPerToken() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardLastPerToken(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// RewardLastPerToken is a free data retrieval call binding the contract method 0x544bb473.
+//
+// Solidity: function rewardLastPerToken() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) RewardLastPerToken() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardLastPerToken(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// RewardPerSecond is a free data retrieval call binding the contract method 0x8f10369a.
+//
+// Solidity: function rewardPerSecond() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) RewardPerSecond(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "rewardPerSecond")
+	return *ret0, err
+}
+
+// RewardPerSecond is a free data retrieval call binding the contract method 0x8f10369a.
+//
+// Solidity: function rewardPerSecond() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) RewardPerSecond() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardPerSecond(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// RewardPerSecond is a free data retrieval call binding the contract method 0x8f10369a.
+//
+// Solidity: function rewardPerSecond() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) RewardPerSecond() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardPerSecond(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// RewardPerToken is a free data retrieval call binding the contract method 0xcd3daf9d.
+//
+// Solidity: function rewardPerToken() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) RewardPerToken(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "rewardPerToken")
+	return *ret0, err
+}
+
+// RewardPerToken is a free data retrieval call binding the contract method 0xcd3daf9d.
+//
+// Solidity: function rewardPerToken() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) RewardPerToken() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardPerToken(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// RewardPerToken is a free data retrieval call binding the contract method 0xcd3daf9d.
+//
+// Solidity: function rewardPerToken() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) RewardPerToken() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardPerToken(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// RewardPerTokenDecimalsCorrection is a free data retrieval call binding the contract method 0x64631d9b.
+//
+// Solidity: function rewardPerTokenDecimalsCorrection() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) RewardPerTokenDecimalsCorrection(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "rewardPerTokenDecimalsCorrection")
+	return *ret0, err
+}
+
+// RewardPerTokenDecimalsCorrection is a free data retrieval call binding the contract method 0x64631d9b.
+//
+// Solidity: function rewardPerTokenDecimalsCorrection() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) RewardPerTokenDecimalsCorrection() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardPerTokenDecimalsCorrection(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// RewardPerTokenDecimalsCorrection is a free data retrieval call binding the contract method 0x64631d9b.
+//
+// Solidity: function rewardPerTokenDecimalsCorrection() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) RewardPerTokenDecimalsCorrection() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardPerTokenDecimalsCorrection(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// RewardPerTokenPaid is a free data retrieval call binding the contract method 0x653a8da1.
+//
+// Solidity: function rewardPerTokenPaid(address ) view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) RewardPerTokenPaid(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "rewardPerTokenPaid", arg0)
+	return *ret0, err
+}
+
+// RewardPerTokenPaid is a free data retrieval call binding the contract method 0x653a8da1.
+//
+// Solidity: function rewardPerTokenPaid(address ) view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) RewardPerTokenPaid(arg0 common.Address) (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardPerTokenPaid(&_DefiFMintRewardDistribution.CallOpts, arg0)
+}
+
+// RewardPerTokenPaid is a free data retrieval call binding the contract method 0x653a8da1.
+//
+// Solidity: function rewardPerTokenPaid(address ) view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) RewardPerTokenPaid(arg0 common.Address) (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardPerTokenPaid(&_DefiFMintRewardDistribution.CallOpts, arg0)
+}
+
+// RewardRate is a free data retrieval call binding the contract method 0x7b0a47ee.
+//
+// Solidity: function rewardRate() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) RewardRate(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "rewardRate")
+	return *ret0, err
+}
+
+// RewardRate is a free data retrieval call binding the contract method 0x7b0a47ee.
+//
+// Solidity: function rewardRate() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) RewardRate() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardRate(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// RewardRate is a free data retrieval call binding the contract method 0x7b0a47ee.
+//
+// Solidity: function rewardRate() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) RewardRate() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardRate(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// RewardStash is a free data retrieval call binding the contract method 0xf2392c8d.
+//
+// Solidity: function rewardStash(address ) view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) RewardStash(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "rewardStash", arg0)
+	return *ret0, err
+}
+
+// RewardStash is a free data retrieval call binding the contract method 0xf2392c8d.
+//
+// Solidity: function rewardStash(address ) view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) RewardStash(arg0 common.Address) (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardStash(&_DefiFMintRewardDistribution.CallOpts, arg0)
+}
+
+// RewardStash is a free data retrieval call binding the contract method 0xf2392c8d.
+//
+// Solidity: function rewardStash(address ) view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) RewardStash(arg0 common.Address) (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardStash(&_DefiFMintRewardDistribution.CallOpts, arg0)
+}
+
+// RewardTokenAddress is a free data retrieval call binding the contract method 0x125f9e33.
+//
+// Solidity: function rewardTokenAddress() view returns(address)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) RewardTokenAddress(opts *bind.CallOpts) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "rewardTokenAddress")
+	return *ret0, err
+}
+
+// RewardTokenAddress is a free data retrieval call binding the contract method 0x125f9e33.
+//
+// Solidity: function rewardTokenAddress() view returns(address)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) RewardTokenAddress() (common.Address, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardTokenAddress(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// RewardTokenAddress is a free data retrieval call binding the contract method 0x125f9e33.
+//
+// Solidity: function rewardTokenAddress() view returns(address)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) RewardTokenAddress() (common.Address, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardTokenAddress(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// RewardUpdated is a free data retrieval call binding the contract method 0x6e718e04.
+//
+// Solidity: function rewardUpdated() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCaller) RewardUpdated(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _DefiFMintRewardDistribution.contract.Call(opts, out, "rewardUpdated")
+	return *ret0, err
+}
+
+// RewardUpdated is a free data retrieval call binding the contract method 0x6e718e04.
+//
+// Solidity: function rewardUpdated() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) RewardUpdated() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardUpdated(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// RewardUpdated is a free data retrieval call binding the contract method 0x6e718e04.
+//
+// Solidity: function rewardUpdated() view returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionCallerSession) RewardUpdated() (*big.Int, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardUpdated(&_DefiFMintRewardDistribution.CallOpts)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x485cc955.
+//
+// Solidity: function initialize(address owner, address _addressProvider) returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionTransactor) Initialize(opts *bind.TransactOpts, owner common.Address, _addressProvider common.Address) (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.contract.Transact(opts, "initialize", owner, _addressProvider)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x485cc955.
+//
+// Solidity: function initialize(address owner, address _addressProvider) returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) Initialize(owner common.Address, _addressProvider common.Address) (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.Contract.Initialize(&_DefiFMintRewardDistribution.TransactOpts, owner, _addressProvider)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x485cc955.
+//
+// Solidity: function initialize(address owner, address _addressProvider) returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionTransactorSession) Initialize(owner common.Address, _addressProvider common.Address) (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.Contract.Initialize(&_DefiFMintRewardDistribution.TransactOpts, owner, _addressProvider)
+}
+
+// Initialize0 is a paid mutator transaction binding the contract method 0xc4d66de8.
+//
+// Solidity: function initialize(address sender) returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionTransactor) Initialize0(opts *bind.TransactOpts, sender common.Address) (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.contract.Transact(opts, "initialize0", sender)
+}
+
+// Initialize0 is a paid mutator transaction binding the contract method 0xc4d66de8.
+//
+// Solidity: function initialize(address sender) returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) Initialize0(sender common.Address) (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.Contract.Initialize0(&_DefiFMintRewardDistribution.TransactOpts, sender)
+}
+
+// Initialize0 is a paid mutator transaction binding the contract method 0xc4d66de8.
+//
+// Solidity: function initialize(address sender) returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionTransactorSession) Initialize0(sender common.Address) (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.Contract.Initialize0(&_DefiFMintRewardDistribution.TransactOpts, sender)
+}
+
+// MustRewardClaim is a paid mutator transaction binding the contract method 0x101df8b5.
+//
+// Solidity: function mustRewardClaim() returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionTransactor) MustRewardClaim(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.contract.Transact(opts, "mustRewardClaim")
+}
+
+// MustRewardClaim is a paid mutator transaction binding the contract method 0x101df8b5.
+//
+// Solidity: function mustRewardClaim() returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) MustRewardClaim() (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.Contract.MustRewardClaim(&_DefiFMintRewardDistribution.TransactOpts)
+}
+
+// MustRewardClaim is a paid mutator transaction binding the contract method 0x101df8b5.
+//
+// Solidity: function mustRewardClaim() returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionTransactorSession) MustRewardClaim() (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.Contract.MustRewardClaim(&_DefiFMintRewardDistribution.TransactOpts)
+}
+
+// MustRewardPush is a paid mutator transaction binding the contract method 0x9b7ea007.
+//
+// Solidity: function mustRewardPush() returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionTransactor) MustRewardPush(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.contract.Transact(opts, "mustRewardPush")
+}
+
+// MustRewardPush is a paid mutator transaction binding the contract method 0x9b7ea007.
+//
+// Solidity: function mustRewardPush() returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) MustRewardPush() (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.Contract.MustRewardPush(&_DefiFMintRewardDistribution.TransactOpts)
+}
+
+// MustRewardPush is a paid mutator transaction binding the contract method 0x9b7ea007.
+//
+// Solidity: function mustRewardPush() returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionTransactorSession) MustRewardPush() (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.Contract.MustRewardPush(&_DefiFMintRewardDistribution.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) RenounceOwnership() (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.Contract.RenounceOwnership(&_DefiFMintRewardDistribution.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.Contract.RenounceOwnership(&_DefiFMintRewardDistribution.TransactOpts)
+}
+
+// RewardClaim is a paid mutator transaction binding the contract method 0x6409f921.
+//
+// Solidity: function rewardClaim() returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionTransactor) RewardClaim(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.contract.Transact(opts, "rewardClaim")
+}
+
+// RewardClaim is a paid mutator transaction binding the contract method 0x6409f921.
+//
+// Solidity: function rewardClaim() returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) RewardClaim() (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardClaim(&_DefiFMintRewardDistribution.TransactOpts)
+}
+
+// RewardClaim is a paid mutator transaction binding the contract method 0x6409f921.
+//
+// Solidity: function rewardClaim() returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionTransactorSession) RewardClaim() (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardClaim(&_DefiFMintRewardDistribution.TransactOpts)
+}
+
+// RewardCleanup is a paid mutator transaction binding the contract method 0xc759df4e.
+//
+// Solidity: function rewardCleanup(address _recipient) returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionTransactor) RewardCleanup(opts *bind.TransactOpts, _recipient common.Address) (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.contract.Transact(opts, "rewardCleanup", _recipient)
+}
+
+// RewardCleanup is a paid mutator transaction binding the contract method 0xc759df4e.
+//
+// Solidity: function rewardCleanup(address _recipient) returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) RewardCleanup(_recipient common.Address) (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardCleanup(&_DefiFMintRewardDistribution.TransactOpts, _recipient)
+}
+
+// RewardCleanup is a paid mutator transaction binding the contract method 0xc759df4e.
+//
+// Solidity: function rewardCleanup(address _recipient) returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionTransactorSession) RewardCleanup(_recipient common.Address) (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardCleanup(&_DefiFMintRewardDistribution.TransactOpts, _recipient)
+}
+
+// RewardPush is a paid mutator transaction binding the contract method 0x185463a4.
+//
+// Solidity: function rewardPush() returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionTransactor) RewardPush(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.contract.Transact(opts, "rewardPush")
+}
+
+// RewardPush is a paid mutator transaction binding the contract method 0x185463a4.
+//
+// Solidity: function rewardPush() returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) RewardPush() (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardPush(&_DefiFMintRewardDistribution.TransactOpts)
+}
+
+// RewardPush is a paid mutator transaction binding the contract method 0x185463a4.
+//
+// Solidity: function rewardPush() returns(uint256)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionTransactorSession) RewardPush() (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardPush(&_DefiFMintRewardDistribution.TransactOpts)
+}
+
+// RewardUpdate is a paid mutator transaction binding the contract method 0x48ebb08d.
+//
+// Solidity: function rewardUpdate(address _account) returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionTransactor) RewardUpdate(opts *bind.TransactOpts, _account common.Address) (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.contract.Transact(opts, "rewardUpdate", _account)
+}
+
+// RewardUpdate is a paid mutator transaction binding the contract method 0x48ebb08d.
+//
+// Solidity: function rewardUpdate(address _account) returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) RewardUpdate(_account common.Address) (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardUpdate(&_DefiFMintRewardDistribution.TransactOpts, _account)
+}
+
+// RewardUpdate is a paid mutator transaction binding the contract method 0x48ebb08d.
+//
+// Solidity: function rewardUpdate(address _account) returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionTransactorSession) RewardUpdate(_account common.Address) (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardUpdate(&_DefiFMintRewardDistribution.TransactOpts, _account)
+}
+
+// RewardUpdateGlobal is a paid mutator transaction binding the contract method 0x64dd213f.
+//
+// Solidity: function rewardUpdateGlobal() returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionTransactor) RewardUpdateGlobal(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.contract.Transact(opts, "rewardUpdateGlobal")
+}
+
+// RewardUpdateGlobal is a paid mutator transaction binding the contract method 0x64dd213f.
+//
+// Solidity: function rewardUpdateGlobal() returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) RewardUpdateGlobal() (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardUpdateGlobal(&_DefiFMintRewardDistribution.TransactOpts)
+}
+
+// RewardUpdateGlobal is a paid mutator transaction binding the contract method 0x64dd213f.
+//
+// Solidity: function rewardUpdateGlobal() returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionTransactorSession) RewardUpdateGlobal() (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardUpdateGlobal(&_DefiFMintRewardDistribution.TransactOpts)
+}
+
+// RewardUpdateRate is a paid mutator transaction binding the contract method 0xef24d9a1.
+//
+// Solidity: function rewardUpdateRate(uint256 _perSecond) returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionTransactor) RewardUpdateRate(opts *bind.TransactOpts, _perSecond *big.Int) (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.contract.Transact(opts, "rewardUpdateRate", _perSecond)
+}
+
+// RewardUpdateRate is a paid mutator transaction binding the contract method 0xef24d9a1.
+//
+// Solidity: function rewardUpdateRate(uint256 _perSecond) returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) RewardUpdateRate(_perSecond *big.Int) (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardUpdateRate(&_DefiFMintRewardDistribution.TransactOpts, _perSecond)
+}
+
+// RewardUpdateRate is a paid mutator transaction binding the contract method 0xef24d9a1.
+//
+// Solidity: function rewardUpdateRate(uint256 _perSecond) returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionTransactorSession) RewardUpdateRate(_perSecond *big.Int) (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.Contract.RewardUpdateRate(&_DefiFMintRewardDistribution.TransactOpts, _perSecond)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.Contract.TransferOwnership(&_DefiFMintRewardDistribution.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _DefiFMintRewardDistribution.Contract.TransferOwnership(&_DefiFMintRewardDistribution.TransactOpts, newOwner)
+}
+
+// DefiFMintRewardDistributionOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the DefiFMintRewardDistribution contract.
+type DefiFMintRewardDistributionOwnershipTransferredIterator struct {
+	Event *DefiFMintRewardDistributionOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DefiFMintRewardDistributionOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DefiFMintRewardDistributionOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DefiFMintRewardDistributionOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DefiFMintRewardDistributionOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DefiFMintRewardDistributionOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DefiFMintRewardDistributionOwnershipTransferred represents a OwnershipTransferred event raised by the DefiFMintRewardDistribution contract.
+type DefiFMintRewardDistributionOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*DefiFMintRewardDistributionOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _DefiFMintRewardDistribution.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DefiFMintRewardDistributionOwnershipTransferredIterator{contract: _DefiFMintRewardDistribution.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *DefiFMintRewardDistributionOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _DefiFMintRewardDistribution.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DefiFMintRewardDistributionOwnershipTransferred)
+				if err := _DefiFMintRewardDistribution.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionFilterer) ParseOwnershipTransferred(log types.Log) (*DefiFMintRewardDistributionOwnershipTransferred, error) {
+	event := new(DefiFMintRewardDistributionOwnershipTransferred)
+	if err := _DefiFMintRewardDistribution.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// DefiFMintRewardDistributionRateUpdatedIterator is returned from FilterRateUpdated and is used to iterate over the raw logs and unpacked data for RateUpdated events raised by the DefiFMintRewardDistribution contract.
+type DefiFMintRewardDistributionRateUpdatedIterator struct {
+	Event *DefiFMintRewardDistributionRateUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DefiFMintRewardDistributionRateUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DefiFMintRewardDistributionRateUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DefiFMintRewardDistributionRateUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DefiFMintRewardDistributionRateUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DefiFMintRewardDistributionRateUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DefiFMintRewardDistributionRateUpdated represents a RateUpdated event raised by the DefiFMintRewardDistribution contract.
+type DefiFMintRewardDistributionRateUpdated struct {
+	RewardPerYear *big.Int
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterRateUpdated is a free log retrieval operation binding the contract event 0xe65c987b2e4668e09ba867026921588005b2b2063607a1e7e7d91683c8f91b7b.
+//
+// Solidity: event RateUpdated(uint256 rewardPerYear)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionFilterer) FilterRateUpdated(opts *bind.FilterOpts) (*DefiFMintRewardDistributionRateUpdatedIterator, error) {
+
+	logs, sub, err := _DefiFMintRewardDistribution.contract.FilterLogs(opts, "RateUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &DefiFMintRewardDistributionRateUpdatedIterator{contract: _DefiFMintRewardDistribution.contract, event: "RateUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchRateUpdated is a free log subscription operation binding the contract event 0xe65c987b2e4668e09ba867026921588005b2b2063607a1e7e7d91683c8f91b7b.
+//
+// Solidity: event RateUpdated(uint256 rewardPerYear)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionFilterer) WatchRateUpdated(opts *bind.WatchOpts, sink chan<- *DefiFMintRewardDistributionRateUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _DefiFMintRewardDistribution.contract.WatchLogs(opts, "RateUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DefiFMintRewardDistributionRateUpdated)
+				if err := _DefiFMintRewardDistribution.contract.UnpackLog(event, "RateUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRateUpdated is a log parse operation binding the contract event 0xe65c987b2e4668e09ba867026921588005b2b2063607a1e7e7d91683c8f91b7b.
+//
+// Solidity: event RateUpdated(uint256 rewardPerYear)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionFilterer) ParseRateUpdated(log types.Log) (*DefiFMintRewardDistributionRateUpdated, error) {
+	event := new(DefiFMintRewardDistributionRateUpdated)
+	if err := _DefiFMintRewardDistribution.contract.UnpackLog(event, "RateUpdated", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// DefiFMintRewardDistributionRewardAddedIterator is returned from FilterRewardAdded and is used to iterate over the raw logs and unpacked data for RewardAdded events raised by the DefiFMintRewardDistribution contract.
+type DefiFMintRewardDistributionRewardAddedIterator struct {
+	Event *DefiFMintRewardDistributionRewardAdded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DefiFMintRewardDistributionRewardAddedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DefiFMintRewardDistributionRewardAdded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DefiFMintRewardDistributionRewardAdded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DefiFMintRewardDistributionRewardAddedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DefiFMintRewardDistributionRewardAddedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DefiFMintRewardDistributionRewardAdded represents a RewardAdded event raised by the DefiFMintRewardDistribution contract.
+type DefiFMintRewardDistributionRewardAdded struct {
+	Reward *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterRewardAdded is a free log retrieval operation binding the contract event 0xde88a922e0d3b88b24e9623efeb464919c6bf9f66857a65e2bfcf2ce87a9433d.
+//
+// Solidity: event RewardAdded(uint256 reward)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionFilterer) FilterRewardAdded(opts *bind.FilterOpts) (*DefiFMintRewardDistributionRewardAddedIterator, error) {
+
+	logs, sub, err := _DefiFMintRewardDistribution.contract.FilterLogs(opts, "RewardAdded")
+	if err != nil {
+		return nil, err
+	}
+	return &DefiFMintRewardDistributionRewardAddedIterator{contract: _DefiFMintRewardDistribution.contract, event: "RewardAdded", logs: logs, sub: sub}, nil
+}
+
+// WatchRewardAdded is a free log subscription operation binding the contract event 0xde88a922e0d3b88b24e9623efeb464919c6bf9f66857a65e2bfcf2ce87a9433d.
+//
+// Solidity: event RewardAdded(uint256 reward)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionFilterer) WatchRewardAdded(opts *bind.WatchOpts, sink chan<- *DefiFMintRewardDistributionRewardAdded) (event.Subscription, error) {
+
+	logs, sub, err := _DefiFMintRewardDistribution.contract.WatchLogs(opts, "RewardAdded")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DefiFMintRewardDistributionRewardAdded)
+				if err := _DefiFMintRewardDistribution.contract.UnpackLog(event, "RewardAdded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRewardAdded is a log parse operation binding the contract event 0xde88a922e0d3b88b24e9623efeb464919c6bf9f66857a65e2bfcf2ce87a9433d.
+//
+// Solidity: event RewardAdded(uint256 reward)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionFilterer) ParseRewardAdded(log types.Log) (*DefiFMintRewardDistributionRewardAdded, error) {
+	event := new(DefiFMintRewardDistributionRewardAdded)
+	if err := _DefiFMintRewardDistribution.contract.UnpackLog(event, "RewardAdded", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// DefiFMintRewardDistributionRewardPaidIterator is returned from FilterRewardPaid and is used to iterate over the raw logs and unpacked data for RewardPaid events raised by the DefiFMintRewardDistribution contract.
+type DefiFMintRewardDistributionRewardPaidIterator struct {
+	Event *DefiFMintRewardDistributionRewardPaid // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DefiFMintRewardDistributionRewardPaidIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DefiFMintRewardDistributionRewardPaid)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DefiFMintRewardDistributionRewardPaid)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DefiFMintRewardDistributionRewardPaidIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DefiFMintRewardDistributionRewardPaidIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DefiFMintRewardDistributionRewardPaid represents a RewardPaid event raised by the DefiFMintRewardDistribution contract.
+type DefiFMintRewardDistributionRewardPaid struct {
+	User   common.Address
+	Reward *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterRewardPaid is a free log retrieval operation binding the contract event 0xe2403640ba68fed3a2f88b7557551d1993f84b99bb10ff833f0cf8db0c5e0486.
+//
+// Solidity: event RewardPaid(address indexed user, uint256 reward)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionFilterer) FilterRewardPaid(opts *bind.FilterOpts, user []common.Address) (*DefiFMintRewardDistributionRewardPaidIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _DefiFMintRewardDistribution.contract.FilterLogs(opts, "RewardPaid", userRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DefiFMintRewardDistributionRewardPaidIterator{contract: _DefiFMintRewardDistribution.contract, event: "RewardPaid", logs: logs, sub: sub}, nil
+}
+
+// WatchRewardPaid is a free log subscription operation binding the contract event 0xe2403640ba68fed3a2f88b7557551d1993f84b99bb10ff833f0cf8db0c5e0486.
+//
+// Solidity: event RewardPaid(address indexed user, uint256 reward)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionFilterer) WatchRewardPaid(opts *bind.WatchOpts, sink chan<- *DefiFMintRewardDistributionRewardPaid, user []common.Address) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+
+	logs, sub, err := _DefiFMintRewardDistribution.contract.WatchLogs(opts, "RewardPaid", userRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DefiFMintRewardDistributionRewardPaid)
+				if err := _DefiFMintRewardDistribution.contract.UnpackLog(event, "RewardPaid", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRewardPaid is a log parse operation binding the contract event 0xe2403640ba68fed3a2f88b7557551d1993f84b99bb10ff833f0cf8db0c5e0486.
+//
+// Solidity: event RewardPaid(address indexed user, uint256 reward)
+func (_DefiFMintRewardDistribution *DefiFMintRewardDistributionFilterer) ParseRewardPaid(log types.Log) (*DefiFMintRewardDistributionRewardPaid, error) {
+	event := new(DefiFMintRewardDistributionRewardPaid)
+	if err := _DefiFMintRewardDistribution.contract.UnpackLog(event, "RewardPaid", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
@@ -0,0 +1,1361 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package rpc
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// GovernanceContractABI is the input ABI used to generate the binding from.
+const GovernanceContractABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"proposalID\",\"type\":\"uint256\"}],\"name\":\"ProposalCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"proposalID\",\"type\":\"uint256\"}],\"name\":\"ProposalResolved\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"proposalID\",\"type\":\"uint256\"}],\"name\":\"ProposalRejected\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"proposalID\",\"type\":\"uint256\"}],\"name\":\"ProposalCanceled\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"proposalID\",\"type\":\"uint256\"}],\"name\":\"ProposalExecutionExpired\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"voter\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"delegatedTo\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"proposalID\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256[]\",\"name\":\"choices\",\"type\":\"uint256[]\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"weight\",\"type\":\"uint256\"}],\"name\":\"Voted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"voter\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"delegatedTo\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"proposalID\",\"type\":\"uint256\"}],\"name\":\"VoteCanceled\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"lastProposalID\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"proposalID\",\"type\":\"uint256\"}],\"name\":\"proposalParams\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"pType\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"executable\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"minVotes\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"minAgreement\",\"type\":\"uint256\"},{\"internalType\":\"uint256[]\",\"name\":\"opinionScales\",\"type\":\"uint256[]\"},{\"internalType\":\"bytes32[]\",\"name\":\"options\",\"type\":\"bytes32[]\"},{\"internalType\":\"address\",\"name\":\"proposalContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"votingStartTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"votingMinEndTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"votingMaxEndTime\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"proposalID\",\"type\":\"uint256\"}],\"name\":\"proposalState\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"winnerOptionID\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"votes\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"status\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"proposalID\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"optionID\",\"type\":\"uint256\"}],\"name\":\"proposalOptionState\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"votes\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"agreementRatio\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"agreement\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"delegatedTo\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"proposalID\",\"type\":\"uint256\"}],\"name\":\"getVote\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"weight\",\"type\":\"uint256\"},{\"internalType\":\"uint256[]\",\"name\":\"choices\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"delegatedTo\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"proposalID\",\"type\":\"uint256\"},{\"internalType\":\"uint256[]\",\"name\":\"choices\",\"type\":\"uint256[]\"}],\"name\":\"vote\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"delegatedTo\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"proposalID\",\"type\":\"uint256\"}],\"name\":\"cancelVote\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+
+// GovernanceContract is an auto generated Go binding around an Ethereum contract.
+type GovernanceContract struct {
+	GovernanceContractCaller     // Read-only binding to the contract
+	GovernanceContractTransactor // Write-only binding to the contract
+	GovernanceContractFilterer   // Log filterer for contract events
+}
+
+// GovernanceContractCaller is an auto generated read-only Go binding around an Ethereum contract.
+type GovernanceContractCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GovernanceContractTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type GovernanceContractTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GovernanceContractFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type GovernanceContractFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GovernanceContractSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type GovernanceContractSession struct {
+	Contract     *GovernanceContract // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts       // Call options to use throughout this session
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// GovernanceContractCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type GovernanceContractCallerSession struct {
+	Contract *GovernanceContractCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts             // Call options to use throughout this session
+}
+
+// GovernanceContractTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type GovernanceContractTransactorSession struct {
+	Contract     *GovernanceContractTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts             // Transaction auth options to use throughout this session
+}
+
+// GovernanceContractRaw is an auto generated low-level Go binding around an Ethereum contract.
+type GovernanceContractRaw struct {
+	Contract *GovernanceContract // Generic contract binding to access the raw methods on
+}
+
+// GovernanceContractCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type GovernanceContractCallerRaw struct {
+	Contract *GovernanceContractCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// GovernanceContractTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type GovernanceContractTransactorRaw struct {
+	Contract *GovernanceContractTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewGovernanceContract creates a new instance of GovernanceContract, bound to a specific deployed contract.
+func NewGovernanceContract(address common.Address, backend bind.ContractBackend) (*GovernanceContract, error) {
+	contract, err := bindGovernanceContract(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &GovernanceContract{GovernanceContractCaller: GovernanceContractCaller{contract: contract}, GovernanceContractTransactor: GovernanceContractTransactor{contract: contract}, GovernanceContractFilterer: GovernanceContractFilterer{contract: contract}}, nil
+}
+
+// NewGovernanceContractCaller creates a new read-only instance of GovernanceContract, bound to a specific deployed contract.
+func NewGovernanceContractCaller(address common.Address, caller bind.ContractCaller) (*GovernanceContractCaller, error) {
+	contract, err := bindGovernanceContract(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &GovernanceContractCaller{contract: contract}, nil
+}
+
+// NewGovernanceContractTransactor creates a new write-only instance of GovernanceContract, bound to a specific deployed contract.
+func NewGovernanceContractTransactor(address common.Address, transactor bind.ContractTransactor) (*GovernanceContractTransactor, error) {
+	contract, err := bindGovernanceContract(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &GovernanceContractTransactor{contract: contract}, nil
+}
+
+// NewGovernanceContractFilterer creates a new log filterer instance of GovernanceContract, bound to a specific deployed contract.
+func NewGovernanceContractFilterer(address common.Address, filterer bind.ContractFilterer) (*GovernanceContractFilterer, error) {
+	contract, err := bindGovernanceContract(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &GovernanceContractFilterer{contract: contract}, nil
+}
+
+// bindGovernanceContract binds a generic wrapper to an already deployed contract.
+func bindGovernanceContract(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(GovernanceContractABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_GovernanceContract *GovernanceContractRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _GovernanceContract.Contract.GovernanceContractCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_GovernanceContract *GovernanceContractRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _GovernanceContract.Contract.GovernanceContractTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_GovernanceContract *GovernanceContractRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _GovernanceContract.Contract.GovernanceContractTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_GovernanceContract *GovernanceContractCallerRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _GovernanceContract.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_GovernanceContract *GovernanceContractTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _GovernanceContract.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_GovernanceContract *GovernanceContractTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _GovernanceContract.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetVote is a free data retrieval call binding the contract method 0xb9e6842b.
+//
+// Solidity: function getVote(address from, address delegatedTo, uint256 proposalID) view returns(uint256 weight, uint256[] choices)
+func (_GovernanceContract *GovernanceContractCaller) GetVote(opts *bind.CallOpts, from common.Address, delegatedTo common.Address, proposalID *big.Int) (struct {
+	Weight  *big.Int
+	Choices []*big.Int
+}, error) {
+	ret := new(struct {
+		Weight  *big.Int
+		Choices []*big.Int
+	})
+	out := ret
+	err := _GovernanceContract.contract.Call(opts, out, "getVote", from, delegatedTo, proposalID)
+	return *ret, err
+}
+
+// GetVote is a free data retrieval call binding the contract method 0xb9e6842b.
+//
+// Solidity: function getVote(address from, address delegatedTo, uint256 proposalID) view returns(uint256 weight, uint256[] choices)
+func (_GovernanceContract *GovernanceContractSession) GetVote(from common.Address, delegatedTo common.Address, proposalID *big.Int) (struct {
+	Weight  *big.Int
+	Choices []*big.Int
+}, error) {
+	return _GovernanceContract.Contract.GetVote(&_GovernanceContract.CallOpts, from, delegatedTo, proposalID)
+}
+
+// GetVote is a free data retrieval call binding the contract method 0xb9e6842b.
+//
+// Solidity: function getVote(address from, address delegatedTo, uint256 proposalID) view returns(uint256 weight, uint256[] choices)
+func (_GovernanceContract *GovernanceContractCallerSession) GetVote(from common.Address, delegatedTo common.Address, proposalID *big.Int) (struct {
+	Weight  *big.Int
+	Choices []*big.Int
+}, error) {
+	return _GovernanceContract.Contract.GetVote(&_GovernanceContract.CallOpts, from, delegatedTo, proposalID)
+}
+
+// LastProposalID is a free data retrieval call binding the contract method 0xa1d373d7.
+//
+// Solidity: function lastProposalID() view returns(uint256)
+func (_GovernanceContract *GovernanceContractCaller) LastProposalID(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _GovernanceContract.contract.Call(opts, out, "lastProposalID")
+	return *ret0, err
+}
+
+// LastProposalID is a free data retrieval call binding the contract method 0xa1d373d7.
+//
+// Solidity: function lastProposalID() view returns(uint256)
+func (_GovernanceContract *GovernanceContractSession) LastProposalID() (*big.Int, error) {
+	return _GovernanceContract.Contract.LastProposalID(&_GovernanceContract.CallOpts)
+}
+
+// LastProposalID is a free data retrieval call binding the contract method 0xa1d373d7.
+//
+// Solidity: function lastProposalID() view returns(uint256)
+func (_GovernanceContract *GovernanceContractCallerSession) LastProposalID() (*big.Int, error) {
+	return _GovernanceContract.Contract.LastProposalID(&_GovernanceContract.CallOpts)
+}
+
+// ProposalOptionState is a free data retrieval call binding the contract method 0x5f89801e.
+//
+// Solidity: function proposalOptionState(uint256 proposalID, uint256 optionID) view returns(uint256 votes, uint256 agreementRatio, uint256 agreement)
+func (_GovernanceContract *GovernanceContractCaller) ProposalOptionState(opts *bind.CallOpts, proposalID *big.Int, optionID *big.Int) (struct {
+	Votes          *big.Int
+	AgreementRatio *big.Int
+	Agreement      *big.Int
+}, error) {
+	ret := new(struct {
+		Votes          *big.Int
+		AgreementRatio *big.Int
+		Agreement      *big.Int
+	})
+	out := ret
+	err := _GovernanceContract.contract.Call(opts, out, "proposalOptionState", proposalID, optionID)
+	return *ret, err
+}
+
+// ProposalOptionState is a free data retrieval call binding the contract method 0x5f89801e.
+//
+// Solidity: function proposalOptionState(uint256 proposalID, uint256 optionID) view returns(uint256 votes, uint256 agreementRatio, uint256 agreement)
+func (_GovernanceContract *GovernanceContractSession) ProposalOptionState(proposalID *big.Int, optionID *big.Int) (struct {
+	Votes          *big.Int
+	AgreementRatio *big.Int
+	Agreement      *big.Int
+}, error) {
+	return _GovernanceContract.Contract.ProposalOptionState(&_GovernanceContract.CallOpts, proposalID, optionID)
+}
+
+// ProposalOptionState is a free data retrieval call binding the contract method 0x5f89801e.
+//
+// Solidity: function proposalOptionState(uint256 proposalID, uint256 optionID) view returns(uint256 votes, uint256 agreementRatio, uint256 agreement)
+func (_GovernanceContract *GovernanceContractCallerSession) ProposalOptionState(proposalID *big.Int, optionID *big.Int) (struct {
+	Votes          *big.Int
+	AgreementRatio *big.Int
+	Agreement      *big.Int
+}, error) {
+	return _GovernanceContract.Contract.ProposalOptionState(&_GovernanceContract.CallOpts, proposalID, optionID)
+}
+
+// ProposalParams is a free data retrieval call binding the contract method 0xcfa1afa3.
+//
+// Solidity: function proposalParams(uint256 proposalID) view returns(uint256 pType, bool executable, uint256 minVotes, uint256 minAgreement, uint256[] opinionScales, bytes32[] options, address proposalContract, uint256 votingStartTime, uint256 votingMinEndTime, uint256 votingMaxEndTime)
+func (_GovernanceContract *GovernanceContractCaller) ProposalParams(opts *bind.CallOpts, proposalID *big.Int) (struct {
+	PType            *big.Int
+	Executable       bool
+	MinVotes         *big.Int
+	MinAgreement     *big.Int
+	OpinionScales    []*big.Int
+	Options          [][32]byte
+	ProposalContract common.Address
+	VotingStartTime  *big.Int
+	VotingMinEndTime *big.Int
+	VotingMaxEndTime *big.Int
+}, error) {
+	ret := new(struct {
+		PType            *big.Int
+		Executable       bool
+		MinVotes         *big.Int
+		MinAgreement     *big.Int
+		OpinionScales    []*big.Int
+		Options          [][32]byte
+		ProposalContract common.Address
+		VotingStartTime  *big.Int
+		VotingMinEndTime *big.Int
+		VotingMaxEndTime *big.Int
+	})
+	out := ret
+	err := _GovernanceContract.contract.Call(opts, out, "proposalParams", proposalID)
+	return *ret, err
+}
+
+// ProposalParams is a free data retrieval call binding the contract method 0xcfa1afa3.
+//
+// Solidity: function proposalParams(uint256 proposalID) view returns(uint256 pType, bool executable, uint256 minVotes, uint256 minAgreement, uint256[] opinionScales, bytes32[] options, address proposalContract, uint256 votingStartTime, uint256 votingMinEndTime, uint256 votingMaxEndTime)
+func (_GovernanceContract *GovernanceContractSession) ProposalParams(proposalID *big.Int) (struct {
+	PType            *big.Int
+	Executable       bool
+	MinVotes         *big.Int
+	MinAgreement     *big.Int
+	OpinionScales    []*big.Int
+	Options          [][32]byte
+	ProposalContract common.Address
+	VotingStartTime  *big.Int
+	VotingMinEndTime *big.Int
+	VotingMaxEndTime *big.Int
+}, error) {
+	return _GovernanceContract.Contract.ProposalParams(&_GovernanceContract.CallOpts, proposalID)
+}
+
+// ProposalParams is a free data retrieval call binding the contract method 0xcfa1afa3.
+//
+// Solidity: function proposalParams(uint256 proposalID) view returns(uint256 pType, bool executable, uint256 minVotes, uint256 minAgreement, uint256[] opinionScales, bytes32[] options, address proposalContract, uint256 votingStartTime, uint256 votingMinEndTime, uint256 votingMaxEndTime)
+func (_GovernanceContract *GovernanceContractCallerSession) ProposalParams(proposalID *big.Int) (struct {
+	PType            *big.Int
+	Executable       bool
+	MinVotes         *big.Int
+	MinAgreement     *big.Int
+	OpinionScales    []*big.Int
+	Options          [][32]byte
+	ProposalContract common.Address
+	VotingStartTime  *big.Int
+	VotingMinEndTime *big.Int
+	VotingMaxEndTime *big.Int
+}, error) {
+	return _GovernanceContract.Contract.ProposalParams(&_GovernanceContract.CallOpts, proposalID)
+}
+
+// ProposalState is a free data retrieval call binding the contract method 0xd26331d4.
+//
+// Solidity: function proposalState(uint256 proposalID) view returns(uint256 winnerOptionID, uint256 votes, uint256 status)
+func (_GovernanceContract *GovernanceContractCaller) ProposalState(opts *bind.CallOpts, proposalID *big.Int) (struct {
+	WinnerOptionID *big.Int
+	Votes          *big.Int
+	Status         *big.Int
+}, error) {
+	ret := new(struct {
+		WinnerOptionID *big.Int
+		Votes          *big.Int
+		Status         *big.Int
+	})
+	out := ret
+	err := _GovernanceContract.contract.Call(opts, out, "proposalState", proposalID)
+	return *ret, err
+}
+
+// ProposalState is a free data retrieval call binding the contract method 0xd26331d4.
+//
+// Solidity: function proposalState(uint256 proposalID) view returns(uint256 winnerOptionID, uint256 votes, uint256 status)
+func (_GovernanceContract *GovernanceContractSession) ProposalState(proposalID *big.Int) (struct {
+	WinnerOptionID *big.Int
+	Votes          *big.Int
+	Status         *big.Int
+}, error) {
+	return _GovernanceContract.Contract.ProposalState(&_GovernanceContract.CallOpts, proposalID)
+}
+
+// ProposalState is a free data retrieval call binding the contract method 0xd26331d4.
+//
+// Solidity: function proposalState(uint256 proposalID) view returns(uint256 winnerOptionID, uint256 votes, uint256 status)
+func (_GovernanceContract *GovernanceContractCallerSession) ProposalState(proposalID *big.Int) (struct {
+	WinnerOptionID *big.Int
+	Votes          *big.Int
+	Status         *big.Int
+}, error) {
+	return _GovernanceContract.Contract.ProposalState(&_GovernanceContract.CallOpts, proposalID)
+}
+
+// CancelVote is a paid mutator transaction binding the contract method 0x21edf2eb.
+//
+// Solidity: function cancelVote(address delegatedTo, uint256 proposalID) returns()
+func (_GovernanceContract *GovernanceContractTransactor) CancelVote(opts *bind.TransactOpts, delegatedTo common.Address, proposalID *big.Int) (*types.Transaction, error) {
+	return _GovernanceContract.contract.Transact(opts, "cancelVote", delegatedTo, proposalID)
+}
+
+// CancelVote is a paid mutator transaction binding the contract method 0x21edf2eb.
+//
+// Solidity: function cancelVote(address delegatedTo, uint256 proposalID) returns()
+func (_GovernanceContract *GovernanceContractSession) CancelVote(delegatedTo common.Address, proposalID *big.Int) (*types.Transaction, error) {
+	return _GovernanceContract.Contract.CancelVote(&_GovernanceContract.TransactOpts, delegatedTo, proposalID)
+}
+
+// CancelVote is a paid mutator transaction binding the contract method 0x21edf2eb.
+//
+// Solidity: function cancelVote(address delegatedTo, uint256 proposalID) returns()
+func (_GovernanceContract *GovernanceContractTransactorSession) CancelVote(delegatedTo common.Address, proposalID *big.Int) (*types.Transaction, error) {
+	return _GovernanceContract.Contract.CancelVote(&_GovernanceContract.TransactOpts, delegatedTo, proposalID)
+}
+
+// Vote is a paid mutator transaction binding the contract method 0x172c18b1.
+//
+// Solidity: function vote(address delegatedTo, uint256 proposalID, uint256[] choices) returns()
+func (_GovernanceContract *GovernanceContractTransactor) Vote(opts *bind.TransactOpts, delegatedTo common.Address, proposalID *big.Int, choices []*big.Int) (*types.Transaction, error) {
+	return _GovernanceContract.contract.Transact(opts, "vote", delegatedTo, proposalID, choices)
+}
+
+// Vote is a paid mutator transaction binding the contract method 0x172c18b1.
+//
+// Solidity: function vote(address delegatedTo, uint256 proposalID, uint256[] choices) returns()
+func (_GovernanceContract *GovernanceContractSession) Vote(delegatedTo common.Address, proposalID *big.Int, choices []*big.Int) (*types.Transaction, error) {
+	return _GovernanceContract.Contract.Vote(&_GovernanceContract.TransactOpts, delegatedTo, proposalID, choices)
+}
+
+// Vote is a paid mutator transaction binding the contract method 0x172c18b1.
+//
+// Solidity: function vote(address delegatedTo, uint256 proposalID, uint256[] choices) returns()
+func (_GovernanceContract *GovernanceContractTransactorSession) Vote(delegatedTo common.Address, proposalID *big.Int, choices []*big.Int) (*types.Transaction, error) {
+	return _GovernanceContract.Contract.Vote(&_GovernanceContract.TransactOpts, delegatedTo, proposalID, choices)
+}
+
+// GovernanceContractProposalCanceledIterator is returned from FilterProposalCanceled and is used to iterate over the raw logs and unpacked data for ProposalCanceled events raised by the GovernanceContract contract.
+type GovernanceContractProposalCanceledIterator struct {
+	Event *GovernanceContractProposalCanceled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovernanceContractProposalCanceledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovernanceContractProposalCanceled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovernanceContractProposalCanceled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovernanceContractProposalCanceledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovernanceContractProposalCanceledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovernanceContractProposalCanceled represents a ProposalCanceled event raised by the GovernanceContract contract.
+type GovernanceContractProposalCanceled struct {
+	ProposalID *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterProposalCanceled is a free log retrieval operation binding the contract event 0x789cf55be980739dad1d0699b93b58e806b51c9d96619bfa8fe0a28abaa7b30c.
+//
+// Solidity: event ProposalCanceled(uint256 proposalID)
+func (_GovernanceContract *GovernanceContractFilterer) FilterProposalCanceled(opts *bind.FilterOpts) (*GovernanceContractProposalCanceledIterator, error) {
+
+	logs, sub, err := _GovernanceContract.contract.FilterLogs(opts, "ProposalCanceled")
+	if err != nil {
+		return nil, err
+	}
+	return &GovernanceContractProposalCanceledIterator{contract: _GovernanceContract.contract, event: "ProposalCanceled", logs: logs, sub: sub}, nil
+}
+
+// WatchProposalCanceled is a free log subscription operation binding the contract event 0x789cf55be980739dad1d0699b93b58e806b51c9d96619bfa8fe0a28abaa7b30c.
+//
+// Solidity: event ProposalCanceled(uint256 proposalID)
+func (_GovernanceContract *GovernanceContractFilterer) WatchProposalCanceled(opts *bind.WatchOpts, sink chan<- *GovernanceContractProposalCanceled) (event.Subscription, error) {
+
+	logs, sub, err := _GovernanceContract.contract.WatchLogs(opts, "ProposalCanceled")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovernanceContractProposalCanceled)
+				if err := _GovernanceContract.contract.UnpackLog(event, "ProposalCanceled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseProposalCanceled is a log parse operation binding the contract event 0x789cf55be980739dad1d0699b93b58e806b51c9d96619bfa8fe0a28abaa7b30c.
+//
+// Solidity: event ProposalCanceled(uint256 proposalID)
+func (_GovernanceContract *GovernanceContractFilterer) ParseProposalCanceled(log types.Log) (*GovernanceContractProposalCanceled, error) {
+	event := new(GovernanceContractProposalCanceled)
+	if err := _GovernanceContract.contract.UnpackLog(event, "ProposalCanceled", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// GovernanceContractProposalCreatedIterator is returned from FilterProposalCreated and is used to iterate over the raw logs and unpacked data for ProposalCreated events raised by the GovernanceContract contract.
+type GovernanceContractProposalCreatedIterator struct {
+	Event *GovernanceContractProposalCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovernanceContractProposalCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovernanceContractProposalCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovernanceContractProposalCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovernanceContractProposalCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovernanceContractProposalCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovernanceContractProposalCreated represents a ProposalCreated event raised by the GovernanceContract contract.
+type GovernanceContractProposalCreated struct {
+	ProposalID *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterProposalCreated is a free log retrieval operation binding the contract event 0xc2c021f5d73c63c481d336fbbafec58f694fc45095f00b02d2deb8cca59afe07.
+//
+// Solidity: event ProposalCreated(uint256 proposalID)
+func (_GovernanceContract *GovernanceContractFilterer) FilterProposalCreated(opts *bind.FilterOpts) (*GovernanceContractProposalCreatedIterator, error) {
+
+	logs, sub, err := _GovernanceContract.contract.FilterLogs(opts, "ProposalCreated")
+	if err != nil {
+		return nil, err
+	}
+	return &GovernanceContractProposalCreatedIterator{contract: _GovernanceContract.contract, event: "ProposalCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchProposalCreated is a free log subscription operation binding the contract event 0xc2c021f5d73c63c481d336fbbafec58f694fc45095f00b02d2deb8cca59afe07.
+//
+// Solidity: event ProposalCreated(uint256 proposalID)
+func (_GovernanceContract *GovernanceContractFilterer) WatchProposalCreated(opts *bind.WatchOpts, sink chan<- *GovernanceContractProposalCreated) (event.Subscription, error) {
+
+	logs, sub, err := _GovernanceContract.contract.WatchLogs(opts, "ProposalCreated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovernanceContractProposalCreated)
+				if err := _GovernanceContract.contract.UnpackLog(event, "ProposalCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseProposalCreated is a log parse operation binding the contract event 0xc2c021f5d73c63c481d336fbbafec58f694fc45095f00b02d2deb8cca59afe07.
+//
+// Solidity: event ProposalCreated(uint256 proposalID)
+func (_GovernanceContract *GovernanceContractFilterer) ParseProposalCreated(log types.Log) (*GovernanceContractProposalCreated, error) {
+	event := new(GovernanceContractProposalCreated)
+	if err := _GovernanceContract.contract.UnpackLog(event, "ProposalCreated", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// GovernanceContractProposalExecutionExpiredIterator is returned from FilterProposalExecutionExpired and is used to iterate over the raw logs and unpacked data for ProposalExecutionExpired events raised by the GovernanceContract contract.
+type GovernanceContractProposalExecutionExpiredIterator struct {
+	Event *GovernanceContractProposalExecutionExpired // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovernanceContractProposalExecutionExpiredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovernanceContractProposalExecutionExpired)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovernanceContractProposalExecutionExpired)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovernanceContractProposalExecutionExpiredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovernanceContractProposalExecutionExpiredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovernanceContractProposalExecutionExpired represents a ProposalExecutionExpired event raised by the GovernanceContract contract.
+type GovernanceContractProposalExecutionExpired struct {
+	ProposalID *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterProposalExecutionExpired is a free log retrieval operation binding the contract event 0xe8365dd25802fb5113a4ebd6fbe5fee885b5ea470b6b1467f3f4df69e490ed87.
+//
+// Solidity: event ProposalExecutionExpired(uint256 proposalID)
+func (_GovernanceContract *GovernanceContractFilterer) FilterProposalExecutionExpired(opts *bind.FilterOpts) (*GovernanceContractProposalExecutionExpiredIterator, error) {
+
+	logs, sub, err := _GovernanceContract.contract.FilterLogs(opts, "ProposalExecutionExpired")
+	if err != nil {
+		return nil, err
+	}
+	return &GovernanceContractProposalExecutionExpiredIterator{contract: _GovernanceContract.contract, event: "ProposalExecutionExpired", logs: logs, sub: sub}, nil
+}
+
+// WatchProposalExecutionExpired is a free log subscription operation binding the contract event 0xe8365dd25802fb5113a4ebd6fbe5fee885b5ea470b6b1467f3f4df69e490ed87.
+//
+// Solidity: event ProposalExecutionExpired(uint256 proposalID)
+func (_GovernanceContract *GovernanceContractFilterer) WatchProposalExecutionExpired(opts *bind.WatchOpts, sink chan<- *GovernanceContractProposalExecutionExpired) (event.Subscription, error) {
+
+	logs, sub, err := _GovernanceContract.contract.WatchLogs(opts, "ProposalExecutionExpired")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovernanceContractProposalExecutionExpired)
+				if err := _GovernanceContract.contract.UnpackLog(event, "ProposalExecutionExpired", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseProposalExecutionExpired is a log parse operation binding the contract event 0xe8365dd25802fb5113a4ebd6fbe5fee885b5ea470b6b1467f3f4df69e490ed87.
+//
+// Solidity: event ProposalExecutionExpired(uint256 proposalID)
+func (_GovernanceContract *GovernanceContractFilterer) ParseProposalExecutionExpired(log types.Log) (*GovernanceContractProposalExecutionExpired, error) {
+	event := new(GovernanceContractProposalExecutionExpired)
+	if err := _GovernanceContract.contract.UnpackLog(event, "ProposalExecutionExpired", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// GovernanceContractProposalRejectedIterator is returned from FilterProposalRejected and is used to iterate over the raw logs and unpacked data for ProposalRejected events raised by the GovernanceContract contract.
+type GovernanceContractProposalRejectedIterator struct {
+	Event *GovernanceContractProposalRejected // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovernanceContractProposalRejectedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovernanceContractProposalRejected)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovernanceContractProposalRejected)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovernanceContractProposalRejectedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovernanceContractProposalRejectedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovernanceContractProposalRejected represents a ProposalRejected event raised by the GovernanceContract contract.
+type GovernanceContractProposalRejected struct {
+	ProposalID *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterProposalRejected is a free log retrieval operation binding the contract event 0xd92fba445edb3153b571e6df782d7a66fd0ce668519273670820ee3a86da0ef4.
+//
+// Solidity: event ProposalRejected(uint256 proposalID)
+func (_GovernanceContract *GovernanceContractFilterer) FilterProposalRejected(opts *bind.FilterOpts) (*GovernanceContractProposalRejectedIterator, error) {
+
+	logs, sub, err := _GovernanceContract.contract.FilterLogs(opts, "ProposalRejected")
+	if err != nil {
+		return nil, err
+	}
+	return &GovernanceContractProposalRejectedIterator{contract: _GovernanceContract.contract, event: "ProposalRejected", logs: logs, sub: sub}, nil
+}
+
+// WatchProposalRejected is a free log subscription operation binding the contract event 0xd92fba445edb3153b571e6df782d7a66fd0ce668519273670820ee3a86da0ef4.
+//
+// Solidity: event ProposalRejected(uint256 proposalID)
+func (_GovernanceContract *GovernanceContractFilterer) WatchProposalRejected(opts *bind.WatchOpts, sink chan<- *GovernanceContractProposalRejected) (event.Subscription, error) {
+
+	logs, sub, err := _GovernanceContract.contract.WatchLogs(opts, "ProposalRejected")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovernanceContractProposalRejected)
+				if err := _GovernanceContract.contract.UnpackLog(event, "ProposalRejected", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseProposalRejected is a log parse operation binding the contract event 0xd92fba445edb3153b571e6df782d7a66fd0ce668519273670820ee3a86da0ef4.
+//
+// Solidity: event ProposalRejected(uint256 proposalID)
+func (_GovernanceContract *GovernanceContractFilterer) ParseProposalRejected(log types.Log) (*GovernanceContractProposalRejected, error) {
+	event := new(GovernanceContractProposalRejected)
+	if err := _GovernanceContract.contract.UnpackLog(event, "ProposalRejected", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// GovernanceContractProposalResolvedIterator is returned from FilterProposalResolved and is used to iterate over the raw logs and unpacked data for ProposalResolved events raised by the GovernanceContract contract.
+type GovernanceContractProposalResolvedIterator struct {
+	Event *GovernanceContractProposalResolved // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovernanceContractProposalResolvedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovernanceContractProposalResolved)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovernanceContractProposalResolved)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovernanceContractProposalResolvedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovernanceContractProposalResolvedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovernanceContractProposalResolved represents a ProposalResolved event raised by the GovernanceContract contract.
+type GovernanceContractProposalResolved struct {
+	ProposalID *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterProposalResolved is a free log retrieval operation binding the contract event 0x663674d96fd5c2a954bf75ad2e6795f9c9701eb687a7a8f3297c7a299467c941.
+//
+// Solidity: event ProposalResolved(uint256 proposalID)
+func (_GovernanceContract *GovernanceContractFilterer) FilterProposalResolved(opts *bind.FilterOpts) (*GovernanceContractProposalResolvedIterator, error) {
+
+	logs, sub, err := _GovernanceContract.contract.FilterLogs(opts, "ProposalResolved")
+	if err != nil {
+		return nil, err
+	}
+	return &GovernanceContractProposalResolvedIterator{contract: _GovernanceContract.contract, event: "ProposalResolved", logs: logs, sub: sub}, nil
+}
+
+// WatchProposalResolved is a free log subscription operation binding the contract event 0x663674d96fd5c2a954bf75ad2e6795f9c9701eb687a7a8f3297c7a299467c941.
+//
+// Solidity: event ProposalResolved(uint256 proposalID)
+func (_GovernanceContract *GovernanceContractFilterer) WatchProposalResolved(opts *bind.WatchOpts, sink chan<- *GovernanceContractProposalResolved) (event.Subscription, error) {
+
+	logs, sub, err := _GovernanceContract.contract.WatchLogs(opts, "ProposalResolved")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovernanceContractProposalResolved)
+				if err := _GovernanceContract.contract.UnpackLog(event, "ProposalResolved", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseProposalResolved is a log parse operation binding the contract event 0x663674d96fd5c2a954bf75ad2e6795f9c9701eb687a7a8f3297c7a299467c941.
+//
+// Solidity: event ProposalResolved(uint256 proposalID)
+func (_GovernanceContract *GovernanceContractFilterer) ParseProposalResolved(log types.Log) (*GovernanceContractProposalResolved, error) {
+	event := new(GovernanceContractProposalResolved)
+	if err := _GovernanceContract.contract.UnpackLog(event, "ProposalResolved", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// GovernanceContractVoteCanceledIterator is returned from FilterVoteCanceled and is used to iterate over the raw logs and unpacked data for VoteCanceled events raised by the GovernanceContract contract.
+type GovernanceContractVoteCanceledIterator struct {
+	Event *GovernanceContractVoteCanceled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovernanceContractVoteCanceledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovernanceContractVoteCanceled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovernanceContractVoteCanceled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovernanceContractVoteCanceledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovernanceContractVoteCanceledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovernanceContractVoteCanceled represents a VoteCanceled event raised by the GovernanceContract contract.
+type GovernanceContractVoteCanceled struct {
+	Voter       common.Address
+	DelegatedTo common.Address
+	ProposalID  *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterVoteCanceled is a free log retrieval operation binding the contract event 0x666685d133047310e2a2e8c4f6794b6dccb4e9ad9c6903ac753fb10d8918b649.
+//
+// Solidity: event VoteCanceled(address voter, address delegatedTo, uint256 proposalID)
+func (_GovernanceContract *GovernanceContractFilterer) FilterVoteCanceled(opts *bind.FilterOpts) (*GovernanceContractVoteCanceledIterator, error) {
+
+	logs, sub, err := _GovernanceContract.contract.FilterLogs(opts, "VoteCanceled")
+	if err != nil {
+		return nil, err
+	}
+	return &GovernanceContractVoteCanceledIterator{contract: _GovernanceContract.contract, event: "VoteCanceled", logs: logs, sub: sub}, nil
+}
+
+// WatchVoteCanceled is a free log subscription operation binding the contract event 0x666685d133047310e2a2e8c4f6794b6dccb4e9ad9c6903ac753fb10d8918b649.
+//
+// Solidity: event VoteCanceled(address voter, address delegatedTo, uint256 proposalID)
+func (_GovernanceContract *GovernanceContractFilterer) WatchVoteCanceled(opts *bind.WatchOpts, sink chan<- *GovernanceContractVoteCanceled) (event.Subscription, error) {
+
+	logs, sub, err := _GovernanceContract.contract.WatchLogs(opts, "VoteCanceled")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovernanceContractVoteCanceled)
+				if err := _GovernanceContract.contract.UnpackLog(event, "VoteCanceled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseVoteCanceled is a log parse operation binding the contract event 0x666685d133047310e2a2e8c4f6794b6dccb4e9ad9c6903ac753fb10d8918b649.
+//
+// Solidity: event VoteCanceled(address voter, address delegatedTo, uint256 proposalID)
+func (_GovernanceContract *GovernanceContractFilterer) ParseVoteCanceled(log types.Log) (*GovernanceContractVoteCanceled, error) {
+	event := new(GovernanceContractVoteCanceled)
+	if err := _GovernanceContract.contract.UnpackLog(event, "VoteCanceled", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// GovernanceContractVotedIterator is returned from FilterVoted and is used to iterate over the raw logs and unpacked data for Voted events raised by the GovernanceContract contract.
+type GovernanceContractVotedIterator struct {
+	Event *GovernanceContractVoted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovernanceContractVotedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovernanceContractVoted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovernanceContractVoted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovernanceContractVotedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovernanceContractVotedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovernanceContractVoted represents a Voted event raised by the GovernanceContract contract.
+type GovernanceContractVoted struct {
+	Voter       common.Address
+	DelegatedTo common.Address
+	ProposalID  *big.Int
+	Choices     []*big.Int
+	Weight      *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterVoted is a free log retrieval operation binding the contract event 0x6e5f0f6e0ce2bdcdb0a82952fc6eb90c4c22f0b6228e4619b5dc2118e1166a12.
+//
+// Solidity: event Voted(address voter, address delegatedTo, uint256 proposalID, uint256[] choices, uint256 weight)
+func (_GovernanceContract *GovernanceContractFilterer) FilterVoted(opts *bind.FilterOpts) (*GovernanceContractVotedIterator, error) {
+
+	logs, sub, err := _GovernanceContract.contract.FilterLogs(opts, "Voted")
+	if err != nil {
+		return nil, err
+	}
+	return &GovernanceContractVotedIterator{contract: _GovernanceContract.contract, event: "Voted", logs: logs, sub: sub}, nil
+}
+
+// WatchVoted is a free log subscription operation binding the contract event 0x6e5f0f6e0ce2bdcdb0a82952fc6eb90c4c22f0b6228e4619b5dc2118e1166a12.
+//
+// Solidity: event Voted(address voter, address delegatedTo, uint256 proposalID, uint256[] choices, uint256 weight)
+func (_GovernanceContract *GovernanceContractFilterer) WatchVoted(opts *bind.WatchOpts, sink chan<- *GovernanceContractVoted) (event.Subscription, error) {
+
+	logs, sub, err := _GovernanceContract.contract.WatchLogs(opts, "Voted")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovernanceContractVoted)
+				if err := _GovernanceContract.contract.UnpackLog(event, "Voted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseVoted is a log parse operation binding the contract event 0x6e5f0f6e0ce2bdcdb0a82952fc6eb90c4c22f0b6228e4619b5dc2118e1166a12.
+//
+// Solidity: event Voted(address voter, address delegatedTo, uint256 proposalID, uint256[] choices, uint256 weight)
+func (_GovernanceContract *GovernanceContractFilterer) ParseVoted(log types.Log) (*GovernanceContractVoted, error) {
+	event := new(GovernanceContractVoted)
+	if err := _GovernanceContract.contract.UnpackLog(event, "Voted", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
@@ -0,0 +1,224 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package rpc
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// GovernanceProposalABI is the input ABI used to generate the binding from.
+const GovernanceProposalABI = "[{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"description\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]"
+
+// GovernanceProposal is an auto generated Go binding around an Ethereum contract.
+type GovernanceProposal struct {
+	GovernanceProposalCaller     // Read-only binding to the contract
+	GovernanceProposalTransactor // Write-only binding to the contract
+	GovernanceProposalFilterer   // Log filterer for contract events
+}
+
+// GovernanceProposalCaller is an auto generated read-only Go binding around an Ethereum contract.
+type GovernanceProposalCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GovernanceProposalTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type GovernanceProposalTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GovernanceProposalFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type GovernanceProposalFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GovernanceProposalSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type GovernanceProposalSession struct {
+	Contract     *GovernanceProposal // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts       // Call options to use throughout this session
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// GovernanceProposalCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type GovernanceProposalCallerSession struct {
+	Contract *GovernanceProposalCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts             // Call options to use throughout this session
+}
+
+// GovernanceProposalTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type GovernanceProposalTransactorSession struct {
+	Contract     *GovernanceProposalTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts             // Transaction auth options to use throughout this session
+}
+
+// GovernanceProposalRaw is an auto generated low-level Go binding around an Ethereum contract.
+type GovernanceProposalRaw struct {
+	Contract *GovernanceProposal // Generic contract binding to access the raw methods on
+}
+
+// GovernanceProposalCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type GovernanceProposalCallerRaw struct {
+	Contract *GovernanceProposalCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// GovernanceProposalTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type GovernanceProposalTransactorRaw struct {
+	Contract *GovernanceProposalTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewGovernanceProposal creates a new instance of GovernanceProposal, bound to a specific deployed contract.
+func NewGovernanceProposal(address common.Address, backend bind.ContractBackend) (*GovernanceProposal, error) {
+	contract, err := bindGovernanceProposal(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &GovernanceProposal{GovernanceProposalCaller: GovernanceProposalCaller{contract: contract}, GovernanceProposalTransactor: GovernanceProposalTransactor{contract: contract}, GovernanceProposalFilterer: GovernanceProposalFilterer{contract: contract}}, nil
+}
+
+// NewGovernanceProposalCaller creates a new read-only instance of GovernanceProposal, bound to a specific deployed contract.
+func NewGovernanceProposalCaller(address common.Address, caller bind.ContractCaller) (*GovernanceProposalCaller, error) {
+	contract, err := bindGovernanceProposal(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &GovernanceProposalCaller{contract: contract}, nil
+}
+
+// NewGovernanceProposalTransactor creates a new write-only instance of GovernanceProposal, bound to a specific deployed contract.
+func NewGovernanceProposalTransactor(address common.Address, transactor bind.ContractTransactor) (*GovernanceProposalTransactor, error) {
+	contract, err := bindGovernanceProposal(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &GovernanceProposalTransactor{contract: contract}, nil
+}
+
+// NewGovernanceProposalFilterer creates a new log filterer instance of GovernanceProposal, bound to a specific deployed contract.
+func NewGovernanceProposalFilterer(address common.Address, filterer bind.ContractFilterer) (*GovernanceProposalFilterer, error) {
+	contract, err := bindGovernanceProposal(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &GovernanceProposalFilterer{contract: contract}, nil
+}
+
+// bindGovernanceProposal binds a generic wrapper to an already deployed contract.
+func bindGovernanceProposal(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(GovernanceProposalABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_GovernanceProposal *GovernanceProposalRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _GovernanceProposal.Contract.GovernanceProposalCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_GovernanceProposal *GovernanceProposalRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _GovernanceProposal.Contract.GovernanceProposalTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_GovernanceProposal *GovernanceProposalRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _GovernanceProposal.Contract.GovernanceProposalTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_GovernanceProposal *GovernanceProposalCallerRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _GovernanceProposal.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_GovernanceProposal *GovernanceProposalTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _GovernanceProposal.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_GovernanceProposal *GovernanceProposalTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _GovernanceProposal.Contract.contract.Transact(opts, method, params...)
+}
+
+// Description is a free data retrieval call binding the contract method 0x7284e416.
+//
+// Solidity: function description() view returns(string)
+func (_GovernanceProposal *GovernanceProposalCaller) Description(opts *bind.CallOpts) (string, error) {
+	var (
+		ret0 = new(string)
+	)
+	out := ret0
+	err := _GovernanceProposal.contract.Call(opts, out, "description")
+	return *ret0, err
+}
+
+// Description is a free data retrieval call binding the contract method 0x7284e416.
+//
+// Solidity: function description() view returns(string)
+func (_GovernanceProposal *GovernanceProposalSession) Description() (string, error) {
+	return _GovernanceProposal.Contract.Description(&_GovernanceProposal.CallOpts)
+}
+
+// Description is a free data retrieval call binding the contract method 0x7284e416.
+//
+// Solidity: function description() view returns(string)
+func (_GovernanceProposal *GovernanceProposalCallerSession) Description() (string, error) {
+	return _GovernanceProposal.Contract.Description(&_GovernanceProposal.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_GovernanceProposal *GovernanceProposalCaller) Name(opts *bind.CallOpts) (string, error) {
+	var (
+		ret0 = new(string)
+	)
+	out := ret0
+	err := _GovernanceProposal.contract.Call(opts, out, "name")
+	return *ret0, err
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_GovernanceProposal *GovernanceProposalSession) Name() (string, error) {
+	return _GovernanceProposal.Contract.Name(&_GovernanceProposal.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_GovernanceProposal *GovernanceProposalCallerSession) Name() (string, error) {
+	return _GovernanceProposal.Contract.Name(&_GovernanceProposal.CallOpts)
+}
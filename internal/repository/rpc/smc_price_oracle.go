@@ -0,0 +1,198 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package rpc
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// PriceOracleProxyABI is the input ABI used to generate the binding from.
+const PriceOracleProxyABI = "[{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"}],\"name\":\"getPrice\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"}]"
+
+// PriceOracleProxy is an auto generated Go binding around an Ethereum contract.
+type PriceOracleProxy struct {
+	PriceOracleProxyCaller     // Read-only binding to the contract
+	PriceOracleProxyTransactor // Write-only binding to the contract
+	PriceOracleProxyFilterer   // Log filterer for contract events
+}
+
+// PriceOracleProxyCaller is an auto generated read-only Go binding around an Ethereum contract.
+type PriceOracleProxyCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PriceOracleProxyTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type PriceOracleProxyTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PriceOracleProxyFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type PriceOracleProxyFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PriceOracleProxySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type PriceOracleProxySession struct {
+	Contract     *PriceOracleProxy // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// PriceOracleProxyCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type PriceOracleProxyCallerSession struct {
+	Contract *PriceOracleProxyCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts           // Call options to use throughout this session
+}
+
+// PriceOracleProxyTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type PriceOracleProxyTransactorSession struct {
+	Contract     *PriceOracleProxyTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts           // Transaction auth options to use throughout this session
+}
+
+// PriceOracleProxyRaw is an auto generated low-level Go binding around an Ethereum contract.
+type PriceOracleProxyRaw struct {
+	Contract *PriceOracleProxy // Generic contract binding to access the raw methods on
+}
+
+// PriceOracleProxyCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type PriceOracleProxyCallerRaw struct {
+	Contract *PriceOracleProxyCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// PriceOracleProxyTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type PriceOracleProxyTransactorRaw struct {
+	Contract *PriceOracleProxyTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewPriceOracleProxy creates a new instance of PriceOracleProxy, bound to a specific deployed contract.
+func NewPriceOracleProxy(address common.Address, backend bind.ContractBackend) (*PriceOracleProxy, error) {
+	contract, err := bindPriceOracleProxy(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &PriceOracleProxy{PriceOracleProxyCaller: PriceOracleProxyCaller{contract: contract}, PriceOracleProxyTransactor: PriceOracleProxyTransactor{contract: contract}, PriceOracleProxyFilterer: PriceOracleProxyFilterer{contract: contract}}, nil
+}
+
+// NewPriceOracleProxyCaller creates a new read-only instance of PriceOracleProxy, bound to a specific deployed contract.
+func NewPriceOracleProxyCaller(address common.Address, caller bind.ContractCaller) (*PriceOracleProxyCaller, error) {
+	contract, err := bindPriceOracleProxy(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &PriceOracleProxyCaller{contract: contract}, nil
+}
+
+// NewPriceOracleProxyTransactor creates a new write-only instance of PriceOracleProxy, bound to a specific deployed contract.
+func NewPriceOracleProxyTransactor(address common.Address, transactor bind.ContractTransactor) (*PriceOracleProxyTransactor, error) {
+	contract, err := bindPriceOracleProxy(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &PriceOracleProxyTransactor{contract: contract}, nil
+}
+
+// NewPriceOracleProxyFilterer creates a new log filterer instance of PriceOracleProxy, bound to a specific deployed contract.
+func NewPriceOracleProxyFilterer(address common.Address, filterer bind.ContractFilterer) (*PriceOracleProxyFilterer, error) {
+	contract, err := bindPriceOracleProxy(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &PriceOracleProxyFilterer{contract: contract}, nil
+}
+
+// bindPriceOracleProxy binds a generic wrapper to an already deployed contract.
+func bindPriceOracleProxy(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(PriceOracleProxyABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_PriceOracleProxy *PriceOracleProxyRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _PriceOracleProxy.Contract.PriceOracleProxyCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_PriceOracleProxy *PriceOracleProxyRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _PriceOracleProxy.Contract.PriceOracleProxyTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_PriceOracleProxy *PriceOracleProxyRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _PriceOracleProxy.Contract.PriceOracleProxyTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_PriceOracleProxy *PriceOracleProxyCallerRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _PriceOracleProxy.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_PriceOracleProxy *PriceOracleProxyTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _PriceOracleProxy.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_PriceOracleProxy *PriceOracleProxyTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _PriceOracleProxy.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetPrice is a free data retrieval call binding the contract method 0x41976e09.
+//
+// Solidity: function getPrice(address _token) view returns(uint256)
+func (_PriceOracleProxy *PriceOracleProxyCaller) GetPrice(opts *bind.CallOpts, _token common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _PriceOracleProxy.contract.Call(opts, out, "getPrice", _token)
+	return *ret0, err
+}
+
+// GetPrice is a free data retrieval call binding the contract method 0x41976e09.
+//
+// Solidity: function getPrice(address _token) view returns(uint256)
+func (_PriceOracleProxy *PriceOracleProxySession) GetPrice(_token common.Address) (*big.Int, error) {
+	return _PriceOracleProxy.Contract.GetPrice(&_PriceOracleProxy.CallOpts, _token)
+}
+
+// GetPrice is a free data retrieval call binding the contract method 0x41976e09.
+//
+// Solidity: function getPrice(address _token) view returns(uint256)
+func (_PriceOracleProxy *PriceOracleProxyCallerSession) GetPrice(_token common.Address) (*big.Int, error) {
+	return _PriceOracleProxy.Contract.GetPrice(&_PriceOracleProxy.CallOpts, _token)
+}
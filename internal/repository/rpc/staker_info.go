@@ -0,0 +1,41 @@
+/*
+Package rpc implements bridge to Lachesis full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Lachesis node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Lachesis RPC interface for a remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Lachesis RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Lachesis RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+//go:generate abigen --abi ./contracts/st_info.abi --pkg rpc --type StakerInfoContract --out ./smc_st_info.go
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"math/big"
+)
+
+// StakerInfoUrl extracts the URL of the extended staker information
+// configuration from the staker information contract.
+func (ftm *FtmBridge) StakerInfoUrl(id hexutil.Uint64) (string, error) {
+	// instantiate the contract
+	contract, err := NewStakerInfoContract(ftm.stiContract, ftm.eth)
+	if err != nil {
+		ftm.log.Criticalf("failed to instantiate staker info contract: %v", err)
+		return "", err
+	}
+
+	// get the configuration URL
+	url, err := contract.GetInfo(nil, big.NewInt(int64(id)))
+	if err != nil {
+		ftm.log.Errorf("failed to get the staker #%d info url: %v", uint64(id), err)
+		return "", err
+	}
+
+	return url, nil
+}
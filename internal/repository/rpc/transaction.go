@@ -14,9 +14,12 @@ We strongly discourage opening Lachesis RPC interface for unrestricted Internet
 package rpc
 
 import (
+	"context"
 	"fantom-api-graphql/internal/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	eth "github.com/ethereum/go-ethereum/core/types"
+	ftm "github.com/ethereum/go-ethereum/rpc"
 )
 
 // Transaction returns information about a blockchain transaction by hash.
@@ -63,6 +66,62 @@ func (ftm *FtmBridge) Transaction(hash *types.Hash) (*types.Transaction, error)
 	return &trx, nil
 }
 
+// TransactionLogs returns the event logs emitted by the given processed transaction.
+func (ftm *FtmBridge) TransactionLogs(hash *types.Hash) ([]*eth.Log, error) {
+	// get the transaction receipt with the logs
+	rec, err := ftm.eth.TransactionReceipt(context.Background(), common.Hash(*hash))
+	if err != nil {
+		ftm.log.Errorf("can not get receipt of transaction %s; %s", hash.String(), err.Error())
+		return nil, err
+	}
+	return rec.Logs, nil
+}
+
+// TransactionStatus returns the status of the given processed transactions
+// loaded from their receipts in a single batch. The status is nil
+// for transactions without a receipt.
+func (ftm *FtmBridge) TransactionStatus(hashes []types.Hash) ([]*hexutil.Uint64, error) {
+	// keep track of the operation
+	ftm.log.Debugf("loading status of %d transactions", len(hashes))
+
+	// prep the batch
+	batch, recs := receiptStatusBatch(hashes)
+
+	// call for the receipts
+	if err := ftm.rpc.BatchCall(batch); err != nil {
+		ftm.log.Errorf("can not get transaction receipts; %s", err.Error())
+		return nil, err
+	}
+
+	// collect the status
+	status := make([]*hexutil.Uint64, len(hashes))
+	for i := range batch {
+		if batch[i].Error != nil {
+			ftm.log.Errorf("can not get receipt for transaction %s; %s", hashes[i].String(), batch[i].Error.Error())
+			continue
+		}
+		if recs[i] != nil {
+			status[i] = &recs[i].Status
+		}
+	}
+	return status, nil
+}
+
+// receiptStatus represents the part of the transaction receipt with the status.
+type receiptStatus struct {
+	Status hexutil.Uint64 `json:"status"`
+}
+
+// receiptStatusBatch prepares the batch of receipt calls for the given transactions.
+func receiptStatusBatch(hashes []types.Hash) ([]ftm.BatchElem, []*receiptStatus) {
+	recs := make([]*receiptStatus, len(hashes))
+	batch := make([]ftm.BatchElem, len(hashes))
+	for i := range hashes {
+		batch[i] = ftm.BatchElem{Method: "ftm_getTransactionReceipt", Args: []interface{}{hashes[i]}, Result: &recs[i]}
+	}
+	return batch, recs
+}
+
 // SendTransaction sends raw signed and RLP encoded transaction to the block chain.
 func (ftm *FtmBridge) SendTransaction(tx hexutil.Bytes) (*types.Hash, error) {
 	// keep track of the operation
@@ -0,0 +1,433 @@
+/*
+Package rpc implements bridge to Lachesis full node API interface.
+
+We recommend using local IPC for fast and the most efficient inter-process communication between the API server
+and an Opera/Lachesis node. Any remote RPC connection will work, but the performance may be significantly degraded
+by extra networking overhead of remote RPC calls.
+
+You should also consider security implications of opening Lachesis RPC interface for a remote access.
+If you considering it as your deployment strategy, you should establish encrypted channel between the API server
+and Lachesis RPC interface with connection limited to specified endpoints.
+
+We strongly discourage opening Lachesis RPC interface for unrestricted Internet access.
+*/
+package rpc
+
+//go:generate abigen --abi ./contracts/uniswap-factory.abi --pkg rpc --type UniswapFactory --out ./smc_uniswap_factory.go
+//go:generate abigen --abi ./contracts/uniswap-pair.abi --pkg rpc --type UniswapPair --out ./smc_uniswap_pair.go
+//go:generate abigen --abi ./contracts/uniswap-router.abi --pkg rpc --type UniswapRouter --out ./smc_uniswap_router.go
+
+import (
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eth "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"math/big"
+)
+
+var (
+	// uniswapSwapEventTopic represents the topic of the pair Swap event.
+	uniswapSwapEventTopic = crypto.Keccak256Hash([]byte("Swap(address,uint256,uint256,uint256,uint256,address)"))
+
+	// uniswapMintEventTopic represents the topic of the pair Mint event.
+	uniswapMintEventTopic = crypto.Keccak256Hash([]byte("Mint(address,uint256,uint256)"))
+
+	// uniswapBurnEventTopic represents the topic of the pair Burn event.
+	uniswapBurnEventTopic = crypto.Keccak256Hash([]byte("Burn(address,uint256,uint256,address)"))
+
+	// uniswapSyncEventTopic represents the topic of the pair Sync event.
+	uniswapSyncEventTopic = crypto.Keccak256Hash([]byte("Sync(uint112,uint112)"))
+
+	// uniswapPairCreatedEventTopic represents the topic of the factory PairCreated event.
+	uniswapPairCreatedEventTopic = crypto.Keccak256Hash([]byte("PairCreated(address,address,address,uint256)"))
+)
+
+// uniswapConfig represents the configuration of the Uniswap DEX contracts.
+type uniswapConfig struct {
+	// core is the address of the Uniswap factory contract
+	core common.Address
+
+	// router is the address of the Uniswap router contract
+	router common.Address
+}
+
+// uniswapFactory returns an instance of the Uniswap factory contract.
+func (ftm *FtmBridge) uniswapFactory() (*UniswapFactory, error) {
+	// do we know the factory?
+	if ftm.uniswapCfg.core == (common.Address{}) {
+		return nil, fmt.Errorf("uniswap core contract not configured")
+	}
+
+	// instantiate the contract
+	contract, err := NewUniswapFactory(ftm.uniswapCfg.core, ftm.eth)
+	if err != nil {
+		ftm.log.Criticalf("failed to instantiate uniswap factory: %v", err)
+		return nil, err
+	}
+
+	return contract, nil
+}
+
+// uniswapRouter returns an instance of the Uniswap router contract.
+func (ftm *FtmBridge) uniswapRouter() (*UniswapRouter, error) {
+	// do we know the router?
+	if ftm.uniswapCfg.router == (common.Address{}) {
+		return nil, fmt.Errorf("uniswap router contract not configured")
+	}
+
+	// instantiate the contract
+	contract, err := NewUniswapRouter(ftm.uniswapCfg.router, ftm.eth)
+	if err != nil {
+		ftm.log.Criticalf("failed to instantiate uniswap router: %v", err)
+		return nil, err
+	}
+
+	return contract, nil
+}
+
+// UniswapPairsCount loads the number of pairs created by the Uniswap factory.
+// Zero is returned if the Uniswap DEX is not configured.
+func (ftm *FtmBridge) UniswapPairsCount() (uint64, error) {
+	// no factory, no pairs
+	if ftm.uniswapCfg.core == (common.Address{}) {
+		return 0, nil
+	}
+
+	// get the factory
+	contract, err := ftm.uniswapFactory()
+	if err != nil {
+		return 0, err
+	}
+
+	// get the number of pairs
+	count, err := contract.AllPairsLength(nil)
+	if err != nil {
+		ftm.log.Errorf("uniswap pairs count not available; %v", err)
+		return 0, err
+	}
+
+	return count.Uint64(), nil
+}
+
+// UniswapPairAt loads the state of the pair of the given index
+// in the list of pairs created by the Uniswap factory.
+func (ftm *FtmBridge) UniswapPairAt(index uint64) (*types.UniswapPair, error) {
+	// get the factory
+	contract, err := ftm.uniswapFactory()
+	if err != nil {
+		return nil, err
+	}
+
+	// get the pair address
+	addr, err := contract.AllPairs(nil, new(big.Int).SetUint64(index))
+	if err != nil {
+		ftm.log.Errorf("uniswap pair #%d not available; %v", index, err)
+		return nil, err
+	}
+
+	// load the pair state
+	pair, err := ftm.UniswapPair(&addr)
+	if err != nil {
+		return nil, err
+	}
+
+	pair.Index = index
+	return pair, nil
+}
+
+// UniswapPairsCreated extracts the list of new pairs created by the Uniswap factory
+// from the given transaction event logs. The reserves and the supply of a new pair are empty.
+func (ftm *FtmBridge) UniswapPairsCreated(logs []*eth.Log) ([]types.UniswapPair, error) {
+	// no factory, no pairs
+	if ftm.uniswapCfg.core == (common.Address{}) || !hasLogTopic(logs, uniswapPairCreatedEventTopic) {
+		return nil, nil
+	}
+
+	// the factory filterer decodes the events
+	filter, err := NewUniswapFactoryFilterer(ftm.uniswapCfg.core, ftm.eth)
+	if err != nil {
+		ftm.log.Criticalf("failed to instantiate uniswap factory filterer: %v", err)
+		return nil, err
+	}
+
+	// collect the pairs
+	list := make([]types.UniswapPair, 0)
+	for _, l := range logs {
+		// is this the factory event?
+		if l.Address != ftm.uniswapCfg.core || 0 == len(l.Topics) || l.Topics[0] != uniswapPairCreatedEventTopic {
+			continue
+		}
+
+		// decode the event
+		evt, err := filter.ParsePairCreated(*l)
+		if err != nil {
+			ftm.log.Errorf("can not decode uniswap pair created event; %v", err)
+			return nil, err
+		}
+
+		// the last argument is the number of pairs including the new one
+		list = append(list, types.UniswapPair{
+			Address: evt.Pair,
+			Index:   evt.Arg3.Uint64() - 1,
+			Token0:  evt.Token0,
+			Token1:  evt.Token1,
+		})
+	}
+
+	return list, nil
+}
+
+// UniswapPair loads the state of the given Uniswap pair.
+func (ftm *FtmBridge) UniswapPair(addr *common.Address) (*types.UniswapPair, error) {
+	// instantiate the pair contract
+	contract, err := NewUniswapPair(*addr, ftm.eth)
+	if err != nil {
+		ftm.log.Criticalf("failed to instantiate uniswap pair: %v", err)
+		return nil, err
+	}
+
+	// prep the result
+	pair := types.UniswapPair{Address: *addr}
+
+	// get the tokens
+	pair.Token0, err = contract.Token0(nil)
+	if err != nil {
+		ftm.log.Errorf("first token of uniswap pair %s not available; %v", addr.String(), err)
+		return nil, err
+	}
+
+	pair.Token1, err = contract.Token1(nil)
+	if err != nil {
+		ftm.log.Errorf("second token of uniswap pair %s not available; %v", addr.String(), err)
+		return nil, err
+	}
+
+	// get the reserves
+	res, err := contract.GetReserves(nil)
+	if err != nil {
+		ftm.log.Errorf("reserves of uniswap pair %s not available; %v", addr.String(), err)
+		return nil, err
+	}
+	pair.Reserve0 = hexutil.Big(*res.Reserve0)
+	pair.Reserve1 = hexutil.Big(*res.Reserve1)
+	pair.ReservesTimeStamp = hexutil.Uint64(res.BlockTimestampLast)
+
+	// get the total supply of liquidity tokens
+	val, err := contract.TotalSupply(nil)
+	if err != nil {
+		ftm.log.Errorf("total supply of uniswap pair %s not available; %v", addr.String(), err)
+		return nil, err
+	}
+	pair.TotalSupply = hexutil.Big(*val)
+
+	return &pair, nil
+}
+
+// UniswapTotalSupply loads the total supply of liquidity tokens of the given pair.
+func (ftm *FtmBridge) UniswapTotalSupply(pair *common.Address) (hexutil.Big, error) {
+	// instantiate the pair contract
+	contract, err := NewUniswapPair(*pair, ftm.eth)
+	if err != nil {
+		ftm.log.Criticalf("failed to instantiate uniswap pair: %v", err)
+		return hexutil.Big{}, err
+	}
+
+	// get the total supply
+	val, err := contract.TotalSupply(nil)
+	if err != nil {
+		ftm.log.Errorf("total supply of uniswap pair %s not available; %v", pair.String(), err)
+		return hexutil.Big{}, err
+	}
+
+	return hexutil.Big(*val), nil
+}
+
+// UniswapLiquidity loads the amount of liquidity tokens of the given pair
+// owned by the given account.
+func (ftm *FtmBridge) UniswapLiquidity(pair *common.Address, owner *common.Address) (hexutil.Big, error) {
+	// instantiate the pair contract
+	contract, err := NewUniswapPair(*pair, ftm.eth)
+	if err != nil {
+		ftm.log.Criticalf("failed to instantiate uniswap pair: %v", err)
+		return hexutil.Big{}, err
+	}
+
+	// get the balance
+	val, err := contract.BalanceOf(nil, *owner)
+	if err != nil {
+		ftm.log.Errorf("liquidity of %s in uniswap pair %s not available; %v", owner.String(), pair.String(), err)
+		return hexutil.Big{}, err
+	}
+
+	return hexutil.Big(*val), nil
+}
+
+// UniswapAmountsOut calculates the amounts of tokens received for the given
+// input amount swapped along the given path of tokens.
+func (ftm *FtmBridge) UniswapAmountsOut(amountIn *big.Int, path []common.Address) ([]hexutil.Big, error) {
+	// we need at least two tokens to swap
+	if len(path) < 2 {
+		return nil, fmt.Errorf("swap path must contain at least two tokens")
+	}
+
+	// get the router
+	contract, err := ftm.uniswapRouter()
+	if err != nil {
+		return nil, err
+	}
+
+	// get the amounts
+	amounts, err := contract.GetAmountsOut(nil, amountIn, path)
+	if err != nil {
+		ftm.log.Errorf("uniswap amounts out not available; %v", err)
+		return nil, err
+	}
+
+	// convert the result
+	list := make([]hexutil.Big, len(amounts))
+	for i, val := range amounts {
+		list[i] = hexutil.Big(*val)
+	}
+
+	return list, nil
+}
+
+// UniswapEventSources returns the addresses of contracts emitting the events
+// of Uniswap pairs in the given transaction event logs. The events are matched by topic
+// only, the caller is responsible for checking the sources are known pairs.
+func (ftm *FtmBridge) UniswapEventSources(logs []*eth.Log) []common.Address {
+	list := make([]common.Address, 0)
+	seen := make(map[common.Address]bool)
+	for _, l := range logs {
+		// is this an event of a pair?
+		if 0 == len(l.Topics) || seen[l.Address] {
+			continue
+		}
+
+		switch l.Topics[0] {
+		case uniswapSwapEventTopic, uniswapMintEventTopic, uniswapBurnEventTopic, uniswapSyncEventTopic:
+			seen[l.Address] = true
+			list = append(list, l.Address)
+		}
+	}
+	return list
+}
+
+// UniswapActions extracts the list of swaps and liquidity changes of the given
+// Uniswap pairs from the transaction event logs. The reserves synced by the transaction
+// are returned as well, the last sync of each pair is the state after the transaction.
+func (ftm *FtmBridge) UniswapActions(trx *types.Transaction, logs []*eth.Log, pairs map[common.Address]bool) ([]types.UniswapAction, []types.UniswapSync, error) {
+	// the pair filterer is not bound to any specific pair
+	filter, err := NewUniswapPairFilterer(common.Address{}, ftm.eth)
+	if err != nil {
+		ftm.log.Criticalf("failed to instantiate uniswap pair filterer: %v", err)
+		return nil, nil, err
+	}
+
+	// collect the actions; each action is preceded by the reserves sync
+	list := make([]types.UniswapAction, 0)
+	syncs := make([]types.UniswapSync, 0)
+	reserves := make(map[common.Address]*UniswapPairSync)
+	for _, l := range logs {
+		// is this a log of a known pair?
+		if !pairs[l.Address] || 0 == len(l.Topics) {
+			continue
+		}
+
+		// keep the latest reserves of the pair
+		if l.Topics[0] == uniswapSyncEventTopic {
+			evt, err := filter.ParseSync(*l)
+			if err != nil {
+				ftm.log.Errorf("can not decode uniswap sync event of %s; %v", trx.Hash.String(), err)
+				return nil, nil, err
+			}
+			reserves[l.Address] = evt
+			syncs = append(syncs, types.UniswapSync{
+				Pair:     l.Address,
+				Reserve0: hexutil.Big(*evt.Reserve0),
+				Reserve1: hexutil.Big(*evt.Reserve1),
+			})
+			continue
+		}
+
+		// decode the action
+		act, err := uniswapAction(filter, l)
+		if err != nil {
+			ftm.log.Errorf("can not decode uniswap event of %s; %v", trx.Hash.String(), err)
+			return nil, nil, err
+		}
+
+		// not an action we are interested in
+		if act == nil {
+			continue
+		}
+
+		// add the transaction context
+		act.Account = trx.From
+		act.TransactionHash = trx.Hash
+		act.LogIndex = hexutil.Uint64(l.Index)
+
+		// add the reserves after the action
+		if rs, ok := reserves[l.Address]; ok {
+			act.Reserve0 = hexutil.Big(*rs.Reserve0)
+			act.Reserve1 = hexutil.Big(*rs.Reserve1)
+		}
+
+		list = append(list, *act)
+	}
+
+	return list, syncs, nil
+}
+
+// uniswapAction decodes the given pair event log into an action.
+// Nil is returned for events not representing a swap, or a liquidity change.
+func uniswapAction(filter *UniswapPairFilterer, l *eth.Log) (*types.UniswapAction, error) {
+	switch l.Topics[0] {
+	case uniswapSwapEventTopic:
+		evt, err := filter.ParseSwap(*l)
+		if err != nil {
+			return nil, err
+		}
+		return &types.UniswapAction{
+			Type:       types.UniswapActionSwap,
+			Pair:       l.Address,
+			Sender:     evt.Sender,
+			Recipient:  &evt.To,
+			Amount0In:  hexutil.Big(*evt.Amount0In),
+			Amount1In:  hexutil.Big(*evt.Amount1In),
+			Amount0Out: hexutil.Big(*evt.Amount0Out),
+			Amount1Out: hexutil.Big(*evt.Amount1Out),
+		}, nil
+
+	case uniswapMintEventTopic:
+		evt, err := filter.ParseMint(*l)
+		if err != nil {
+			return nil, err
+		}
+		return &types.UniswapAction{
+			Type:      types.UniswapActionMint,
+			Pair:      l.Address,
+			Sender:    evt.Sender,
+			Amount0In: hexutil.Big(*evt.Amount0),
+			Amount1In: hexutil.Big(*evt.Amount1),
+		}, nil
+
+	case uniswapBurnEventTopic:
+		evt, err := filter.ParseBurn(*l)
+		if err != nil {
+			return nil, err
+		}
+		return &types.UniswapAction{
+			Type:       types.UniswapActionBurn,
+			Pair:       l.Address,
+			Sender:     evt.Sender,
+			Recipient:  &evt.To,
+			Amount0Out: hexutil.Big(*evt.Amount0),
+			Amount1Out: hexutil.Big(*evt.Amount1),
+		}, nil
+	}
+
+	return nil, nil
+}
@@ -89,6 +89,11 @@ func (sys *scanner) scan(lnb uint64) {
 				return
 			}
 
+			// keep the block header in the persistent storage
+			if err = sys.repo.AddBlock(block); err != nil {
+				sys.log.Errorf("can not store block #%d; %s", uint64(current), err.Error())
+			}
+
 			// reset the current block tx index and advance to the next block
 			index = 0
 			current += 1
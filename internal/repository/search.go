@@ -0,0 +1,164 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"strconv"
+	"strings"
+)
+
+const (
+	// searchMinTextLength is the minimal length of a text searched by name, or symbol.
+	searchMinTextLength = 2
+
+	// searchMaxTextLength is the maximal length of a searched text.
+	searchMaxTextLength = 128
+
+	// searchMaxResults is the maximal number of results of a single type.
+	searchMaxResults = 10
+
+	// searchHashLength is the length of a hex encoded hash with the 0x prefix.
+	searchHashLength = 66
+)
+
+// Search finds blocks, transactions, accounts, contracts, tokens and stakers
+// matching the given text. Block numbers, hashes and addresses are matched exactly,
+// contracts and stakers are searched by name and tokens by symbol.
+func (p *proxy) Search(text string) (*types.SearchResults, error) {
+	// validate the text
+	text = strings.TrimSpace(text)
+	if 0 == len(text) {
+		return nil, fmt.Errorf("nothing to search for")
+	}
+	if len(text) > searchMaxTextLength {
+		return nil, fmt.Errorf("search text is too long")
+	}
+
+	res := types.SearchResults{}
+
+	// the text may be a block number
+	if num, err := strconv.ParseUint(text, 10, 64); err == nil {
+		p.searchBlockByNumber(hexutil.Uint64(num), &res)
+	}
+
+	// the text may be a transaction, or block hash
+	if len(text) == searchHashLength && strings.HasPrefix(text, "0x") {
+		p.searchHash(text, &res)
+	}
+
+	// the text may be an address
+	if common.IsHexAddress(text) {
+		p.searchAddress(common.HexToAddress(text), &res)
+	}
+
+	// search by name and symbol
+	if len(text) >= searchMinTextLength {
+		if err := p.searchNames(text, &res); err != nil {
+			return nil, err
+		}
+	}
+
+	return &res, nil
+}
+
+// searchBlockByNumber adds the block of the given number to the search results, if it exists.
+func (p *proxy) searchBlockByNumber(num hexutil.Uint64, res *types.SearchResults) {
+	blk, err := p.BlockByNumber(&num)
+	if err != nil || blk == nil {
+		p.log.Debugf("block #%d not found", uint64(num))
+		return
+	}
+	res.Blocks = append(res.Blocks, blk)
+}
+
+// searchHash adds the transaction, or the block of the given hash to the search results.
+func (p *proxy) searchHash(text string, res *types.SearchResults) {
+	hash := types.HexToHash(text)
+
+	// try the transaction first, it's the most common case
+	if trx, err := p.Transaction(&hash); err == nil && trx != nil {
+		res.Transactions = append(res.Transactions, trx)
+		return
+	}
+
+	// try the block
+	if blk, err := p.BlockByHash(&hash); err == nil && blk != nil {
+		res.Blocks = append(res.Blocks, blk)
+		return
+	}
+
+	p.log.Debugf("hash %s not found", text)
+}
+
+// searchAddress adds the account and the contract of the given address to the search results.
+func (p *proxy) searchAddress(addr common.Address, res *types.SearchResults) {
+	// any valid address is an account
+	if acc, err := p.Account(&addr); err == nil {
+		res.Accounts = append(res.Accounts, acc)
+	}
+
+	// the address may belong to a contract
+	if sc, err := p.Contract(&addr); err == nil && sc != nil {
+		res.Contracts = append(res.Contracts, sc)
+	}
+}
+
+// searchNames adds contracts, tokens and stakers matching the text to the search results.
+func (p *proxy) searchNames(text string, res *types.SearchResults) error {
+	// contracts by name
+	cl, err := p.db.SearchContracts(text, searchMaxResults)
+	if err != nil {
+		return err
+	}
+	for _, sc := range cl {
+		if !containsContract(res.Contracts, sc) {
+			res.Contracts = append(res.Contracts, sc)
+		}
+	}
+
+	// tokens by symbol
+	tl, err := p.DefiTokens()
+	if err != nil {
+		p.log.Errorf("can not search tokens; %s", err.Error())
+	}
+	for _, tk := range tl {
+		if strings.HasPrefix(strings.ToLower(tk.Symbol), strings.ToLower(text)) && len(res.Tokens) < searchMaxResults {
+			res.Tokens = append(res.Tokens, tk)
+		}
+	}
+
+	// stakers by name
+	ids, err := p.db.SearchStakers(text, searchMaxResults)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		st, err := p.Staker(id)
+		if err != nil || st.Id == 0 {
+			continue
+		}
+		res.Stakers = append(res.Stakers, st)
+	}
+
+	return nil
+}
+
+// containsContract checks if the list of contracts already contains the given contract.
+func containsContract(list []*types.Contract, sc *types.Contract) bool {
+	for _, c := range list {
+		if c.Address == sc.Address {
+			return true
+		}
+	}
+	return false
+}
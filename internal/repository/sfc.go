@@ -23,11 +23,27 @@ func (p *proxy) CurrentEpoch() (hexutil.Uint64, error) {
 	return p.rpc.CurrentEpoch()
 }
 
-// Epoch returns the id of the current epoch.
+// Epoch returns the details of the epoch of the given id.
+// Sealed epochs are loaded from the persistent storage if available.
 func (p *proxy) Epoch(id hexutil.Uint64) (types.Epoch, error) {
+	// try the persistent storage first
+	ep, err := p.db.Epoch(id)
+	if err != nil {
+		p.log.Errorf("can not load epoch #%d from database; %s", uint64(id), err.Error())
+	}
+	if ep != nil {
+		return *ep, nil
+	}
+
 	return p.rpc.Epoch(id)
 }
 
+// EpochAt returns the details of the epoch of the given id at the given block.
+// The persistent storage keeps the sealed state only, so the contract is always used.
+func (p *proxy) EpochAt(id hexutil.Uint64, block hexutil.Uint64) (types.Epoch, error) {
+	return p.rpc.EpochAt(id, &block)
+}
+
 // LastStakerId returns the last staker id in Opera blockchain.
 func (p *proxy) LastStakerId() (hexutil.Uint64, error) {
 	return p.rpc.LastStakerId()
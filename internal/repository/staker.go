@@ -0,0 +1,69 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Staker extracts a staker information by numeric id.
+func (p *proxy) Staker(id hexutil.Uint64) (*types.Staker, error) {
+	return p.rpc.Staker(id)
+}
+
+// StakerByAddress extracts a staker information by address.
+func (p *proxy) StakerByAddress(addr common.Address) (*types.Staker, error) {
+	return p.rpc.StakerByAddress(addr)
+}
+
+// StakerAt extracts a staker information by numeric id at the given block.
+func (p *proxy) StakerAt(id hexutil.Uint64, block hexutil.Uint64) (*types.Staker, error) {
+	return p.rpc.StakerAt(id, block)
+}
+
+// StakerByAddressAt extracts a staker information by address at the given block.
+func (p *proxy) StakerByAddressAt(addr common.Address, block hexutil.Uint64) (*types.Staker, error) {
+	return p.rpc.StakerByAddressAt(addr, block)
+}
+
+// Stakers extracts list of all the stakers known to the SFC contract.
+func (p *proxy) Stakers() ([]types.Staker, error) {
+	// get the last staker id
+	last, err := p.rpc.LastStakerId()
+	if err != nil {
+		p.log.Errorf("can not get the last staker id; %s", err.Error())
+		return nil, err
+	}
+
+	// load stakers one by one
+	list := make([]types.Staker, 0, int(last))
+	for id := hexutil.Uint64(1); id <= last; id++ {
+		st, err := p.rpc.Staker(id)
+		if err != nil {
+			p.log.Errorf("can not load staker #%d; %s", uint64(id), err.Error())
+			return nil, err
+		}
+
+		// skip removed stakers
+		if st.Id == 0 {
+			continue
+		}
+		list = append(list, *st)
+	}
+
+	return list, nil
+}
+
+// Delegations extracts the list of delegations of the given account
+// at the given block. The latest state is used for nil block.
+func (p *proxy) Delegations(addr common.Address, block *hexutil.Uint64) ([]types.Delegation, error) {
+	return p.rpc.Delegations(&addr, block)
+}
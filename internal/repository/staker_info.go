@@ -0,0 +1,329 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"context"
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+const (
+	// stakerInfoMaxSize is the max size of the staker info JSON document we accept.
+	stakerInfoMaxSize = 4096
+
+	// stakerInfoMaxNameLength is the max accepted length of the staker name.
+	stakerInfoMaxNameLength = 64
+
+	// stakerInfoMaxUrlLength is the max accepted length of an URL in the staker info.
+	stakerInfoMaxUrlLength = 256
+
+	// stakerInfoRefreshInterval is the minimal time between two requested
+	// refreshes of the info of the same staker.
+	stakerInfoRefreshInterval = 10 * time.Minute
+)
+
+// stakerInfoBlockedNetworks represents the address ranges the staker info
+// documents can not be downloaded from; these are not reachable publicly
+// and would allow stakers to probe our internal network.
+var stakerInfoBlockedNetworks = parseNetworks(
+	"0.0.0.0/8", "10.0.0.0/8", "100.64.0.0/10", "127.0.0.0/8", "169.254.0.0/16",
+	"172.16.0.0/12", "192.168.0.0/16", "198.18.0.0/15", "224.0.0.0/4", "240.0.0.0/4",
+	"::/128", "::1/128", "fc00::/7", "fe80::/10", "ff00::/8",
+)
+
+// stakerInfoClient is the HTTP client used to download the staker info documents.
+// It connects to public addresses only and does not follow redirects.
+var stakerInfoClient = http.Client{
+	Timeout: 5 * time.Second,
+	Transport: &http.Transport{
+		Proxy:                 nil,
+		DialContext:           dialPublicOnly,
+		TLSHandshakeTimeout:   5 * time.Second,
+		ResponseHeaderTimeout: 5 * time.Second,
+	},
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		return fmt.Errorf("staker info redirect to %s refused", req.URL.Host)
+	},
+}
+
+// stakerInfoRefreshLimiter keeps track of the requested staker info
+// refreshes so a single staker can not trigger downloads repeatedly.
+type stakerInfoRefreshLimiter struct {
+	mu   sync.Mutex
+	last map[hexutil.Uint64]time.Time
+}
+
+// newStakerInfoRefreshLimiter creates a new empty refresh limiter.
+func newStakerInfoRefreshLimiter() *stakerInfoRefreshLimiter {
+	return &stakerInfoRefreshLimiter{last: make(map[hexutil.Uint64]time.Time)}
+}
+
+// allow checks if the info of the given staker can be refreshed now
+// and marks the refresh if so.
+func (rl *stakerInfoRefreshLimiter) allow(id hexutil.Uint64) bool {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	// was the staker refreshed recently?
+	now := time.Now()
+	if last, ok := rl.last[id]; ok && now.Sub(last) < stakerInfoRefreshInterval {
+		return false
+	}
+
+	// forget old refreshes so the map does not grow
+	for sid, last := range rl.last {
+		if now.Sub(last) >= stakerInfoRefreshInterval {
+			delete(rl.last, sid)
+		}
+	}
+
+	rl.last[id] = now
+	return true
+}
+
+// StakerInfo resolves the extended staker information, if available.
+// The information is served from the in-memory cache, or from the database,
+// it's never downloaded here; the staker sampler keeps it up to date.
+func (p *proxy) StakerInfo(id hexutil.Uint64) (*types.StakerInfo, error) {
+	// try the cache first
+	if sti := p.cache.PullStakerInfo(id); sti != nil {
+		return stakerInfoOrNil(sti), nil
+	}
+
+	// try the last known info stored in the database
+	sti, err := p.db.StakerInfo(id)
+	if err != nil || sti == nil {
+		return nil, err
+	}
+	return stakerInfoOrNil(sti), nil
+}
+
+// LoadStakerInfo makes sure the extended staker information is loaded
+// and fresh in the in-memory cache, downloading it if needed.
+func (p *proxy) LoadStakerInfo(id hexutil.Uint64) error {
+	// is the cached info still fresh?
+	if sti := p.cache.PullStakerInfo(id); sti != nil {
+		return nil
+	}
+
+	_, err := p.loadStakerInfo(id)
+	return err
+}
+
+// RefreshStakerInfo drops the cached extended staker information
+// and loads it again from the staker info contract.
+// The refresh of the same staker is allowed only once in a while.
+func (p *proxy) RefreshStakerInfo(id hexutil.Uint64) (*types.StakerInfo, error) {
+	// check the refresh rate
+	if !p.stiLimiter.allow(id) {
+		return nil, fmt.Errorf("staker #%d info was refreshed recently, try again later", uint64(id))
+	}
+
+	// remove the current version from cache
+	p.cache.EvictStakerInfo(id)
+
+	// load the info again
+	sti, err := p.loadStakerInfo(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return stakerInfoOrNil(sti), nil
+}
+
+// loadStakerInfo loads the extended staker information from the URL
+// registered in the staker info contract and stores it in the cache.
+// Stakers without valid information get an empty record so we don't
+// need to ask the contract again on each request.
+func (p *proxy) loadStakerInfo(id hexutil.Uint64) (*types.StakerInfo, error) {
+	// get the configuration URL
+	addr, err := p.rpc.StakerInfoUrl(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// download and validate the info
+	sti := new(types.StakerInfo)
+	if 0 < len(addr) {
+		sti, err = downloadStakerInfo(addr)
+		if err != nil {
+			p.log.Errorf("staker #%d info at %s not valid; %s", uint64(id), addr, err.Error())
+			sti = new(types.StakerInfo)
+		}
+	}
+
+	// store the info in cache
+	if err := p.cache.PushStakerInfo(id, sti); err != nil {
+		p.log.Error(err)
+	}
+
+	// keep the info in the database so the staker can be searched by name
+	if err := p.db.UpdateStakerInfo(id, sti); err != nil {
+		p.log.Errorf("can not store staker #%d info; %s", uint64(id), err.Error())
+	}
+
+	p.log.Debugf("staker #%d info loaded", uint64(id))
+	return sti, nil
+}
+
+// downloadStakerInfo downloads the staker info JSON document and validates it.
+func downloadStakerInfo(addr string) (*types.StakerInfo, error) {
+	// the info URL must be valid
+	if !isValidStakerInfoUrl(&addr) {
+		return nil, fmt.Errorf("invalid staker info url")
+	}
+
+	// download the document
+	resp, err := stakerInfoClient.Get(addr)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	// check the response
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("staker info download failed with status %d", resp.StatusCode)
+	}
+
+	// read the data, but not more than needed
+	data, err := ioutil.ReadAll(http.MaxBytesReader(nil, resp.Body, stakerInfoMaxSize))
+	if err != nil {
+		return nil, err
+	}
+
+	// decode the info
+	sti, err := types.UnmarshalStakerInfo(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// validate the content
+	if err := validateStakerInfo(sti); err != nil {
+		return nil, err
+	}
+
+	return sti, nil
+}
+
+// validateStakerInfo checks the content of the staker info document.
+func validateStakerInfo(sti *types.StakerInfo) error {
+	// check the name length
+	if sti.Name != nil && stakerInfoMaxNameLength < len(*sti.Name) {
+		return fmt.Errorf("staker name too long")
+	}
+
+	// check the logo URL
+	if sti.LogoUrl != nil && !isValidStakerInfoUrl(sti.LogoUrl) {
+		return fmt.Errorf("invalid logo url")
+	}
+
+	// check the website URL
+	if sti.Website != nil && !isValidStakerInfoUrl(sti.Website) {
+		return fmt.Errorf("invalid website url")
+	}
+
+	// check the contact URL
+	if sti.Contact != nil && !isValidStakerInfoUrl(sti.Contact) {
+		return fmt.Errorf("invalid contact url")
+	}
+
+	return nil
+}
+
+// isValidStakerInfoUrl checks if the given URL is an acceptable https address.
+func isValidStakerInfoUrl(addr *string) bool {
+	// check the length
+	if stakerInfoMaxUrlLength < len(*addr) {
+		return false
+	}
+
+	// parse the URL
+	u, err := url.ParseRequestURI(*addr)
+	if err != nil {
+		return false
+	}
+
+	return u.Scheme == "https" && 0 < len(u.Host)
+}
+
+// dialPublicOnly resolves the address and connects to it only if all the resolved
+// IP addresses are public. The connection is made to the verified IP so the name
+// can not be re-bound to a different address between the check and the dial.
+func dialPublicOnly(ctx context.Context, network string, addr string) (net.Conn, error) {
+	// split the host and port
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	// resolve the host
+	ips, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	if 0 == len(ips) {
+		return nil, fmt.Errorf("staker info host %s not resolved", host)
+	}
+
+	// all the addresses must be public
+	for _, ip := range ips {
+		if !isPublicIP(ip.IP) {
+			return nil, fmt.Errorf("staker info host %s resolves to non-public address %s", host, ip.IP.String())
+		}
+	}
+
+	var dialer net.Dialer
+	return dialer.DialContext(ctx, network, net.JoinHostPort(ips[0].IP.String(), port))
+}
+
+// isPublicIP checks if the IP address is a public unicast address.
+func isPublicIP(ip net.IP) bool {
+	// IPv4 mapped IPv6 addresses are checked as IPv4
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+
+	for _, n := range stakerInfoBlockedNetworks {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseNetworks parses the list of CIDR network definitions.
+func parseNetworks(cidr ...string) []*net.IPNet {
+	list := make([]*net.IPNet, 0, len(cidr))
+	for _, c := range cidr {
+		_, n, err := net.ParseCIDR(c)
+		if err != nil {
+			panic(err)
+		}
+		list = append(list, n)
+	}
+	return list
+}
+
+// stakerInfoOrNil returns nil for an empty staker info record.
+func stakerInfoOrNil(sti *types.StakerInfo) *types.StakerInfo {
+	if sti.Name == nil && sti.LogoUrl == nil && sti.Website == nil && sti.Contact == nil {
+		return nil
+	}
+	return sti
+}
@@ -0,0 +1,172 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"time"
+)
+
+// stakerPerformanceMaxEpochs is the maximal number of epochs aggregated
+// into a single staker performance.
+const stakerPerformanceMaxEpochs = 1000
+
+// AddStakerSample records the current state of the staker in the given epoch.
+// Staker status changes are detected against the previous sample
+// and broadcast to the status change subscribers.
+func (p *proxy) AddStakerSample(st *types.Staker, epoch hexutil.Uint64) error {
+	// get the previous sample of the staker
+	prev, err := p.db.LastStakerSample(st.Id)
+	if err != nil {
+		return err
+	}
+
+	// make the new sample
+	sm := types.StakerSample{
+		StakerId:     st.Id,
+		Epoch:        epoch,
+		Time:         hexutil.Uint64(time.Now().UTC().Unix()),
+		Status:       st.Status,
+		IsActive:     st.IsActive,
+		IsOffline:    st.IsOffline,
+		IsCheater:    st.IsCheater,
+		WasOffline:   st.IsOffline,
+		MissedBlocks: st.MissedBlocks,
+		Downtime:     st.Downtime,
+	}
+
+	// keep the offline history of the epoch
+	if prev != nil && prev.Epoch == epoch {
+		sm.WasOffline = sm.WasOffline || prev.WasOffline
+		sm.OfflineIncidents = prev.OfflineIncidents
+		sm.EpochMissedBlocks = prev.EpochMissedBlocks
+		sm.EpochDowntime = prev.EpochDowntime
+		sm.ObservedTime = prev.ObservedTime
+	}
+
+	// add the offline counters progress since the previous observation
+	if prev != nil && prev.Time < sm.Time {
+		sm.EpochMissedBlocks += offlineCounterDelta(prev.MissedBlocks, sm.MissedBlocks)
+		sm.EpochDowntime += offlineCounterDelta(prev.Downtime, sm.Downtime)
+		sm.ObservedTime += sm.Time - prev.Time
+	}
+
+	// count a new offline incident
+	if sm.IsOffline && (prev == nil || !prev.IsOffline) {
+		sm.OfflineIncidents++
+	}
+
+	// store the sample
+	if err := p.db.AddStakerSample(&sm); err != nil {
+		return err
+	}
+
+	// notify the status change, if any
+	if prev != nil && isStakerStatusChanged(prev, &sm) {
+		// flag cheaters loudly
+		if sm.IsCheater && !prev.IsCheater {
+			p.log.Warningf("staker #%d flagged as cheater in epoch #%d", uint64(sm.StakerId), uint64(epoch))
+		}
+
+		p.notifyStakerStatus(&types.StakerStatusEvent{StakerSample: sm, PrevStatus: prev.Status})
+	}
+	return nil
+}
+
+// offlineCounterDelta calculates the progress of the staker offline counter
+// between two observations. The counter is reset when the staker gets back online,
+// so a lower value means a new offline period started after the reset.
+func offlineCounterDelta(prev hexutil.Uint64, cur hexutil.Uint64) hexutil.Uint64 {
+	if cur < prev {
+		return cur
+	}
+	return cur - prev
+}
+
+// isStakerStatusChanged checks if the status of the staker changed between samples.
+func isStakerStatusChanged(prev *types.StakerSample, sm *types.StakerSample) bool {
+	return prev.Status != sm.Status ||
+		prev.IsActive != sm.IsActive ||
+		prev.IsOffline != sm.IsOffline ||
+		prev.IsCheater != sm.IsCheater
+}
+
+// notifyStakerStatus broadcasts the staker status change to subscribers.
+func (p *proxy) notifyStakerStatus(evt *types.StakerStatusEvent) {
+	// do we have a subscriber?
+	if p.onStakerStatus == nil {
+		return
+	}
+
+	select {
+	case p.onStakerStatus <- evt:
+	default:
+		p.log.Warning("staker status channel is full")
+	}
+}
+
+// StakerPerformance aggregates the performance of the staker
+// from the samples recorded in the given range of epochs.
+func (p *proxy) StakerPerformance(id hexutil.Uint64, from hexutil.Uint64, to hexutil.Uint64) (*types.StakerPerformance, error) {
+	// validate the range
+	if from > to {
+		return nil, fmt.Errorf("invalid epoch range %d - %d", uint64(from), uint64(to))
+	}
+	if to-from >= stakerPerformanceMaxEpochs {
+		return nil, fmt.Errorf("epoch range too wide, max %d epochs allowed", stakerPerformanceMaxEpochs)
+	}
+
+	// load the samples
+	list, err := p.db.StakerSamples(id, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	// make the performance
+	perf := types.StakerPerformance{
+		StakerId:      id,
+		FromEpoch:     from,
+		ToEpoch:       to,
+		SampledEpochs: int32(len(list)),
+		Samples:       list,
+	}
+
+	// aggregate the samples
+	var observed uint64
+	for i := range list {
+		perf.OfflineIncidents += list[i].OfflineIncidents
+		perf.MissedBlocks += list[i].EpochMissedBlocks
+		perf.Downtime += list[i].EpochDowntime
+		observed += uint64(list[i].ObservedTime)
+
+		// the first cheater sample
+		if list[i].IsCheater && !perf.IsCheater {
+			perf.IsCheater = true
+			perf.CheaterEpoch = &list[i].Epoch
+		}
+	}
+
+	// calculate the uptime from the offline time observed by the counters
+	if 0 < observed {
+		down := uint64(perf.Downtime)
+		if down > observed {
+			down = observed
+		}
+		perf.Uptime = float64(observed-down) / float64(observed) * 100
+	}
+
+	return &perf, nil
+}
+
+// SetStakerStatusChannel registers a channel for notifying staker status changes.
+func (p *proxy) SetStakerStatusChannel(ch chan *types.StakerStatusEvent) {
+	p.onStakerStatus = ch
+}
@@ -0,0 +1,103 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/logger"
+	"sync"
+	"time"
+)
+
+// stakerSampleInterval represents the interval of sampling the stakers state.
+const stakerSampleInterval = 60 * time.Second
+
+// stakerSampler implements a service recording the state of stakers
+// in each epoch so the validators performance can be evaluated.
+type stakerSampler struct {
+	service
+}
+
+// newStakerSampler creates a new staker sampler service and starts it.
+func newStakerSampler(repo Repository, log logger.Logger, wg *sync.WaitGroup) *stakerSampler {
+	// create new sampler
+	ss := stakerSampler{
+		service: newService("staker sampler", repo, log, wg),
+	}
+
+	// add self to the wait group and run the sampling routine
+	wg.Add(1)
+	go ss.run()
+
+	return &ss
+}
+
+// run samples the stakers state periodically.
+func (ss *stakerSampler) run() {
+	// don't forget to sign off after we are done
+	defer func() {
+		// log finish
+		ss.log.Notice("staker sampler done")
+		ss.wg.Done()
+	}()
+
+	// inform about the action
+	ss.log.Notice("staker sampler is running")
+
+	// sample the stakers on each tick
+	ticker := time.NewTicker(stakerSampleInterval)
+	defer ticker.Stop()
+
+	for {
+		// sample what we have
+		ss.sample()
+
+		select {
+		case <-ss.sigStop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sample records the current state of all the stakers.
+func (ss *stakerSampler) sample() {
+	// get the current epoch
+	epoch, err := ss.repo.CurrentEpoch()
+	if err != nil {
+		ss.log.Errorf("can not get the current epoch; %s", err.Error())
+		return
+	}
+
+	// get the stakers
+	list, err := ss.repo.Stakers()
+	if err != nil {
+		ss.log.Errorf("can not get the list of stakers; %s", err.Error())
+		return
+	}
+
+	// record the samples
+	for i := range list {
+		// terminate if requested
+		select {
+		case <-ss.sigStop:
+			// the channel is closed after the signal so the main loop sees it too
+			return
+		default:
+		}
+
+		if err := ss.repo.AddStakerSample(&list[i], epoch); err != nil {
+			ss.log.Errorf("can not sample staker #%d; %s", uint64(list[i].Id), err.Error())
+		}
+
+		// make sure the staker info is known so the staker can be searched by name
+		if err := ss.repo.LoadStakerInfo(list[i].Id); err != nil {
+			ss.log.Errorf("can not load info of staker #%d; %s", uint64(list[i].Id), err.Error())
+		}
+	}
+}
@@ -28,12 +28,59 @@ func (p *proxy) AddTransaction(block *types.Block, trx *types.Transaction) error
 	// add smart contract to the persistent storage, too
 	if trx.ContractAddress != nil {
 		// add the smart contract
-		if err := p.db.AddContract(block, trx); err != nil {
+		if err := p.addContract(block, trx, trx.ContractAddress, nil, 0); err != nil {
 			p.log.Critical(err)
 			return err
 		}
 	}
 
+	// add smart contracts deployed by other contracts
+	if err := p.addFactoryContracts(block, trx); err != nil {
+		p.log.Critical(err)
+		return err
+	}
+
+	// load the event logs once for all the event handlers below;
+	// failed transactions don't emit any events
+	if trx.Status == nil || uint64(*trx.Status) == 0 {
+		return nil
+	}
+	logs, err := p.rpc.TransactionLogs(&trx.Hash)
+	if err != nil {
+		p.log.Critical(err)
+		return err
+	}
+
+	// add implementation upgrades of proxy contracts
+	if err := p.addContractUpgrades(logs); err != nil {
+		p.log.Critical(err)
+		return err
+	}
+
+	// add fMint reward claims
+	if err := p.addFMintRewardClaims(block, trx, logs); err != nil {
+		p.log.Critical(err)
+		return err
+	}
+
+	// add Uniswap swaps and liquidity changes
+	if err := p.addUniswapActions(block, trx, logs); err != nil {
+		p.log.Critical(err)
+		return err
+	}
+
+	// add votes cast in official ballots
+	if err := p.addBallotVotes(block, trx, logs); err != nil {
+		p.log.Critical(err)
+		return err
+	}
+
+	// add governance votes and proposal changes
+	if err := p.addGovernanceEvents(block, trx, logs); err != nil {
+		p.log.Critical(err)
+		return err
+	}
+
 	// everything seems to be ok
 	return nil
 }
@@ -123,9 +170,17 @@ func (p *proxy) SendTransaction(tx hexutil.Bytes) (*types.Transaction, error) {
 // No-number boundaries are handled as follows:
 // 	- For positive count we start from the most recent transaction and scan to older transactions.
 // 	- For negative count we start from the first transaction and scan to newer transactions.
-func (p *proxy) Transactions(cursor *string, count int32) (*types.TransactionHashList, error) {
+//
+// The list is limited to transactions matching the filter, if provided.
+func (p *proxy) Transactions(cursor *string, count int32, filter *types.TransactionFilter) (*types.TransactionHashList, error) {
 	// go to the database for the list of hashes of transaction searched
-	return p.db.Transactions(cursor, count)
+	return p.db.Transactions(cursor, count, filter)
+}
+
+// TransactionsFilteredCount returns the number of transactions matching the given filter.
+func (p *proxy) TransactionsFilteredCount(filter *types.TransactionFilter) (hexutil.Uint64, error) {
+	tc, err := p.db.TransactionsFilteredCount(filter)
+	return hexutil.Uint64(tc), err
 }
 
 // TransactionsCount returns total number of transactions in the block chain.
@@ -0,0 +1,369 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/types"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eth "github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+	"time"
+)
+
+const (
+	// uniswapVolumeResolution is the resolution of candles used
+	// to aggregate the trading volume of a pair.
+	uniswapVolumeResolution = 300
+
+	// uniswapVolumePeriod is the period of the trading volume of a pair in seconds.
+	uniswapVolumePeriod = 24 * 60 * 60
+
+	// uniswapPricePrecision is the precision of the price calculation in bits,
+	// enough for the 34 significant digits of the price stored with candles.
+	uniswapPricePrecision = 128
+
+	// uniswapPairSyncBatch represents the max number of Uniswap pairs loaded
+	// from the factory in a single sync.
+	uniswapPairSyncBatch = 50
+
+	// uniswapSwapNotifyMaxAge is the max age of a swap to be broadcast
+	// to subscribers; swaps found by re-scanning old blocks are not broadcast.
+	uniswapSwapNotifyMaxAge = 5 * time.Minute
+)
+
+// UniswapPairs resolves the list of the pairs of the Uniswap exchange
+// known to the repository.
+func (p *proxy) UniswapPairs(cursor *string, count int32) (*types.UniswapPairList, error) {
+	return p.db.UniswapPairs(cursor, count)
+}
+
+// UniswapPair resolves the state of the given Uniswap pair.
+func (p *proxy) UniswapPair(addr *common.Address) (*types.UniswapPair, error) {
+	// try the database first
+	pair, err := p.db.UniswapPair(addr)
+	if err != nil || pair != nil {
+		return pair, err
+	}
+
+	// the pair may not be synced yet
+	return p.rpc.UniswapPair(addr)
+}
+
+// SyncUniswapPairs stores a batch of Uniswap pairs created by the factory and not known
+// to the repository yet. It returns true if all the pairs of the factory are known.
+func (p *proxy) SyncUniswapPairs() (bool, error) {
+	// get the number of pairs of the factory
+	count, err := p.rpc.UniswapPairsCount()
+	if err != nil {
+		return false, err
+	}
+
+	// get the known pairs
+	known, err := p.db.UniswapPairIndexes()
+	if err != nil {
+		return false, err
+	}
+	if uint64(len(known)) >= count {
+		return true, nil
+	}
+
+	// the state is valid as of the current block
+	blk, err := p.rpc.BlockHeight()
+	if err != nil {
+		return false, err
+	}
+
+	// load the missing pairs, but not too many at once
+	added := 0
+	for ix := uint64(0); ix < count && added < uniswapPairSyncBatch; ix++ {
+		if known[ix] {
+			continue
+		}
+
+		pair, err := p.rpc.UniswapPairAt(ix)
+		if err != nil {
+			return false, err
+		}
+
+		if err := p.db.AddUniswapPair(pair, blk.ToInt().Uint64()); err != nil {
+			return false, err
+		}
+		added++
+	}
+
+	return uint64(len(known)+added) >= count, nil
+}
+
+// UniswapPairTvl resolves the total value locked in the given Uniswap pair expressed
+// in the first and in the second token. The value of the reserves is calculated using
+// the DeFi price oracle; nil values are returned if a token of the pair is not priced by the oracle.
+func (p *proxy) UniswapPairTvl(pair *types.UniswapPair) (*hexutil.Big, *hexutil.Big, error) {
+	// get the value of the reserves in the oracle price units
+	val0, err := p.uniswapReserveValue(&pair.Token0, &pair.Reserve0)
+	if err != nil || val0 == nil {
+		return nil, nil, err
+	}
+	val1, err := p.uniswapReserveValue(&pair.Token1, &pair.Reserve1)
+	if err != nil || val1 == nil {
+		return nil, nil, err
+	}
+
+	// no value, no price to convert the total with
+	if 0 == val0.Sign() || 0 == val1.Sign() {
+		return &hexutil.Big{}, &hexutil.Big{}, nil
+	}
+
+	// convert the total value into each of the tokens using the token value per unit
+	total := new(big.Int).Add(val0, val1)
+	tvl0 := new(big.Int).Div(new(big.Int).Mul(total, pair.Reserve0.ToInt()), val0)
+	tvl1 := new(big.Int).Div(new(big.Int).Mul(total, pair.Reserve1.ToInt()), val1)
+	return (*hexutil.Big)(tvl0), (*hexutil.Big)(tvl1), nil
+}
+
+// uniswapReserveValue calculates the value of the given amount of the token
+// in the DeFi price oracle units; nil if the token is not priced by the oracle.
+func (p *proxy) uniswapReserveValue(token *common.Address, amount *hexutil.Big) (*big.Int, error) {
+	// get the token decimals from the registry
+	tokens, err := p.DefiTokens()
+	if err != nil {
+		return nil, err
+	}
+
+	var tk *types.DefiToken
+	for _, t := range tokens {
+		if t.Address == *token {
+			tk = t
+			break
+		}
+	}
+	if tk == nil {
+		return nil, nil
+	}
+
+	// get the token price
+	price, err := p.DefiTokenPrice(token)
+	if err != nil {
+		return nil, err
+	}
+
+	// value = amount * price / 10^decimals
+	val := new(big.Int).Mul(amount.ToInt(), price.ToInt())
+	return val.Div(val, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(tk.Decimals)), nil)), nil
+}
+
+// UniswapAmountsOut resolves the amounts of tokens received for the given
+// input amount swapped along the given path of tokens.
+func (p *proxy) UniswapAmountsOut(amountIn hexutil.Big, path []common.Address) ([]hexutil.Big, error) {
+	return p.rpc.UniswapAmountsOut(amountIn.ToInt(), path)
+}
+
+// UniswapPositions resolves the list of liquidity positions of the given account
+// in the Uniswap pairs.
+func (p *proxy) UniswapPositions(owner *common.Address) ([]types.UniswapPosition, error) {
+	// get the pairs
+	al, err := p.db.UniswapPairAddresses()
+	if err != nil {
+		return nil, err
+	}
+
+	// check the liquidity of the account in each pair
+	list := make([]types.UniswapPosition, 0)
+	for i := range al {
+		liq, err := p.rpc.UniswapLiquidity(&al[i], owner)
+		if err != nil {
+			return nil, err
+		}
+
+		// no liquidity, no position
+		if 0 == liq.ToInt().Sign() {
+			continue
+		}
+
+		// get the pair reserves
+		pair, err := p.UniswapPair(&al[i])
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, types.UniswapPosition{
+			Pair:      al[i],
+			Owner:     *owner,
+			Liquidity: liq,
+			Amount0:   uniswapShare(&pair.Reserve0, &liq, &pair.TotalSupply),
+			Amount1:   uniswapShare(&pair.Reserve1, &liq, &pair.TotalSupply),
+		})
+	}
+
+	return list, nil
+}
+
+// uniswapShare calculates the share of the reserve owned by the given liquidity.
+func uniswapShare(reserve *hexutil.Big, liquidity *hexutil.Big, supply *hexutil.Big) hexutil.Big {
+	// no supply, no share
+	if 0 == supply.ToInt().Sign() {
+		return hexutil.Big{}
+	}
+
+	val := new(big.Int).Mul(reserve.ToInt(), liquidity.ToInt())
+	return hexutil.Big(*val.Div(val, supply.ToInt()))
+}
+
+// UniswapActions resolves the list of swaps and liquidity changes on the Uniswap
+// exchange, optionally filtered by the pair, the account and the type of the action.
+func (p *proxy) UniswapActions(pair *common.Address, account *common.Address, actionType *string, count int32) ([]types.UniswapAction, error) {
+	return p.db.UniswapActions(pair, account, actionType, count)
+}
+
+// addUniswapActions extracts new pairs, swaps and liquidity changes of the Uniswap pairs
+// from the given transaction event logs and stores them in the persistent storage.
+// Events are matched by the emitting pair, so actions executed through any contract are found.
+func (p *proxy) addUniswapActions(block *types.Block, trx *types.Transaction, logs []*eth.Log) error {
+	// store the new pairs first, the transaction may trade on them already
+	created, err := p.rpc.UniswapPairsCreated(logs)
+	if err != nil {
+		return err
+	}
+	for i := range created {
+		if err := p.db.AddUniswapPair(&created[i], uint64(block.Number)); err != nil {
+			return err
+		}
+	}
+
+	// any pair events at all?
+	src := p.rpc.UniswapEventSources(logs)
+	if 0 == len(src) {
+		return nil
+	}
+
+	// the events must come from the known pairs
+	pairs, err := p.db.UniswapPairsKnown(src)
+	if err != nil {
+		return err
+	}
+	if 0 == len(pairs) {
+		return nil
+	}
+
+	// get the list of actions and the reserves synced
+	list, syncs, err := p.rpc.UniswapActions(trx, logs, pairs)
+	if err != nil {
+		p.log.Errorf("can not get Uniswap actions of %s; %s", trx.Hash.String(), err.Error())
+		return nil
+	}
+
+	// store all of them
+	for i := range list {
+		list[i].TimeStamp = block.TimeStamp
+		list[i].OrdinalIndex = p.db.TransactionIndex(block, trx)
+		isNew, err := p.db.AddUniswapAction(&list[i])
+		if err != nil {
+			return err
+		}
+
+		// aggregate new swaps into candles
+		if isNew && list[i].Type == types.UniswapActionSwap {
+			if err := p.addUniswapSwap(&list[i]); err != nil {
+				return err
+			}
+		}
+
+		// liquidity changes update the supply of liquidity tokens
+		if list[i].Type != types.UniswapActionSwap {
+			if err := p.updateUniswapSupply(&list[i].Pair); err != nil {
+				return err
+			}
+		}
+	}
+
+	// update the pair reserves; the last sync of a pair is the state after the transaction
+	for i := range syncs {
+		if err := p.db.UpdateUniswapReserves(&syncs[i], uint64(block.Number), uint64(block.TimeStamp)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateUniswapSupply refreshes the total supply of liquidity tokens of the given pair.
+func (p *proxy) updateUniswapSupply(pair *common.Address) error {
+	val, err := p.rpc.UniswapTotalSupply(pair)
+	if err != nil {
+		// the supply is refreshed on the next liquidity change
+		p.log.Errorf("can not get supply of Uniswap pair %s; %s", pair.String(), err.Error())
+		return nil
+	}
+	return p.db.UpdateUniswapSupply(pair, val)
+}
+
+// addUniswapSwap aggregates the new swap into the pair candles
+// and broadcasts it to subscribers.
+func (p *proxy) addUniswapSwap(act *types.UniswapAction) error {
+	// the price is given by the reserves after the swap
+	price, ok := uniswapPrice(&act.Reserve0, &act.Reserve1)
+	if !ok {
+		p.log.Warningf("uniswap swap %s on %s has no reserves", act.TransactionHash.String(), act.Pair.String())
+		return nil
+	}
+
+	// update the candles
+	if err := p.db.AddUniswapSwapToCandles(act, price); err != nil {
+		return err
+	}
+
+	// notify subscribers about fresh swaps only
+	if p.onSwap != nil && time.Since(time.Unix(int64(act.TimeStamp), 0)) < uniswapSwapNotifyMaxAge {
+		select {
+		case p.onSwap <- act:
+		default:
+			p.log.Warning("uniswap swap channel is full")
+		}
+	}
+
+	return nil
+}
+
+// uniswapPrice calculates the price of the first token in units of the second
+// token from the given pair reserves.
+func uniswapPrice(reserve0 *hexutil.Big, reserve1 *hexutil.Big) (*big.Float, bool) {
+	// we need both reserves to calculate the price
+	if 0 == reserve0.ToInt().Sign() || 0 == reserve1.ToInt().Sign() {
+		return nil, false
+	}
+
+	return new(big.Float).SetPrec(uniswapPricePrecision).Quo(
+		new(big.Float).SetPrec(uniswapPricePrecision).SetInt(reserve1.ToInt()),
+		new(big.Float).SetPrec(uniswapPricePrecision).SetInt(reserve0.ToInt())), true
+}
+
+// UniswapCandles resolves the list of OHLCV candles of the given Uniswap pair
+// and resolution in the given time range.
+func (p *proxy) UniswapCandles(pair *common.Address, resolution string, from uint64, to uint64) ([]types.UniswapCandle, error) {
+	// get the resolution bucket length
+	res, ok := types.UniswapCandleResolutions[resolution]
+	if !ok {
+		return nil, fmt.Errorf("unknown candle resolution %s", resolution)
+	}
+
+	// validate the time range
+	if from > to {
+		return nil, fmt.Errorf("invalid time range of candles")
+	}
+
+	return p.db.UniswapCandles(pair, res, from, to)
+}
+
+// UniswapVolume24h resolves the trading volume of the given Uniswap pair
+// over the last 24 hours.
+func (p *proxy) UniswapVolume24h(pair *common.Address) (*types.UniswapVolume, error) {
+	from := uint64(time.Now().Unix() - uniswapVolumePeriod)
+	return p.db.UniswapVolume(pair, uniswapVolumeResolution, from-from%uniswapVolumeResolution)
+}
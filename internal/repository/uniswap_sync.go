@@ -0,0 +1,77 @@
+/*
+Package repository implements repository for handling fast and efficient access to data required
+by the resolvers of the API server.
+
+Internally it utilizes RPC to access Opera/Lachesis full node for blockchain interaction. Mongo database
+for fast, robust and scalable off-chain data storage, especially for aggregated and pre-calculated data mining
+results. BigCache for in-memory object storage to speed up loading of frequently accessed entities.
+*/
+package repository
+
+import (
+	"fantom-api-graphql/internal/logger"
+	"sync"
+	"time"
+)
+
+const (
+	// uniswapSyncPause represents the pause between two batches of pairs
+	// loaded while the repository is catching up with the factory.
+	uniswapSyncPause = time.Second
+
+	// uniswapSyncInterval represents the interval of checking for pairs
+	// missed by the scanner once the repository knows all the pairs.
+	uniswapSyncInterval = 5 * time.Minute
+)
+
+// uniswapPairSyncer implements a service storing the pairs of the Uniswap
+// factory created before the scanner started to track them.
+type uniswapPairSyncer struct {
+	service
+}
+
+// newUniswapPairSyncer creates a new Uniswap pair syncer service and starts it.
+func newUniswapPairSyncer(repo Repository, log logger.Logger, wg *sync.WaitGroup) *uniswapPairSyncer {
+	// create new syncer
+	us := uniswapPairSyncer{
+		service: newService("uniswap pair syncer", repo, log, wg),
+	}
+
+	// add self to the wait group and run the sync routine
+	wg.Add(1)
+	go us.run()
+
+	return &us
+}
+
+// run loads the missing pairs in batches and checks for new ones periodically.
+func (us *uniswapPairSyncer) run() {
+	// don't forget to sign off after we are done
+	defer func() {
+		// log finish
+		us.log.Notice("uniswap pair syncer done")
+		us.wg.Done()
+	}()
+
+	// inform about the action
+	us.log.Notice("uniswap pair syncer is running")
+
+	for {
+		// sync the next batch; wait longer if all the pairs are known
+		wait := uniswapSyncPause
+		done, err := us.repo.SyncUniswapPairs()
+		if err != nil {
+			us.log.Errorf("can not sync uniswap pairs; %s", err.Error())
+			wait = uniswapSyncInterval
+		}
+		if done {
+			wait = uniswapSyncInterval
+		}
+
+		select {
+		case <-us.sigStop:
+			return
+		case <-time.After(wait):
+		}
+	}
+}
@@ -0,0 +1,70 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// TransactionDirectionIn represents transactions received by an account.
+	TransactionDirectionIn = "IN"
+
+	// TransactionDirectionOut represents transactions sent by an account.
+	TransactionDirectionOut = "OUT"
+)
+
+// AccountTransactionFilter represents a set of conditions applied
+// to the list of transactions of an account.
+// Conditions not provided are not applied.
+type AccountTransactionFilter struct {
+	// Direction is the direction of the transaction from the account point of view.
+	Direction *string
+
+	// Counterparty is the address of the other side of the transaction.
+	Counterparty *common.Address
+
+	// MinValue is the minimal value transferred in WEI.
+	MinValue *hexutil.Big
+
+	// MaxValue is the maximal value transferred in WEI.
+	MaxValue *hexutil.Big
+
+	// FromTime is the minimal time stamp of the transaction.
+	FromTime *hexutil.Uint64
+
+	// ToTime is the maximal time stamp of the transaction.
+	ToTime *hexutil.Uint64
+}
+
+// IsEmpty checks if the filter has no conditions.
+func (f *AccountTransactionFilter) IsEmpty() bool {
+	return f == nil || (f.Direction == nil && f.Counterparty == nil &&
+		f.MinValue == nil && f.MaxValue == nil && f.FromTime == nil && f.ToTime == nil)
+}
+
+// AccountTxSummary represents aggregated transactions of an account.
+type AccountTxSummary struct {
+	// TotalSent is the total value sent by the account in WEI.
+	TotalSent hexutil.Big
+
+	// TotalReceived is the total value received by the account in WEI.
+	TotalReceived hexutil.Big
+
+	// SentCount is the number of transactions sent by the account.
+	SentCount hexutil.Uint64
+
+	// ReceivedCount is the number of transactions received by the account.
+	ReceivedCount hexutil.Uint64
+
+	// FirstActivity is the time stamp of the first transaction of the account.
+	FirstActivity *hexutil.Uint64
+
+	// LastActivity is the time stamp of the latest transaction of the account.
+	LastActivity *hexutil.Uint64
+
+	// IsComplete signals the totals include the values of all the transactions;
+	// the values of transactions stored before they were recorded
+	// are missing until the accounts backfill is done.
+	IsComplete bool
+}
@@ -0,0 +1,68 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Ballot represents an official voting ballot contract deployed
+// by one of the configured voting sources.
+type Ballot struct {
+	// Address is the address of the ballot contract.
+	Address common.Address
+
+	// Name is the name of the ballot.
+	Name string
+
+	// DetailsUrl is the URL of the ballot details.
+	DetailsUrl string
+
+	// Start is the unix time stamp of the voting start.
+	Start hexutil.Uint64
+
+	// End is the unix time stamp of the voting end.
+	End hexutil.Uint64
+
+	// IsFinalized signals the ballot has been finalized and the winner is known.
+	IsFinalized bool
+
+	// TotalVotes is the number of votes cast.
+	TotalVotes hexutil.Uint64
+
+	// Proposals is the list of proposals of the ballot.
+	Proposals []BallotProposal
+
+	// Winner is the index of the winning proposal, if finalized.
+	Winner *int32
+}
+
+// BallotProposal represents a proposal of a voting ballot with its vote tally.
+type BallotProposal struct {
+	// Name is the name of the proposal.
+	Name string
+
+	// Weight is the total weight of the votes for the proposal.
+	Weight hexutil.Big
+
+	// Votes is the number of votes for the proposal.
+	Votes hexutil.Uint64
+}
+
+// Vote represents a vote cast by an account in a voting ballot.
+type Vote struct {
+	// Ballot is the address of the ballot contract.
+	Ballot common.Address
+
+	// Voter is the address of the voting account.
+	Voter common.Address
+
+	// Proposal is the index of the proposal voted for.
+	Proposal int32
+
+	// TransactionHash is the hash of the vote transaction.
+	TransactionHash Hash
+
+	// TimeStamp is the unix time stamp of the vote.
+	TimeStamp hexutil.Uint64
+}
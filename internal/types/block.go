@@ -36,6 +36,9 @@ type Block struct {
 	// TimeStamp represents the unix timestamp for when the block was collated.
 	TimeStamp hexutil.Uint64 `json:"timestamp"`
 
+	// Epoch represents the id of the epoch the block belongs to.
+	Epoch hexutil.Uint64 `json:"epoch"`
+
 	// Txs represents array of 32 bytes hashes of transactions included in the block.
 	Txs []*Hash `json:"transactions"`
 }
@@ -6,6 +6,9 @@ type BlockList struct {
 	// List keeps the actual Collection.
 	Collection []*Block
 
+	// Total indicates total number of blocks in the listed range.
+	Total uint64
+
 	// IsStart indicates there are no blocks available above the list currently.
 	IsStart bool
 
@@ -0,0 +1,29 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// CallInput represents a transaction call message used to estimate gas,
+// or to execute a read only call without creating a transaction.
+type CallInput struct {
+	// From represents the address of the sender.
+	From *common.Address `json:"from,omitempty"`
+
+	// To represents the address of the recipient; nil for contract creation.
+	To *common.Address `json:"to,omitempty"`
+
+	// Gas represents the amount of gas provided for the call.
+	Gas *hexutil.Uint64 `json:"gas,omitempty"`
+
+	// GasPrice represents the gas price in WEI.
+	GasPrice *hexutil.Big `json:"gasPrice,omitempty"`
+
+	// Value represents the amount of WEI transferred with the call.
+	Value *hexutil.Big `json:"value,omitempty"`
+
+	// Data represents the call data, or the contract byte code.
+	Data *hexutil.Bytes `json:"data,omitempty"`
+}
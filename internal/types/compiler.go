@@ -0,0 +1,17 @@
+// Package types implements different core types of the API.
+package types
+
+// Compiler represents a smart contract compiler available for contract validation.
+type Compiler struct {
+	// Language represents the language the compiler is built for, i.e. Solidity.
+	Language string `json:"language"`
+
+	// Version represents the short version identifier of the compiler, i.e. 0.5.17.
+	Version string `json:"version"`
+
+	// FullVersion represents the full version identifier reported by the compiler.
+	FullVersion string `json:"fullVersion"`
+
+	// Path represents the path to the compiler binary.
+	Path string `json:"-"`
+}
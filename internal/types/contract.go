@@ -6,6 +6,44 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
+const (
+	// ContractLanguageSolidity represents the Solidity smart contract language.
+	ContractLanguageSolidity = "Solidity"
+
+	// ContractLanguageVyper represents the Vyper smart contract language.
+	ContractLanguageVyper = "Vyper"
+
+	// ContractMatchFull represents a validation match where both the deployed
+	// byte code and the metadata of the compiled code are identical.
+	ContractMatchFull = "FULL"
+
+	// ContractMatchPartial represents a validation match where the deployed
+	// byte code is identical, but the metadata of the compiled code differ.
+	ContractMatchPartial = "PARTIAL"
+)
+
+// ContractCreation represents a smart contract deployed by another contract.
+type ContractCreation struct {
+	// Address is the address of the deployed contract.
+	Address common.Address
+
+	// Factory is the address of the contract deploying the new contract.
+	Factory common.Address
+}
+
+// ContractArgument represents a decoded constructor argument
+// of a smart contract.
+type ContractArgument struct {
+	// Name is the name of the argument, if available.
+	Name string `json:"name"`
+
+	// Type is the ABI type of the argument.
+	Type string `json:"type"`
+
+	// Value is the textual representation of the argument value.
+	Value string `json:"value"`
+}
+
 // Contract represents an Opera smart contract at the blockchain.
 type Contract struct {
 	// OrdinalIndex is the ordinal contract index in the database.
@@ -17,6 +55,13 @@ type Contract struct {
 	// TransactionHash represents the hash of the contract deployment transaction.
 	TransactionHash Hash `json:"tx"`
 
+	// Factory represents the address of the contract which deployed this contract,
+	// if the contract was not deployed directly by a transaction.
+	Factory *common.Address `json:"factory"`
+
+	// CodeHash represents the hash of the deployed runtime byte code.
+	CodeHash *Hash `json:"coh"`
+
 	// TimeStamp represents the unix timestamp of the contract deployment.
 	TimeStamp hexutil.Uint64 `json:"timestamp"`
 
@@ -36,6 +81,10 @@ type Contract struct {
 	// Smart contract compiler identifier, if available.
 	Compiler string `json:"cv"`
 
+	// Language represents the language of the smart contract
+	// source code, i.e. Solidity or Vyper.
+	Language string `json:"lang"`
+
 	// IsOptimized signals that the contract byte code was optimized
 	// during compilation.
 	IsOptimized bool `json:"optimized"`
@@ -54,7 +103,72 @@ type Contract struct {
 	// ABI definition of the smart contract, if available.
 	Abi string `json:"abi"`
 
+	// ConstructorArgs represents the raw ABI encoded constructor arguments
+	// appended to the contract deployment byte code.
+	ConstructorArgs hexutil.Bytes `json:"args"`
+
+	// ConstructorArguments represents the decoded constructor arguments.
+	ConstructorArguments []ContractArgument `json:"argv"`
+
+	// Match represents the quality of the match between the validated
+	// source code and the deployed byte code, i.e. FULL, or PARTIAL.
+	Match string `json:"match"`
+
 	// Validated represents the unix timestamp
 	//of the contract source validation against deployed byte code.
 	Validated *hexutil.Uint64 `json:"ok"`
+
+	// Proxy represents the detected proxy relation of the contract;
+	// nil if the contract was not recognized as a proxy.
+	Proxy *ContractProxy `json:"proxy"`
+
+	// ProxyChecked represents the unix timestamp of the last proxy detection;
+	// nil if the detection was not done yet.
+	ProxyChecked *hexutil.Uint64 `json:"pxc"`
+
+	// UpgradesIndexed signals all the past upgrade events of the contract
+	// are stored in the persistent storage.
+	UpgradesIndexed bool `json:"upx"`
+}
+
+const (
+	// ContractProxyEIP1967 represents proxy with the implementation stored in EIP-1967 slot.
+	ContractProxyEIP1967 = "EIP-1967"
+
+	// ContractProxyEIP1822 represents universal upgradeable proxy defined by EIP-1822.
+	ContractProxyEIP1822 = "EIP-1822"
+
+	// ContractProxyOpenZeppelin represents legacy OpenZeppelin transparent proxy.
+	ContractProxyOpenZeppelin = "OpenZeppelin"
+
+	// ContractProxyEIP1167 represents minimal proxy clone defined by EIP-1167.
+	ContractProxyEIP1167 = "EIP-1167"
+)
+
+// ContractProxy represents a proxy relation of a smart contract
+// to the contract implementing its logic.
+type ContractProxy struct {
+	// Type represents the type of the proxy, i.e. EIP-1967, or EIP-1167.
+	Type string `json:"type"`
+
+	// Implementation is the address of the contract implementing the logic.
+	Implementation common.Address `json:"impl"`
+}
+
+// ContractUpgrade represents an implementation upgrade of a proxy contract.
+type ContractUpgrade struct {
+	// Proxy is the address of the upgraded proxy contract.
+	Proxy common.Address
+
+	// Implementation is the address of the new implementation.
+	Implementation common.Address
+
+	// BlockNumber is the number of the block of the upgrade.
+	BlockNumber hexutil.Uint64
+
+	// TransactionHash is the hash of the upgrade transaction.
+	TransactionHash Hash
+
+	// LogIndex is the index of the upgrade event log in the block.
+	LogIndex hexutil.Uint64
 }
@@ -0,0 +1,196 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"encoding/json"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// DefiSettings represents the current configuration of the fMint DeFi module.
+type DefiSettings struct {
+	// MintFee4 represents the fee charged on minting in 4 decimals.
+	MintFee4 hexutil.Big
+
+	// MinCollateralRatio4 represents the lowest collateral to debt ratio
+	// allowed in 4 decimals. Positions below the ratio can be liquidated.
+	MinCollateralRatio4 hexutil.Big
+
+	// RewardCollateralRatio4 represents the collateral to debt ratio
+	// needed to be eligible for rewards in 4 decimals.
+	RewardCollateralRatio4 hexutil.Big
+
+	// Decimals represents the number of decimals used by the ratios and fees.
+	Decimals int32
+
+	// FMintAddressProvider is the address of the fMint address provider.
+	FMintAddressProvider common.Address
+
+	// FMintContract is the address of the fMint minter contract.
+	FMintContract common.Address
+
+	// FMintCollateralPool is the address of the fMint collateral pool.
+	FMintCollateralPool common.Address
+
+	// FMintDebtPool is the address of the fMint debt pool.
+	FMintDebtPool common.Address
+
+	// FMintRewardDistribution is the address of the fMint reward distribution.
+	FMintRewardDistribution common.Address
+
+	// PriceOracleAggregate is the address of the price oracle proxy.
+	PriceOracleAggregate common.Address
+
+	// TokenRegistry is the address of the DeFi tokens registry.
+	TokenRegistry common.Address
+}
+
+// FMintTokenBalance represents a balance of a token in fMint pool.
+type FMintTokenBalance struct {
+	// Token is the address of the token.
+	Token common.Address
+
+	// Balance is the amount of tokens.
+	Balance hexutil.Big
+
+	// Value is the value of the tokens in the reference denomination.
+	Value hexutil.Big
+}
+
+// FMintAccount represents collateral and debt positions of an account
+// in the fMint DeFi module.
+type FMintAccount struct {
+	// Address is the address of the account.
+	Address common.Address
+
+	// Collateral is the list of tokens locked as collateral.
+	Collateral []FMintTokenBalance
+
+	// Debt is the list of tokens minted as debt.
+	Debt []FMintTokenBalance
+
+	// CollateralValue is the total value of the collateral.
+	CollateralValue hexutil.Big
+
+	// DebtValue is the total value of the debt.
+	DebtValue hexutil.Big
+
+	// CollateralRatio4 is the collateral to debt ratio in 4 decimals.
+	// Nil if there is no debt.
+	CollateralRatio4 *hexutil.Big
+}
+
+// DefiToken represents a token registered in the DeFi tokens registry.
+type DefiToken struct {
+	// Address is the address of the token contract.
+	Address common.Address `json:"address"`
+
+	// Name is the name of the token.
+	Name string `json:"name"`
+
+	// Symbol is the symbol of the token.
+	Symbol string `json:"symbol"`
+
+	// Decimals is the number of decimals of the token.
+	Decimals int32 `json:"decimals"`
+
+	// LogoUrl is the URL of the token logo image.
+	LogoUrl string `json:"logo"`
+
+	// PriceDecimals is the number of decimals of the token price.
+	PriceDecimals int32 `json:"priceDecimals"`
+
+	// IsActive signals the token is active in the DeFi module.
+	IsActive bool `json:"active"`
+
+	// CanDeposit signals the token can be used as a collateral.
+	CanDeposit bool `json:"canDeposit"`
+
+	// CanMint signals the token can be minted.
+	CanMint bool `json:"canMint"`
+
+	// CanTrade signals the token can be traded.
+	CanTrade bool `json:"canTrade"`
+}
+
+// UnmarshalDefiTokens parses the JSON-encoded list of DeFi tokens.
+func UnmarshalDefiTokens(data []byte) ([]*DefiToken, error) {
+	var list []*DefiToken
+	err := json.Unmarshal(data, &list)
+	return list, err
+}
+
+// FMintRewardPool represents the state of the fMint reward distribution pool.
+type FMintRewardPool struct {
+	// Address is the address of the reward distribution contract.
+	Address common.Address
+
+	// RewardToken is the address of the token used for rewards.
+	RewardToken common.Address
+
+	// RewardRate represents the amount of reward tokens distributed per second.
+	RewardRate hexutil.Big
+
+	// TotalPrincipal represents the total principal eligible for rewards.
+	TotalPrincipal hexutil.Big
+
+	// EpochLength represents the length of the reward epoch in seconds.
+	EpochLength hexutil.Uint64
+
+	// EpochEnds represents the unix time stamp of the current reward epoch end.
+	EpochEnds hexutil.Uint64
+
+	// LastRewardPush represents the unix time stamp of the last reward push.
+	LastRewardPush hexutil.Uint64
+
+	// RewardApplicableUntil represents the unix time stamp until which
+	// the rewards are accumulated.
+	RewardApplicableUntil hexutil.Uint64
+}
+
+// FMintRewards represents the state of fMint rewards of an account.
+type FMintRewards struct {
+	// Address is the address of the account.
+	Address common.Address
+
+	// Principal represents the account principal eligible for rewards.
+	Principal hexutil.Big
+
+	// Earned represents the amount of reward earned by the account so far.
+	Earned hexutil.Big
+
+	// Unlocked represents the amount of reward accumulated so far
+	// and paid to the account on the next claim.
+	Unlocked hexutil.Big
+
+	// Pending represents the amount of reward the account accumulates
+	// until the end of the current reward period.
+	Pending hexutil.Big
+
+	// Stashed represents the amount of reward stashed for the account.
+	Stashed hexutil.Big
+
+	// CanClaim signals the account can claim the earned reward now.
+	CanClaim bool
+
+	// IsEligible signals the account collateral ratio is eligible for rewards.
+	IsEligible bool
+}
+
+// FMintRewardClaim represents a reward claimed from the fMint reward distribution.
+type FMintRewardClaim struct {
+	// Account is the address of the account claiming the reward.
+	Account common.Address
+
+	// Amount is the amount of reward paid.
+	Amount hexutil.Big
+
+	// TransactionHash is the hash of the claim transaction.
+	TransactionHash Hash
+
+	// LogIndex is the index of the reward event in the block.
+	LogIndex hexutil.Uint64
+
+	// TimeStamp is the unix time stamp of the claim.
+	TimeStamp hexutil.Uint64
+}
@@ -0,0 +1,39 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Delegation represents a delegation of an account to a staker.
+type Delegation struct {
+	// Address is the address of the delegating account.
+	Address common.Address `json:"address"`
+
+	// ToStakerId is the id of the staker the delegation belongs to.
+	ToStakerId hexutil.Uint64 `json:"toStakerID"`
+
+	// CreatedEpoch is the id of the epoch the delegation was created in.
+	CreatedEpoch hexutil.Uint64 `json:"createdEpoch"`
+
+	// CreatedTime is the unix time stamp of the delegation creation.
+	CreatedTime hexutil.Uint64 `json:"createdTime"`
+
+	// DeactivatedEpoch is the id of the epoch the delegation was deactivated in.
+	DeactivatedEpoch hexutil.Uint64 `json:"deactivatedEpoch"`
+
+	// DeactivatedTime is the unix time stamp of the delegation deactivation.
+	DeactivatedTime hexutil.Uint64 `json:"deactivatedTime"`
+
+	// Amount is the amount of delegated tokens in WEI.
+	Amount hexutil.Big `json:"amount"`
+
+	// PaidUntilEpoch is the id of the last epoch the rewards were claimed for.
+	PaidUntilEpoch hexutil.Uint64 `json:"paidUntilEpoch"`
+}
+
+// IsActive signals the delegation has not been deactivated.
+func (dl *Delegation) IsActive() bool {
+	return dl.DeactivatedEpoch == 0
+}
@@ -31,3 +31,44 @@ func UnmarshalEpoch(data []byte) (*Epoch, error) {
 func (e *Epoch) Marshal() ([]byte, error) {
 	return json.Marshal(e)
 }
+
+// EpochValidator represents the state of a validator in a sealed epoch.
+type EpochValidator struct {
+	// Epoch is the id of the epoch.
+	Epoch hexutil.Uint64 `json:"epoch"`
+
+	// StakerId is the id of the validator staker.
+	StakerId hexutil.Uint64 `json:"stakerId"`
+
+	// StakeAmount is the amount of own stake of the validator in the epoch.
+	StakeAmount hexutil.Big `json:"stakeAmount"`
+
+	// DelegatedMe is the amount delegated to the validator in the epoch.
+	DelegatedMe hexutil.Big `json:"delegatedMe"`
+
+	// BaseRewardWeight is the base reward weight of the validator in the epoch.
+	BaseRewardWeight hexutil.Big `json:"baseRewardWeight"`
+
+	// TxRewardWeight is the transaction reward weight of the validator in the epoch.
+	TxRewardWeight hexutil.Big `json:"txRewardWeight"`
+
+	// Reward is the raw reward of the validator in the epoch,
+	// before the delegators share is paid out.
+	Reward hexutil.Big `json:"reward"`
+
+	// MissedBlocks is the number of blocks missed by the validator in the epoch.
+	// It's known only for epochs observed live when they were sealed.
+	MissedBlocks *hexutil.Uint64 `json:"missedBlocks,omitempty"`
+
+	// Downtime is the downtime of the validator in the epoch in seconds.
+	// It's known only for epochs observed live when they were sealed.
+	Downtime *hexutil.Uint64 `json:"downtime,omitempty"`
+
+	// MissedBlocksTotal is the total number of blocks missed by the validator
+	// at the moment the epoch was sealed.
+	MissedBlocksTotal *hexutil.Uint64 `json:"missedBlocksTotal,omitempty"`
+
+	// DowntimeTotal is the total downtime of the validator
+	// at the moment the epoch was sealed.
+	DowntimeTotal *hexutil.Uint64 `json:"downtimeTotal,omitempty"`
+}
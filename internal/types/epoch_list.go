@@ -0,0 +1,39 @@
+// Package types implements different core types of the API.
+package types
+
+// EpochList represents a list of epochs.
+type EpochList struct {
+	// List keeps the actual Collection.
+	Collection []*Epoch
+
+	// Total indicates total number of epochs in the whole collection.
+	Total uint64
+
+	// First is the id of the first epoch on the list
+	First uint64
+
+	// Last is the id of the last epoch on the list
+	Last uint64
+
+	// IsStart indicates there are no epochs available above the list currently.
+	IsStart bool
+
+	// IsEnd indicates there are no epochs available below the list currently.
+	IsEnd bool
+}
+
+// Reverse reverses the order of epochs in the list.
+func (e *EpochList) Reverse() {
+	// anything to swap at all?
+	if e.Collection == nil || len(e.Collection) < 2 {
+		return
+	}
+
+	// swap elements
+	for i, j := 0, len(e.Collection)-1; i < j; i, j = i+1, j-1 {
+		e.Collection[i], e.Collection[j] = e.Collection[j], e.Collection[i]
+	}
+
+	// swap indexes
+	e.First, e.Last = e.Last, e.First
+}
@@ -0,0 +1,28 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// GasPriceSuggestion represents gas prices suggested for new transactions
+// based on gas prices of transactions in recent blocks.
+type GasPriceSuggestion struct {
+	// Slow is the gas price for transactions not in a hurry.
+	Slow hexutil.Big
+
+	// Standard is the gas price for regular transactions.
+	Standard hexutil.Big
+
+	// Fast is the gas price for transactions to be processed quickly.
+	Fast hexutil.Big
+
+	// NodePrice is the gas price suggested by the connected node.
+	NodePrice hexutil.Big
+
+	// Blocks is the number of recent blocks sampled.
+	Blocks int32
+
+	// Samples is the number of transactions sampled.
+	Samples int32
+}
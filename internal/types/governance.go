@@ -0,0 +1,162 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// GovernanceStatusActive represents a proposal open for voting.
+	GovernanceStatusActive = "ACTIVE"
+
+	// GovernanceStatusResolved represents a proposal resolved by the voting.
+	GovernanceStatusResolved = "RESOLVED"
+
+	// GovernanceStatusFailed represents a proposal rejected by the voting.
+	GovernanceStatusFailed = "FAILED"
+
+	// GovernanceStatusCanceled represents a proposal canceled before resolving.
+	GovernanceStatusCanceled = "CANCELED"
+
+	// GovernanceStatusExpired represents a resolved proposal not executed in time.
+	GovernanceStatusExpired = "EXPIRED"
+)
+
+// GovernanceContract represents a configured governance contract.
+type GovernanceContract struct {
+	// Name is the configured name of the governance contract.
+	Name string
+
+	// Address is the address of the governance contract.
+	Address common.Address
+}
+
+// GovernanceProposal represents a proposal of a governance contract.
+type GovernanceProposal struct {
+	// Governance is the address of the governance contract.
+	Governance common.Address
+
+	// Id is the identifier of the proposal inside the governance contract.
+	Id hexutil.Uint64
+
+	// Name is the name of the proposal.
+	Name string
+
+	// Description is the description of the proposal.
+	Description string
+
+	// Contract is the address of the proposal contract.
+	Contract common.Address
+
+	// ProposalType is the type of the proposal.
+	ProposalType hexutil.Uint64
+
+	// IsExecutable signals the proposal is executed when resolved.
+	IsExecutable bool
+
+	// MinVotes is the minimal ratio of the votes weight needed to resolve the proposal.
+	MinVotes hexutil.Big
+
+	// MinAgreement is the minimal agreement ratio needed to accept an option.
+	MinAgreement hexutil.Big
+
+	// OpinionScales is the list of opinion scales a voter chooses from for each option.
+	OpinionScales []hexutil.Big
+
+	// Options is the list of options of the proposal with their results.
+	Options []GovernanceOption
+
+	// VotingStarts is the unix time stamp of the voting start.
+	VotingStarts hexutil.Uint64
+
+	// VotingMayEnd is the unix time stamp from which the voting may be resolved.
+	VotingMayEnd hexutil.Uint64
+
+	// VotingMustEnd is the unix time stamp of the voting end.
+	VotingMustEnd hexutil.Uint64
+
+	// Status is the status of the proposal.
+	Status string
+
+	// Votes is the total weight of the votes cast.
+	Votes hexutil.Big
+
+	// WinnerId is the identifier of the winning option, if resolved.
+	WinnerId *hexutil.Uint64
+}
+
+// GovernanceOption represents an option of a governance proposal with its weighted result.
+type GovernanceOption struct {
+	// Id is the identifier of the option.
+	Id hexutil.Uint64
+
+	// Name is the name of the option.
+	Name string
+
+	// Votes is the total weight of the votes for the option.
+	Votes hexutil.Big
+
+	// AgreementRatio is the weighted agreement ratio of the option.
+	AgreementRatio hexutil.Big
+
+	// Agreement is the weighted agreement of the option.
+	Agreement hexutil.Big
+}
+
+// GovernanceVote represents a vote cast in a governance proposal.
+type GovernanceVote struct {
+	// Governance is the address of the governance contract.
+	Governance common.Address
+
+	// ProposalId is the identifier of the proposal.
+	ProposalId hexutil.Uint64
+
+	// Voter is the address of the voting account.
+	Voter common.Address
+
+	// DelegatedTo is the address the voting power is delegated to.
+	DelegatedTo common.Address
+
+	// Choices is the list of opinions chosen for each option.
+	Choices []hexutil.Uint64
+
+	// Weight is the weight of the vote.
+	Weight hexutil.Big
+
+	// TransactionHash is the hash of the vote transaction.
+	TransactionHash Hash
+
+	// LogIndex is the index of the vote event in the block.
+	LogIndex hexutil.Uint64
+
+	// TimeStamp is the unix time stamp of the vote.
+	TimeStamp hexutil.Uint64
+}
+
+// GovernanceVotingPower represents voting power of an account derived from its SFC stake.
+type GovernanceVotingPower struct {
+	// Address is the address of the account.
+	Address common.Address
+
+	// Stake is the amount staked by the account as a validator.
+	Stake hexutil.Big
+
+	// DelegatedMe is the amount delegated to the account as a validator.
+	DelegatedMe hexutil.Big
+
+	// Delegated is the amount delegated by the account to validators.
+	Delegated hexutil.Big
+
+	// Total is the total voting power of the account.
+	Total hexutil.Big
+}
+
+// GovernanceProposalEvent represents a change of a governance proposal state.
+type GovernanceProposalEvent struct {
+	// Governance is the address of the governance contract.
+	Governance common.Address
+
+	// ProposalId is the identifier of the proposal.
+	ProposalId hexutil.Uint64
+}
@@ -0,0 +1,57 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// NetworkStatsResolutionHour represents hourly aggregated network statistics.
+	NetworkStatsResolutionHour = "HOUR"
+
+	// NetworkStatsResolutionDay represents daily aggregated network statistics.
+	NetworkStatsResolutionDay = "DAY"
+)
+
+// NetworkStats represents the network activity aggregated over a period of time.
+type NetworkStats struct {
+	// Resolution is the aggregation period, HOUR or DAY.
+	Resolution string
+
+	// TimeStamp is the unix time stamp of the period start.
+	TimeStamp hexutil.Uint64
+
+	// Duration is the number of seconds covered by the aggregated data.
+	// It's shorter than the period for the period in progress.
+	Duration hexutil.Uint64
+
+	// TxCount is the number of transactions processed in the period.
+	TxCount hexutil.Uint64
+
+	// GasUsed is the total amount of gas used by the blocks of the period.
+	GasUsed hexutil.Uint64
+
+	// Senders is the number of unique accounts sending transactions in the period.
+	Senders hexutil.Uint64
+
+	// NewAccounts is the number of accounts first seen in the period.
+	NewAccounts hexutil.Uint64
+
+	// NewContracts is the number of contracts deployed in the period.
+	NewContracts hexutil.Uint64
+
+	// Volume is the total amount of WEI transferred in the period.
+	Volume hexutil.Big
+}
+
+// NetworkStatsPeriod returns the length of the aggregation period in seconds;
+// zero for unknown resolution.
+func NetworkStatsPeriod(res string) uint64 {
+	switch res {
+	case NetworkStatsResolutionHour:
+		return 3600
+	case NetworkStatsResolutionDay:
+		return 86400
+	}
+	return 0
+}
@@ -0,0 +1,57 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// RewardParams represents the parameters of the staking rewards
+// distribution configured in the SFC contract. Ratios are expressed
+// in units of the SFC contract ratio unit (1.000.000).
+type RewardParams struct {
+	// ValidatorCommission is the ratio of delegators rewards paid to the validator.
+	ValidatorCommission hexutil.Big
+
+	// ContractCommission is the ratio of transaction fees kept by the contract.
+	ContractCommission hexutil.Big
+
+	// UnlockedRewardRatio is the ratio of the full reward paid for unlocked stake.
+	UnlockedRewardRatio hexutil.Big
+
+	// HasLockup signals the contract supports stake locking.
+	HasLockup bool
+
+	// MinLockupDuration is the minimal lockup duration in seconds.
+	MinLockupDuration hexutil.Uint64
+
+	// MaxLockupDuration is the maximal lockup duration in seconds.
+	MaxLockupDuration hexutil.Uint64
+}
+
+// RewardsEstimate represents the expected staking rewards
+// calculated from the state of the latest sealed epoch.
+type RewardsEstimate struct {
+	// Amount is the amount of tokens staked, or delegated.
+	Amount hexutil.Big
+
+	// Duration is the staking period in seconds.
+	Duration hexutil.Uint64
+
+	// IsValidator signals the amount is the own stake of a validator.
+	IsValidator bool
+
+	// LockDuration is the lockup duration of the stake in seconds.
+	LockDuration hexutil.Uint64
+
+	// BaseReward is the expected base reward for the period.
+	BaseReward hexutil.Big
+
+	// TxReward is the expected share on transaction fees for the period.
+	TxReward hexutil.Big
+
+	// Total is the total expected reward for the period.
+	Total hexutil.Big
+
+	// Apr is the annual percentage rate of the expected reward.
+	Apr float64
+}
@@ -0,0 +1,23 @@
+// Package types implements different core types of the API.
+package types
+
+// SearchResults represents the entities matching a search text grouped by their type.
+type SearchResults struct {
+	// Blocks is the list of blocks found by number, or hash.
+	Blocks []*Block
+
+	// Transactions is the list of transactions found by hash.
+	Transactions []*Transaction
+
+	// Accounts is the list of accounts found by address.
+	Accounts []*Account
+
+	// Contracts is the list of smart contracts found by address, or name.
+	Contracts []*Contract
+
+	// Tokens is the list of tokens found by symbol.
+	Tokens []*DefiToken
+
+	// Stakers is the list of stakers found by name.
+	Stakers []*Staker
+}
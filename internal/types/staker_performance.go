@@ -0,0 +1,99 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// StakerSample represents the state of a staker observed during an epoch.
+// The sample is updated on each observation within the epoch so it keeps
+// the latest state of the staker together with the offline history.
+type StakerSample struct {
+	// StakerId is the id of the staker.
+	StakerId hexutil.Uint64
+
+	// Epoch is the id of the epoch the sample belongs to.
+	Epoch hexutil.Uint64
+
+	// Time is the time stamp of the latest observation.
+	Time hexutil.Uint64
+
+	// Status is the binary encoded status of the staker.
+	Status hexutil.Uint64
+
+	// IsActive signals the staker is active.
+	IsActive bool
+
+	// IsOffline signals the staker is offline.
+	IsOffline bool
+
+	// IsCheater signals the staker is flagged as a cheater.
+	IsCheater bool
+
+	// WasOffline signals the staker was observed offline during the epoch.
+	WasOffline bool
+
+	// OfflineIncidents is the number of times the staker went offline during the epoch.
+	OfflineIncidents int32
+
+	// MissedBlocks is the number of blocks missed by the staker.
+	MissedBlocks hexutil.Uint64
+
+	// Downtime is the number of seconds the staker is offline.
+	Downtime hexutil.Uint64
+
+	// EpochMissedBlocks is the number of blocks missed by the staker during the epoch.
+	EpochMissedBlocks hexutil.Uint64
+
+	// EpochDowntime is the number of seconds the staker was offline during the epoch.
+	EpochDowntime hexutil.Uint64
+
+	// ObservedTime is the number of seconds of the epoch covered by the observations.
+	ObservedTime hexutil.Uint64
+}
+
+// StakerPerformance represents the performance of a staker
+// aggregated from the samples of a range of epochs.
+type StakerPerformance struct {
+	// StakerId is the id of the staker.
+	StakerId hexutil.Uint64
+
+	// FromEpoch is the first epoch of the range.
+	FromEpoch hexutil.Uint64
+
+	// ToEpoch is the last epoch of the range.
+	ToEpoch hexutil.Uint64
+
+	// SampledEpochs is the number of epochs with a sample in the range.
+	SampledEpochs int32
+
+	// Uptime is the percentage of the observed time the staker was online.
+	Uptime float64
+
+	// MissedBlocks is the number of blocks missed by the staker in the range.
+	MissedBlocks hexutil.Uint64
+
+	// Downtime is the number of seconds the staker was offline in the range.
+	Downtime hexutil.Uint64
+
+	// OfflineIncidents is the number of times the staker went offline in the range.
+	OfflineIncidents int32
+
+	// IsCheater signals the staker was flagged as a cheater in the range.
+	IsCheater bool
+
+	// CheaterEpoch is the first epoch the staker was flagged as a cheater in, if any.
+	CheaterEpoch *hexutil.Uint64
+
+	// Samples is the list of the staker samples in the range.
+	Samples []StakerSample
+}
+
+// StakerStatusEvent represents a change of a staker status.
+type StakerStatusEvent struct {
+	// StakerSample is the state of the staker after the change.
+	StakerSample
+
+	// PrevStatus is the binary encoded status of the staker before the change.
+	PrevStatus hexutil.Uint64
+}
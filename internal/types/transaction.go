@@ -21,6 +21,9 @@ type Transaction struct {
 	// Gas represents gas provided by the sender.
 	Gas hexutil.Uint64 `json:"gas"`
 
+	// GasPrice represents gas price provided by the sender in Wei.
+	GasPrice hexutil.Big `json:"gasPrice"`
+
 	// Gas represents gas provided by the sender.
 	GasUsed *hexutil.Uint64 `json:"gasUsed"`
 
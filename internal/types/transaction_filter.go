@@ -0,0 +1,41 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// TransactionFilter represents a set of conditions applied to a list of transactions.
+// Conditions not provided are not applied.
+type TransactionFilter struct {
+	// Sender is the address of the sender of the transaction.
+	Sender *common.Address
+
+	// Recipient is the address of the recipient of the transaction.
+	Recipient *common.Address
+
+	// ContractCreation limits the list to contract creating transactions.
+	ContractCreation bool
+
+	// MinValue is the minimal value transferred in WEI.
+	MinValue *hexutil.Big
+
+	// MaxValue is the maximal value transferred in WEI.
+	MaxValue *hexutil.Big
+
+	// FromTime is the minimal time stamp of the transaction.
+	FromTime *hexutil.Uint64
+
+	// ToTime is the maximal time stamp of the transaction.
+	ToTime *hexutil.Uint64
+
+	// FromBlock is the minimal block number of the transaction.
+	FromBlock *hexutil.Uint64
+
+	// ToBlock is the maximal block number of the transaction.
+	ToBlock *hexutil.Uint64
+
+	// Status is the status of the transaction; 1 for success, 0 for failure.
+	Status *hexutil.Uint64
+}
@@ -0,0 +1,190 @@
+// Package types implements different core types of the API.
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// UniswapActionSwap represents a swap of tokens in a Uniswap pair.
+	UniswapActionSwap = "SWAP"
+
+	// UniswapActionMint represents liquidity added to a Uniswap pair.
+	UniswapActionMint = "MINT"
+
+	// UniswapActionBurn represents liquidity removed from a Uniswap pair.
+	UniswapActionBurn = "BURN"
+)
+
+// UniswapCandleResolutions maps the supported resolutions of Uniswap candles
+// to the length of the candle time bucket in seconds.
+var UniswapCandleResolutions = map[string]int64{
+	"MINUTE":       60,
+	"FIVE_MINUTES": 300,
+	"HOUR":         3600,
+	"DAY":          86400,
+}
+
+// UniswapCandleResolutionName returns the name of the resolution of Uniswap candles
+// with the given length of the time bucket; empty string for unknown resolution.
+func UniswapCandleResolutionName(res int64) string {
+	for name, val := range UniswapCandleResolutions {
+		if val == res {
+			return name
+		}
+	}
+	return ""
+}
+
+// UniswapPair represents a pair of tokens traded on the Uniswap exchange.
+type UniswapPair struct {
+	// Address is the address of the pair contract.
+	Address common.Address `json:"address"`
+
+	// Index is the index of the pair in the list of pairs of the factory.
+	Index uint64 `json:"index"`
+
+	// Token0 is the address of the first token of the pair.
+	Token0 common.Address `json:"token0"`
+
+	// Token1 is the address of the second token of the pair.
+	Token1 common.Address `json:"token1"`
+
+	// Reserve0 is the amount of the first token in the pair pool.
+	Reserve0 hexutil.Big `json:"reserve0"`
+
+	// Reserve1 is the amount of the second token in the pair pool.
+	Reserve1 hexutil.Big `json:"reserve1"`
+
+	// ReservesTimeStamp is the unix time stamp of the last reserves update.
+	ReservesTimeStamp hexutil.Uint64 `json:"reservesTs"`
+
+	// TotalSupply is the total amount of liquidity tokens of the pair.
+	TotalSupply hexutil.Big `json:"totalSupply"`
+}
+
+// UniswapSync represents the reserves of a Uniswap pair synced by an event.
+type UniswapSync struct {
+	// Pair is the address of the pair contract.
+	Pair common.Address
+
+	// Reserve0 is the reserve of the first token.
+	Reserve0 hexutil.Big
+
+	// Reserve1 is the reserve of the second token.
+	Reserve1 hexutil.Big
+}
+
+// UniswapPosition represents liquidity provided by an account to a Uniswap pair.
+type UniswapPosition struct {
+	// Pair is the address of the pair contract.
+	Pair common.Address
+
+	// Owner is the address of the liquidity provider.
+	Owner common.Address
+
+	// Liquidity is the amount of liquidity tokens owned.
+	Liquidity hexutil.Big
+
+	// Amount0 is the share of the first token reserve owned.
+	Amount0 hexutil.Big
+
+	// Amount1 is the share of the second token reserve owned.
+	Amount1 hexutil.Big
+}
+
+// UniswapAction represents a swap, or a liquidity change, on a Uniswap pair.
+type UniswapAction struct {
+	// Type is the type of the action.
+	Type string
+
+	// Pair is the address of the pair contract.
+	Pair common.Address
+
+	// Account is the address of the account sending the transaction.
+	Account common.Address
+
+	// Sender is the address of the contract calling the pair.
+	Sender common.Address
+
+	// Recipient is the address receiving the output tokens, if any.
+	Recipient *common.Address
+
+	// Amount0In is the amount of the first token sent to the pair.
+	Amount0In hexutil.Big
+
+	// Amount1In is the amount of the second token sent to the pair.
+	Amount1In hexutil.Big
+
+	// Amount0Out is the amount of the first token sent out of the pair.
+	Amount0Out hexutil.Big
+
+	// Amount1Out is the amount of the second token sent out of the pair.
+	Amount1Out hexutil.Big
+
+	// Reserve0 is the reserve of the first token after the action.
+	Reserve0 hexutil.Big
+
+	// Reserve1 is the reserve of the second token after the action.
+	Reserve1 hexutil.Big
+
+	// TransactionHash is the hash of the action transaction.
+	TransactionHash Hash
+
+	// LogIndex is the index of the action event in the block.
+	LogIndex hexutil.Uint64
+
+	// OrdinalIndex is the ordinal index of the action transaction in the chain.
+	OrdinalIndex uint64
+
+	// TimeStamp is the unix time stamp of the action.
+	TimeStamp hexutil.Uint64
+}
+
+// UniswapCandle represents the price and volume of a Uniswap pair
+// aggregated over a time bucket. The price is the amount of the second
+// token paid for one unit of the first token, both in their smallest units.
+type UniswapCandle struct {
+	// Pair is the address of the pair contract.
+	Pair common.Address
+
+	// Resolution is the name of the resolution of the candle.
+	Resolution string
+
+	// Time is the unix time stamp of the time bucket start.
+	Time hexutil.Uint64
+
+	// Open is the price after the first swap in the bucket.
+	Open float64
+
+	// High is the highest price in the bucket.
+	High float64
+
+	// Low is the lowest price in the bucket.
+	Low float64
+
+	// Close is the price after the last swap in the bucket.
+	Close float64
+
+	// Volume0 is the amount of the first token swapped in the bucket.
+	Volume0 hexutil.Big
+
+	// Volume1 is the amount of the second token swapped in the bucket.
+	Volume1 hexutil.Big
+
+	// Swaps is the number of swaps in the bucket.
+	Swaps int32
+}
+
+// UniswapVolume represents the trading volume of a Uniswap pair over a period of time.
+type UniswapVolume struct {
+	// Volume0 is the amount of the first token swapped.
+	Volume0 hexutil.Big
+
+	// Volume1 is the amount of the second token swapped.
+	Volume1 hexutil.Big
+
+	// Swaps is the number of swaps.
+	Swaps int32
+}
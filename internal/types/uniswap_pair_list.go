@@ -0,0 +1,39 @@
+// Package types implements different core types of the API.
+package types
+
+// UniswapPairList represents a list of Uniswap pairs.
+type UniswapPairList struct {
+	// List keeps the actual Collection.
+	Collection []*UniswapPair
+
+	// Total indicates total number of pairs in the whole collection.
+	Total uint64
+
+	// First is the index of the first pair on the list
+	First uint64
+
+	// Last is the index of the last pair on the list
+	Last uint64
+
+	// IsStart indicates there are no pairs available above the list currently.
+	IsStart bool
+
+	// IsEnd indicates there are no pairs available below the list currently.
+	IsEnd bool
+}
+
+// Reverse reverses the order of pairs in the list.
+func (l *UniswapPairList) Reverse() {
+	// anything to swap at all?
+	if l.Collection == nil || len(l.Collection) < 2 {
+		return
+	}
+
+	// swap elements
+	for i, j := 0, len(l.Collection)-1; i < j; i, j = i+1, j-1 {
+		l.Collection[i], l.Collection[j] = l.Collection[j], l.Collection[i]
+	}
+
+	// swap indexes
+	l.First, l.Last = l.Last, l.First
+}